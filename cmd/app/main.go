@@ -2,16 +2,23 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"os/signal"
 	"runtime"
 	"syscall"
+	"time"
 
 	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/notifier"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/webhook"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres/facade"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres/repository"
@@ -58,10 +65,92 @@ func main() {
 	), db)
 	adminsFacade := facade.NewAdminFacade(adminsRepo, 10000)
 
+	clientsRepo := repository.NewClientsRepo(logger.With(
+		zap.String("layer", "clients repo"),
+	), db)
+
+	pickupCodesRepo := repository.NewPickupCodesRepo(logger.With(
+		zap.String("layer", "pickup codes repo"),
+	), db)
+
+	notificationsRepo := repository.NewNotificationsRepo(logger.With(
+		zap.String("layer", "notifications repo"),
+	), db)
+
+	webhooksRepo := repository.NewWebhooksRepo(logger.With(
+		zap.String("layer", "webhooks repo"),
+	), db)
+
+	orderEventsRepo := repository.NewOrderEventsRepo(logger.With(
+		zap.String("layer", "order events repo"),
+	), db, cfg.OrderEventsMaxAttempts())
+
+	tokensRepo := repository.NewTokensRepo(logger.With(
+		zap.String("layer", "tokens repo"),
+	), db)
+
+	loginAttemptsRepo := repository.NewLoginAttemptsRepo(logger.With(
+		zap.String("layer", "login attempts repo"),
+	), db)
+
+	apiKeysRepo := repository.NewAPIKeysRepo(logger.With(
+		zap.String("layer", "api keys repo"),
+	), db)
+
+	logsRepo := repository.NewLogsRepo(db, cfg.AuditMaxAttempts())
+
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	app := grpc.NewServer(logger, ordersFacade, adminsFacade, tx)
+	converter, err := currency.NewConverter(cfg.BaseCurrency(), cfg.CurrencyRates())
+	if err != nil {
+		log.Panic("cannot init currency converter", err)
+	}
+
+	breached, err := password.LoadBreached(cfg.BreachedPasswordsFile())
+	if err != nil {
+		log.Panic("cannot load breached passwords", err)
+	}
+	policy := password.NewPolicy(cfg.PasswordMinLength(), cfg.PasswordMaxAge(), cfg.PasswordHistory(), breached)
+
+	channels := []notifier.Channel{notifier.NewStdoutChannel()}
+	if cfg.NotificationsFile() != "" {
+		fileChannel, file, err := notifier.NewFileChannel(cfg.NotificationsFile())
+		if err != nil {
+			log.Panic("cannot open notifications file", err)
+		}
+		defer file.Close()
+
+		channels[0] = fileChannel
+	}
+	if cfg.NotificationsWebhookURL() != "" {
+		channels = append(channels, notifier.NewWebhookChannel(cfg.NotificationsWebhookURL(), cfg.Timeout))
+	}
+
+	notifier.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "notifications"),
+	), notificationsRepo, cfg.BatchSize, channels...).Start(ctx, cfg.Timeout, time.Hour)
+
+	webhook.NewDispatcher(logger.With(
+		zap.String("layer", "dispatcher"),
+		zap.String("domain", "webhooks"),
+	), webhooksRepo, cfg.BatchSize, cfg.Timeout).Start(ctx, cfg.Timeout, time.Hour)
+
+	// broker is shared by order events relay and audit logger, events and logs stay in db without it
+	broker, err := kafka.NewBroker(cfg)
+	switch {
+	case errors.Is(err, kafka.ErrNoBroker):
+		log.Print("Kafka isn't configured, order events aren't sent to Kafka")
+	case err != nil:
+		log.Panic("cannot init kafka broker", err)
+	default:
+		kafka.StartOrderEvents(ctx, cfg, broker, cfg.Timeout, orderEventsRepo, cfg.BatchSize)
+	}
+
+	app := grpc.NewServer(cfg, logger, ordersFacade, adminsFacade, clientsRepo, pickupCodesRepo, notificationsRepo,
+		webhooksRepo, orderEventsRepo, tokensRepo, loginAttemptsRepo, apiKeysRepo, logsRepo, broker, tx, converter,
+		policy)
 
 	errCh := make(chan error, 1)
 	go func() {
@@ -1,13 +1,14 @@
 package config
 
 import (
-	"bufio"
 	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -15,6 +16,17 @@ import (
 	"github.com/uber/jaeger-client-go/config"
 )
 
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+	defaultLogsTopic       = "logs"
+	defaultConsumerGroup   = "pvz-audit-logger"
+	defaultAuditAttempts   = 3
+	defaultEventsTopic     = "order-events"
+	defaultEventAttempts   = 5
+	defaultKafkaBroker     = "sarama"
+)
+
 var (
 	errNoConfigFile = errors.New("no config file found")
 )
@@ -31,19 +43,38 @@ func InitEnv(envFile string) error {
 
 // Config is a structure that contains all configuration parameters
 type Config struct {
-	host        string
-	port        string
-	username    string
-	password    string
-	dbname      string
-	kafkaHost   string
-	kafkaPort   string
-	kafkaUIPort string
-	appEnv      string
-	grpcPort    string
-	WorkerCount int
-	BatchSize   int
-	Timeout     time.Duration
+	host          string
+	port          string
+	username      string
+	password      string
+	dbname        string
+	kafkaHost     string
+	kafkaPort     string
+	kafkaUIPort   string
+	kafkaBroker   string
+	logsTopic     string
+	consumerGroup string
+	eventsTopic   string
+	appEnv        string
+	grpcPort      string
+	baseCurrency  string
+	currencyRates map[string]float64
+	notifyFile    string
+	notifyWebhook string
+	jwtSecret     string
+	accessTTL     time.Duration
+	refreshTTL    time.Duration
+	noBasicAuth   bool
+	pwdMinLength  int
+	pwdMaxAge     time.Duration
+	pwdHistory    int
+	breachedFile  string
+	auditSinks    string
+	auditAttempts int
+	eventAttempts int
+	WorkerCount   int
+	BatchSize     int
+	Timeout       time.Duration
 }
 
 // NewConfig creates instance of Config
@@ -58,26 +89,102 @@ func NewConfig() Config {
 	kafkaUIPort := os.Getenv("KAFKA_UI_PORT")
 	grpcPort := os.Getenv("GRPC_PORT")
 	appEnv := os.Getenv("APP_ENV")
+	baseCurrency := os.Getenv("BASE_CURRENCY")
+	notifyFile := os.Getenv("NOTIFICATIONS_FILE")
+	notifyWebhook := os.Getenv("NOTIFICATIONS_WEBHOOK_URL")
+	jwtSecret := os.Getenv("JWT_SECRET")
 
 	if host == "" || port == "" || username == "" || password == "" || dbname == "" ||
 		kafkaHost == "" || kafkaPort == "" || kafkaUIPort == "" || appEnv == "" || grpcPort == "" {
 		log.Fatal("Database configuration missing: one or more required fields are empty.")
 	}
 
+	if jwtSecret == "" {
+		log.Fatal("JWT_SECRET is required to sign access tokens")
+	}
+
+	accessTTL, err := parseDuration(os.Getenv("ACCESS_TOKEN_TTL"), defaultAccessTokenTTL)
+	if err != nil {
+		log.Fatal("Access token TTL is invalid: ", err)
+	}
+
+	refreshTTL, err := parseDuration(os.Getenv("REFRESH_TOKEN_TTL"), defaultRefreshTokenTTL)
+	if err != nil {
+		log.Fatal("Refresh token TTL is invalid: ", err)
+	}
+
+	basicAuth := true
+	if raw := os.Getenv("BASIC_AUTH_ENABLED"); raw != "" {
+		basicAuth, err = strconv.ParseBool(raw)
+		if err != nil {
+			log.Fatal("BASIC_AUTH_ENABLED is invalid: ", err)
+		}
+	}
+
+	// zero password settings are replaced with password policy defaults
+	pwdMinLength, err := parseInt(os.Getenv("PASSWORD_MIN_LENGTH"))
+	if err != nil {
+		log.Fatal("PASSWORD_MIN_LENGTH is invalid: ", err)
+	}
+
+	pwdMaxAge, err := parseDuration(os.Getenv("PASSWORD_MAX_AGE"), 0)
+	if err != nil {
+		log.Fatal("PASSWORD_MAX_AGE is invalid: ", err)
+	}
+
+	pwdHistory, err := parseInt(os.Getenv("PASSWORD_HISTORY"))
+	if err != nil {
+		log.Fatal("PASSWORD_HISTORY is invalid: ", err)
+	}
+
+	auditAttempts, err := parseInt(os.Getenv("AUDIT_MAX_ATTEMPTS"))
+	if err != nil {
+		log.Fatal("AUDIT_MAX_ATTEMPTS is invalid: ", err)
+	}
+
+	eventAttempts, err := parseInt(os.Getenv("ORDER_EVENTS_MAX_ATTEMPTS"))
+	if err != nil {
+		log.Fatal("ORDER_EVENTS_MAX_ATTEMPTS is invalid: ", err)
+	}
+
+	currencyRates, err := parseCurrencyRates(os.Getenv("CURRENCY_RATES"))
+	if err != nil {
+		log.Fatal("Currency rates configuration is invalid: ", err)
+	}
+
 	return Config{
-		host:        host,
-		port:        port,
-		username:    username,
-		password:    password,
-		dbname:      dbname,
-		kafkaHost:   kafkaHost,
-		kafkaPort:   kafkaPort,
-		kafkaUIPort: kafkaUIPort,
-		grpcPort:    grpcPort,
-		appEnv:      appEnv,
-		WorkerCount: 2,
-		BatchSize:   5,
-		Timeout:     2 * time.Second,
+		host:          host,
+		port:          port,
+		username:      username,
+		password:      password,
+		dbname:        dbname,
+		kafkaHost:     kafkaHost,
+		kafkaPort:     kafkaPort,
+		kafkaUIPort:   kafkaUIPort,
+		kafkaBroker:   os.Getenv("KAFKA_BROKER"),
+		logsTopic:     os.Getenv("KAFKA_LOGS_TOPIC"),
+		consumerGroup: os.Getenv("KAFKA_CONSUMER_GROUP"),
+		eventsTopic:   os.Getenv("KAFKA_ORDER_EVENTS_TOPIC"),
+		grpcPort:      grpcPort,
+		appEnv:        appEnv,
+		baseCurrency:  baseCurrency,
+		currencyRates: currencyRates,
+		notifyFile:    notifyFile,
+		notifyWebhook: notifyWebhook,
+		jwtSecret:     jwtSecret,
+		accessTTL:     accessTTL,
+		refreshTTL:    refreshTTL,
+		noBasicAuth:   !basicAuth,
+		pwdMinLength:  pwdMinLength,
+		pwdMaxAge:     pwdMaxAge,
+		pwdHistory:    pwdHistory,
+		breachedFile:  os.Getenv("BREACHED_PASSWORDS_FILE"),
+		auditSinks:    os.Getenv("AUDIT_SINKS_CONFIG"),
+		auditAttempts: auditAttempts,
+		eventAttempts: eventAttempts,
+		WorkerCount:   2,
+		BatchSize:     5,
+		Timeout:       2 * time.Second,
 	}
 }
 
@@ -126,6 +233,43 @@ func (c *Config) KafkaUIPort() string {
 	return c.kafkaUIPort
 }
 
+// KafkaBroker returns broker Kafka clients are created by, sarama connects to Kafka,
+// memory keeps topics in process for tests and offline runs
+func (c *Config) KafkaBroker() string {
+	if c.kafkaBroker == "" {
+		return defaultKafkaBroker
+	}
+
+	return c.kafkaBroker
+}
+
+// KafkaLogsTopic returns topic audit logs are sent to
+func (c *Config) KafkaLogsTopic() string {
+	if c.logsTopic == "" {
+		return defaultLogsTopic
+	}
+
+	return c.logsTopic
+}
+
+// KafkaConsumerGroup returns consumer group audit logs are consumed by, replicas share its partitions
+func (c *Config) KafkaConsumerGroup() string {
+	if c.consumerGroup == "" {
+		return defaultConsumerGroup
+	}
+
+	return c.consumerGroup
+}
+
+// KafkaOrderEventsTopic returns topic order domain events are published to
+func (c *Config) KafkaOrderEventsTopic() string {
+	if c.eventsTopic == "" {
+		return defaultEventsTopic
+	}
+
+	return c.eventsTopic
+}
+
 // AppEnv returns env in which app is run
 func (c *Config) AppEnv() string {
 	return c.appEnv
@@ -136,6 +280,142 @@ func (c *Config) GRPCPort() string {
 	return c.grpcPort
 }
 
+// BaseCurrency returns currency in which totals are reported
+func (c *Config) BaseCurrency() string {
+	return c.baseCurrency
+}
+
+// CurrencyRates returns conversion rates to base currency
+func (c *Config) CurrencyRates() map[string]float64 {
+	return c.currencyRates
+}
+
+// NotificationsFile returns path of a file notifications are written to, stdout is used if it is empty
+func (c *Config) NotificationsFile() string {
+	return c.notifyFile
+}
+
+// NotificationsWebhookURL returns url notifications are posted to, webhook is disabled if it is empty
+func (c *Config) NotificationsWebhookURL() string {
+	return c.notifyWebhook
+}
+
+// JWTSecret returns secret access tokens are signed with
+func (c *Config) JWTSecret() string {
+	return c.jwtSecret
+}
+
+// AccessTokenTTL returns lifetime of access tokens
+func (c *Config) AccessTokenTTL() time.Duration {
+	if c.accessTTL == 0 {
+		return defaultAccessTokenTTL
+	}
+
+	return c.accessTTL
+}
+
+// RefreshTokenTTL returns lifetime of refresh tokens
+func (c *Config) RefreshTokenTTL() time.Duration {
+	if c.refreshTTL == 0 {
+		return defaultRefreshTokenTTL
+	}
+
+	return c.refreshTTL
+}
+
+// BasicAuthEnabled returns if basic auth is accepted along with access tokens
+func (c *Config) BasicAuthEnabled() bool {
+	return !c.noBasicAuth
+}
+
+// PasswordMinLength returns minimal length of admin passwords, zero means default
+func (c *Config) PasswordMinLength() int {
+	return c.pwdMinLength
+}
+
+// PasswordMaxAge returns time after which admins have to change their passwords, zero means default
+func (c *Config) PasswordMaxAge() time.Duration {
+	return c.pwdMaxAge
+}
+
+// PasswordHistory returns number of previous passwords admins can't reuse, zero means default
+func (c *Config) PasswordHistory() int {
+	return c.pwdHistory
+}
+
+// BreachedPasswordsFile returns path of a file with passwords that can't be used, list is disabled if it is empty
+func (c *Config) BreachedPasswordsFile() string {
+	return c.breachedFile
+}
+
+// AuditSinksConfig returns path of a file audit sinks are configured in, logger.config is used if it is empty
+func (c *Config) AuditSinksConfig() string {
+	return c.auditSinks
+}
+
+// AuditMaxAttempts returns number of times audit log is sent to Kafka before it's dead-lettered
+func (c *Config) AuditMaxAttempts() int {
+	if c.auditAttempts <= 0 {
+		return defaultAuditAttempts
+	}
+
+	return c.auditAttempts
+}
+
+// OrderEventsMaxAttempts returns number of times order event is sent to Kafka before it's given up
+func (c *Config) OrderEventsMaxAttempts() int {
+	if c.eventAttempts <= 0 {
+		return defaultEventAttempts
+	}
+
+	return c.eventAttempts
+}
+
+// KafkaDLQTopic returns topic audit logs without attempts left are sent to
+func (c *Config) KafkaDLQTopic() string {
+	return c.KafkaLogsTopic() + ".dlq"
+}
+
+func parseInt(raw string) (int, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	return strconv.Atoi(raw)
+}
+
+func parseDuration(raw string, defaultValue time.Duration) (time.Duration, error) {
+	if raw == "" {
+		return defaultValue, nil
+	}
+
+	return time.ParseDuration(raw)
+}
+
+// parseCurrencyRates parses rates in a "USD:92.5,EUR:99.1" format
+func parseCurrencyRates(raw string) (map[string]float64, error) {
+	rates := make(map[string]float64)
+	if raw == "" {
+		return rates, nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("wrong currency rate format: %q", pair)
+		}
+
+		rate, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return nil, fmt.Errorf("wrong currency rate for %s: %w", parts[0], err)
+		}
+
+		rates[strings.ToUpper(parts[0])] = rate
+	}
+
+	return rates, nil
+}
+
 // IsEmpty checks if config is empty
 func (c *Config) IsEmpty() bool {
 	return c.host == ""
@@ -161,33 +441,6 @@ func GetRootDir() (string, error) {
 	}
 }
 
-// ReadFirstFileWord reads first word from a file
-func ReadFirstFileWord(filename string) (string, error) {
-	file, err := os.Open(filename)
-	if err != nil {
-		return "", err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	scanner.Split(bufio.ScanWords)
-
-	if !scanner.Scan() {
-		return "", errors.New("file is empty or has no words")
-	}
-	firstWord := scanner.Text()
-
-	if scanner.Scan() {
-		return "", errors.New("file contains more than one word")
-	}
-
-	if err := scanner.Err(); err != nil {
-		return "", err
-	}
-
-	return firstWord, nil
-}
-
 func InitTracer(serviceName string) (opentracing.Tracer, io.Closer, error) {
 	tracerCfg := config.Configuration{
 		ServiceName: serviceName,
@@ -0,0 +1,147 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+
+	"github.com/Rhymond/go-money"
+)
+
+var (
+	// ErrUnknownCurrency happens when currency code is not an ISO 4217 code known to go-money
+	ErrUnknownCurrency = errors.New("unknown currency")
+
+	// ErrNoRate happens when there is no conversion rate for currency
+	ErrNoRate = errors.New("no conversion rate for currency")
+
+	errWrongRate = errors.New("conversion rate must be positive")
+	errOverflow  = errors.New("converted amount overflows")
+)
+
+const (
+	// DefaultBase is a base currency used when none is configured
+	DefaultBase = money.RUB
+
+	// rateScale is a number of rate units in one unit of base currency, rates are kept with 6 decimal places,
+	// so conversion is done in integers
+	rateScale = 1_000_000
+)
+
+// Converter is a structure that converts money into a base currency using a fixed rates table
+type Converter struct {
+	base  string
+	rates map[string]int64
+}
+
+// NewConverter creates an instance of Converter, rates are amounts of base currency per one unit of currency
+func NewConverter(base string, rates map[string]float64) (*Converter, error) {
+	if base == "" {
+		base = DefaultBase
+	}
+
+	base, err := Validate(base)
+	if err != nil {
+		return nil, err
+	}
+
+	converterRates := make(map[string]int64, len(rates)+1)
+	for code, rate := range rates {
+		code, err = Validate(code)
+		if err != nil {
+			return nil, err
+		}
+
+		scaled := math.Round(rate * rateScale)
+		if scaled <= 0 || scaled > math.MaxInt64 {
+			return nil, fmt.Errorf("%w: %s", errWrongRate, code)
+		}
+
+		converterRates[code] = int64(scaled)
+	}
+	converterRates[base] = rateScale
+
+	return &Converter{
+		base:  base,
+		rates: converterRates,
+	}, nil
+}
+
+// Validate checks that code is a known ISO 4217 currency code and returns it in upper case
+func Validate(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if money.GetCurrency(code) == nil {
+		return "", fmt.Errorf("%w: %q", ErrUnknownCurrency, code)
+	}
+
+	return code, nil
+}
+
+// Base returns base currency code
+func (c *Converter) Base() string {
+	return c.base
+}
+
+// Convert converts money into base currency, amount is converted in minor units
+// and rounded half away from zero to minor units of base currency
+func (c *Converter) Convert(m *money.Money) (*money.Money, error) {
+	code := m.Currency().Code
+	if code == c.base {
+		return m, nil
+	}
+
+	rate, ok := c.rates[code]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrNoRate, code)
+	}
+
+	// amount * rate * 10^baseFraction / (rateScale * 10^fraction)
+	num := new(big.Int).Mul(big.NewInt(m.Amount()), big.NewInt(rate))
+	num.Mul(num, pow10(money.GetCurrency(c.base).Fraction))
+	den := new(big.Int).Mul(big.NewInt(rateScale), pow10(m.Currency().Fraction))
+
+	amount, err := roundDiv(num, den)
+	if err != nil {
+		return nil, fmt.Errorf("%s to %s: %w", code, c.base, err)
+	}
+
+	return money.New(amount, c.base), nil
+}
+
+// Total sums up money converting everything into base currency
+func (c *Converter) Total(amounts ...*money.Money) (*money.Money, error) {
+	total := money.New(0, c.base)
+	for _, amount := range amounts {
+		converted, err := c.Convert(amount)
+		if err != nil {
+			return nil, err
+		}
+
+		total, err = total.Add(converted)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return total, nil
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
+
+// roundDiv divides num by positive den rounding half away from zero
+func roundDiv(num, den *big.Int) (int64, error) {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(den) >= 0 {
+		quo.Add(quo, big.NewInt(int64(num.Sign())))
+	}
+
+	if !quo.IsInt64() {
+		return 0, errOverflow
+	}
+
+	return quo.Int64(), nil
+}
@@ -0,0 +1,161 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/Rhymond/go-money"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConverter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		base          string
+		rates         map[string]float64
+		expectedBase  string
+		expectedError error
+	}{
+		{
+			name:         "Default base",
+			expectedBase: DefaultBase,
+		},
+		{
+			name:         "Lower case base",
+			base:         " usd ",
+			rates:        map[string]float64{"rub": 0.011},
+			expectedBase: money.USD,
+		},
+		{
+			name:          "Unknown base",
+			base:          "XXXX",
+			expectedError: ErrUnknownCurrency,
+		},
+		{
+			name:          "Unknown rate currency",
+			rates:         map[string]float64{"XXXX": 1},
+			expectedError: ErrUnknownCurrency,
+		},
+		{
+			name:          "Negative rate",
+			rates:         map[string]float64{money.USD: -92.5},
+			expectedError: errWrongRate,
+		},
+		{
+			name:          "Rate rounded to zero",
+			rates:         map[string]float64{money.USD: 0.0000001},
+			expectedError: errWrongRate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			converter, err := NewConverter(tt.base, tt.rates)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.Equal(t, tt.expectedBase, converter.Base())
+			}
+		})
+	}
+}
+
+func TestConverter_Convert(t *testing.T) {
+	t.Parallel()
+	converter, err := NewConverter(money.RUB, map[string]float64{
+		money.USD: 92.5,
+		money.EUR: 99.1,
+		money.JPY: 0.6543,
+		money.KWD: 300.123,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		amount         *money.Money
+		expectedAmount int64
+		expectedError  error
+	}{
+		{
+			name:           "Base currency",
+			amount:         money.New(12345, money.RUB),
+			expectedAmount: 12345,
+		},
+		{
+			name:           "Whole amount",
+			amount:         money.New(1000, money.USD),
+			expectedAmount: 92500,
+		},
+		{
+			name:           "Fractional rate",
+			amount:         money.New(10, money.EUR),
+			expectedAmount: 991,
+		},
+		{
+			name:           "Rounded half up",
+			amount:         money.New(1, money.USD),
+			expectedAmount: 93,
+		},
+		{
+			name:           "Rounded half away from zero",
+			amount:         money.New(-1, money.USD),
+			expectedAmount: -93,
+		},
+		{
+			name:           "Rounded down",
+			amount:         money.New(1, money.JPY),
+			expectedAmount: 65,
+		},
+		{
+			name:           "Currency with three decimal places",
+			amount:         money.New(1, money.KWD),
+			expectedAmount: 30,
+		},
+		{
+			name:           "No float error",
+			amount:         money.New(33, money.EUR),
+			expectedAmount: 3270,
+		},
+		{
+			name:          "No rate",
+			amount:        money.New(100, money.GBP),
+			expectedError: ErrNoRate,
+		},
+		{
+			name:          "Overflow",
+			amount:        money.New(1<<62, money.USD),
+			expectedError: errOverflow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			converted, err := converter.Convert(tt.amount)
+
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.Equal(t, tt.expectedAmount, converted.Amount())
+				assert.Equal(t, money.RUB, converted.Currency().Code)
+			}
+		})
+	}
+}
+
+func TestConverter_Total(t *testing.T) {
+	t.Parallel()
+	converter, err := NewConverter(money.RUB, map[string]float64{money.USD: 92.5})
+	require.NoError(t, err)
+
+	total, err := converter.Total(money.New(100, money.RUB), money.New(1, money.USD), money.New(1, money.USD))
+	require.NoError(t, err)
+	assert.Equal(t, int64(286), total.Amount())
+
+	_, err = converter.Total(money.New(100, money.RUB), money.New(1, money.EUR))
+	assert.ErrorIs(t, err, ErrNoRate)
+}
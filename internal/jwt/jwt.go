@@ -0,0 +1,107 @@
+package jwt
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// header is the only header tokens are signed with, HS256 is the only supported algorithm
+const header = `{"alg":"HS256","typ":"JWT"}`
+
+var (
+	// ErrMalformedToken happens when token is not a JWT
+	ErrMalformedToken = errors.New("malformed token")
+
+	// ErrInvalidSignature happens when token wasn't signed with our secret or uses other algorithm
+	ErrInvalidSignature = errors.New("invalid token signature")
+
+	// ErrTokenExpired happens when token lifetime is over
+	ErrTokenExpired = errors.New("token expired")
+)
+
+// Claims is a payload of an access token
+type Claims struct {
+	ID        string `json:"jti"`
+	Subject   string `json:"sub"`
+	AdminID   int    `json:"admin_id"`
+	Role      int    `json:"role"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// Expiry returns time when token expires
+func (c *Claims) Expiry() time.Time {
+	return time.Unix(c.ExpiresAt, 0)
+}
+
+// Sign encodes claims into a token signed with HMAC-SHA256
+func Sign(claims Claims, secret []byte) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	unsigned := encode([]byte(header)) + "." + encode(payload)
+
+	return unsigned + "." + encode(signature(unsigned, secret)), nil
+}
+
+// Parse checks token signature and expiry and returns its claims
+func Parse(token string, secret []byte, now time.Time) (Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return Claims{}, ErrMalformedToken
+	}
+
+	rawHeader, err := decode(parts[0])
+	if err != nil {
+		return Claims{}, ErrMalformedToken
+	}
+	if string(rawHeader) != header {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	sig, err := decode(parts[2])
+	if err != nil {
+		return Claims{}, ErrMalformedToken
+	}
+	if !hmac.Equal(sig, signature(parts[0]+"."+parts[1], secret)) {
+		return Claims{}, ErrInvalidSignature
+	}
+
+	payload, err := decode(parts[1])
+	if err != nil {
+		return Claims{}, ErrMalformedToken
+	}
+
+	var claims Claims
+	if err = json.Unmarshal(payload, &claims); err != nil {
+		return Claims{}, ErrMalformedToken
+	}
+
+	if !now.Before(claims.Expiry()) {
+		return Claims{}, ErrTokenExpired
+	}
+
+	return claims, nil
+}
+
+func signature(unsigned string, secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return mac.Sum(nil)
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decode(data string) ([]byte, error) {
+	return base64.RawURLEncoding.DecodeString(data)
+}
@@ -0,0 +1,46 @@
+package jwt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignAndParse(t *testing.T) {
+	t.Parallel()
+	secret := []byte("secret")
+	now := time.Now()
+	claims := Claims{
+		ID:        "42",
+		Subject:   "admin",
+		AdminID:   1,
+		Role:      3,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(time.Minute).Unix(),
+	}
+
+	token, err := Sign(claims, secret)
+	require.NoError(t, err)
+
+	parsed, err := Parse(token, secret, now)
+	require.NoError(t, err)
+	assert.Equal(t, claims, parsed)
+
+	_, err = Parse(token, []byte("other secret"), now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+
+	_, err = Parse(token, secret, now.Add(time.Minute))
+	assert.ErrorIs(t, err, ErrTokenExpired)
+
+	_, err = Parse("not a token", secret, now)
+	assert.ErrorIs(t, err, ErrMalformedToken)
+
+	parts := strings.Split(token, ".")
+	forged, err := Sign(Claims{Subject: "admin", Role: 3, ExpiresAt: now.Add(time.Hour).Unix()}, []byte("guess"))
+	require.NoError(t, err)
+	_, err = Parse(parts[0]+"."+strings.Split(forged, ".")[1]+"."+parts[2], secret, now)
+	assert.ErrorIs(t, err, ErrInvalidSignature)
+}
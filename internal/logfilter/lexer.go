@@ -0,0 +1,112 @@
+package logfilter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	eofToken tokenKind = iota
+	identToken
+	numberToken
+	stringToken
+	operatorToken
+	andToken
+	orToken
+	notToken
+	lparenToken
+	rparenToken
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	value string
+	pos   int
+}
+
+// operators are sorted so that longer ones are matched first
+var operators = []string{"==", "!=", "<=", ">=", "!~", "<", ">", "~"}
+
+// lex splits expression into tokens, positions are 1-based offsets used in errors
+func lex(expr string) ([]token, error) {
+	tokens := make([]token, 0)
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: lparenToken, text: "(", pos: i + 1})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: rparenToken, text: ")", pos: i + 1})
+			i++
+		case strings.HasPrefix(expr[i:], "&&"):
+			tokens = append(tokens, token{kind: andToken, text: "&&", pos: i + 1})
+			i += 2
+		case strings.HasPrefix(expr[i:], "||"):
+			tokens = append(tokens, token{kind: orToken, text: "||", pos: i + 1})
+			i += 2
+		case c == '"':
+			end := i + 1
+			for end < len(expr) && expr[end] != '"' {
+				if expr[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			if end >= len(expr) {
+				return nil, fmt.Errorf("%w at %d: unterminated string", ErrSyntax, i+1)
+			}
+
+			value, err := strconv.Unquote(expr[i : end+1])
+			if err != nil {
+				return nil, fmt.Errorf("%w at %d: wrong string %s", ErrSyntax, i+1, expr[i:end+1])
+			}
+			tokens = append(tokens, token{kind: stringToken, text: expr[i : end+1], value: value, pos: i + 1})
+			i = end + 1
+		case c == '-' || unicode.IsDigit(c):
+			end := i + 1
+			for end < len(expr) && unicode.IsDigit(rune(expr[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: numberToken, text: expr[i:end], value: expr[i:end], pos: i + 1})
+			i = end
+		case c == '_' || unicode.IsLetter(c):
+			end := i + 1
+			for end < len(expr) && (expr[end] == '_' || unicode.IsLetter(rune(expr[end])) ||
+				unicode.IsDigit(rune(expr[end]))) {
+				end++
+			}
+			tokens = append(tokens, token{kind: identToken, text: expr[i:end], value: expr[i:end], pos: i + 1})
+			i = end
+		default:
+			op := ""
+			for _, candidate := range operators {
+				if strings.HasPrefix(expr[i:], candidate) {
+					op = candidate
+
+					break
+				}
+			}
+
+			switch {
+			case op != "":
+				tokens = append(tokens, token{kind: operatorToken, text: op, value: op, pos: i + 1})
+				i += len(op)
+			case c == '!':
+				tokens = append(tokens, token{kind: notToken, text: "!", pos: i + 1})
+				i++
+			default:
+				return nil, fmt.Errorf("%w at %d: unexpected character %q", ErrSyntax, i+1, c)
+			}
+		}
+	}
+
+	return append(tokens, token{kind: eofToken, text: "end of expression", pos: len(expr) + 1}), nil
+}
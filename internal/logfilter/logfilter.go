@@ -0,0 +1,222 @@
+package logfilter
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+var (
+	// ErrSyntax happens when expression is malformed
+	ErrSyntax = errors.New("syntax error")
+
+	// ErrUnknownField happens when expression refers to a field logs don't have
+	ErrUnknownField = errors.New("unknown field")
+
+	// ErrTypeMismatch happens when field is compared with a value of another type or with a wrong operator
+	ErrTypeMismatch = errors.New("type mismatch")
+)
+
+// numberFields are fields compared as numbers, api_key_id is 0 for calls made without API key
+var numberFields = map[string]func(models.Log) int{
+	"id":       func(log models.Log) int { return log.ID },
+	"order_id": func(log models.Log) int { return log.OrderID },
+	"admin_id": func(log models.Log) int { return log.AdminID },
+	"api_key_id": func(log models.Log) int {
+		if log.APIKeyID == nil {
+			return 0
+		}
+
+		return *log.APIKeyID
+	},
+	"status":     func(log models.Log) int { return log.Status },
+	"job_status": func(log models.Log) int { return log.JobStatus },
+}
+
+// stringFields are fields compared as strings, text is the log as it's printed
+var stringFields = map[string]func(models.Log) string{
+	"message": func(log models.Log) string { return log.Message },
+	"url":     func(log models.Log) string { return log.URL },
+	"method":  func(log models.Log) string { return log.Method },
+	"text":    func(log models.Log) string { return log.String() },
+}
+
+// Filter is a compiled filter expression over audit log fields, such as
+// `status >= 400 && (method == "DELETE" || url ~ "/admins")`
+type Filter struct {
+	source string
+	root   node
+}
+
+// Parse compiles filter expression, empty expression matches all logs
+func Parse(expr string) (*Filter, error) {
+	if strings.TrimSpace(expr) == "" {
+		return &Filter{source: expr}, nil
+	}
+
+	tokens, err := lex(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if tok := p.peek(); tok.kind != eofToken {
+		return nil, fmt.Errorf("%w at %d: unexpected %s", ErrSyntax, tok.pos, tok.text)
+	}
+
+	return &Filter{
+		source: expr,
+		root:   root,
+	}, nil
+}
+
+// Match checks if log satisfies filter
+func (f *Filter) Match(log models.Log) bool {
+	if f.root == nil {
+		return true
+	}
+
+	return f.root.eval(log)
+}
+
+func (f *Filter) String() string {
+	return f.source
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != eofToken {
+		p.pos++
+	}
+
+	return tok
+}
+
+// parseOr parses `and ("||" and)*`
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == orToken {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = orNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseAnd parses `not ("&&" not)*`
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == andToken {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = andNode{left: left, right: right}
+	}
+
+	return left, nil
+}
+
+// parseNot parses `"!" not | "(" or ")" | comparison`
+func (p *parser) parseNot() (node, error) {
+	switch tok := p.peek(); tok.kind {
+	case notToken:
+		p.next()
+		operand, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+
+		return notNode{operand: operand}, nil
+	case lparenToken:
+		p.next()
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != rparenToken {
+			return nil, fmt.Errorf("%w at %d: expected ), got %s", ErrSyntax, closing.pos, closing.text)
+		}
+
+		return inner, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+// parseComparison parses `field operator value`
+func (p *parser) parseComparison() (node, error) {
+	field := p.next()
+	if field.kind != identToken {
+		return nil, fmt.Errorf("%w at %d: expected field, got %s", ErrSyntax, field.pos, field.text)
+	}
+
+	op := p.next()
+	if op.kind != operatorToken {
+		return nil, fmt.Errorf("%w at %d: expected operator after %s, got %s", ErrSyntax, op.pos, field.text,
+			op.text)
+	}
+
+	value := p.next()
+	if value.kind != numberToken && value.kind != stringToken {
+		return nil, fmt.Errorf("%w at %d: expected number or string, got %s", ErrSyntax, value.pos, value.text)
+	}
+
+	if get, ok := numberFields[field.value]; ok {
+		if value.kind != numberToken {
+			return nil, fmt.Errorf("%w at %d: %s is a number, got %s", ErrTypeMismatch, value.pos, field.value,
+				value.text)
+		}
+		if op.value == "~" || op.value == "!~" {
+			return nil, fmt.Errorf("%w at %d: %s can't be used with number field %s", ErrTypeMismatch, op.pos,
+				op.value, field.value)
+		}
+
+		number, err := strconv.Atoi(value.value)
+		if err != nil {
+			return nil, fmt.Errorf("%w at %d: wrong number %s", ErrSyntax, value.pos, value.text)
+		}
+
+		return numberNode{get: get, op: op.value, value: number}, nil
+	}
+
+	if get, ok := stringFields[field.value]; ok {
+		if value.kind != stringToken {
+			return nil, fmt.Errorf("%w at %d: %s is a string, got %s", ErrTypeMismatch, value.pos, field.value,
+				value.text)
+		}
+
+		return stringNode{get: get, op: op.value, value: value.value}, nil
+	}
+
+	return nil, fmt.Errorf("%w at %d: %s", ErrUnknownField, field.pos, field.value)
+}
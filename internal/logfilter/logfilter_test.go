@@ -0,0 +1,101 @@
+package logfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestFilter_Match(t *testing.T) {
+	t.Parallel()
+
+	apiKeyID := 7
+	log := models.Log{ID: 10, OrderID: 1, AdminID: 2, APIKeyID: &apiKeyID, Message: "order not found",
+		URL: "/orders/1", Method: "DELETE", Status: 404}
+
+	tests := []struct {
+		name string
+		expr string
+		want bool
+	}{
+		{name: "Empty", expr: " ", want: true},
+		{name: "NumberEqual", expr: "status == 404", want: true},
+		{name: "NumberNotEqual", expr: "status != 404", want: false},
+		{name: "NumberLess", expr: "order_id < 2", want: true},
+		{name: "NumberGreaterOrEqual", expr: "status >= 500", want: false},
+		{name: "Negative", expr: "order_id > -1", want: true},
+		{name: "APIKey", expr: "api_key_id == 7", want: true},
+		{name: "StringEqual", expr: `method == "DELETE"`, want: true},
+		{name: "Contains", expr: `url ~ "orders"`, want: true},
+		{name: "NotContains", expr: `message !~ "not found"`, want: false},
+		{name: "Escaped", expr: `message != "order \"1\""`, want: true},
+		{name: "Text", expr: `text ~ "Status: 404"`, want: true},
+		{name: "And", expr: `status >= 400 && method == "DELETE"`, want: true},
+		{name: "Or", expr: `status < 400 || method == "POST"`, want: false},
+		{name: "Not", expr: `!(method == "POST")`, want: true},
+		{name: "Precedence", expr: `method == "POST" && status == 200 || admin_id == 2`, want: true},
+		{name: "Parentheses", expr: `method == "POST" && (status == 200 || admin_id == 2)`, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := Parse(tt.expr)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, filter.Match(log))
+			assert.Equal(t, tt.expr, filter.String())
+		})
+	}
+}
+
+func TestFilter_MatchWithoutAPIKey(t *testing.T) {
+	t.Parallel()
+
+	filter, err := Parse("api_key_id == 0")
+	require.NoError(t, err)
+
+	assert.True(t, filter.Match(models.Log{}))
+}
+
+func TestParse_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr error
+		wantMsg string
+	}{
+		{name: "UnknownField", expr: "code == 1", wantErr: ErrUnknownField, wantMsg: "unknown field at 1: code"},
+		{name: "NumberAsString", expr: `status == "404"`, wantErr: ErrTypeMismatch},
+		{name: "StringAsNumber", expr: "method == 1", wantErr: ErrTypeMismatch},
+		{name: "ContainsNumber", expr: "status ~ 4", wantErr: ErrTypeMismatch},
+		{name: "MissingValue", expr: "status ==", wantErr: ErrSyntax},
+		{name: "MissingOperator", expr: "status 404", wantErr: ErrSyntax},
+		{name: "DanglingAnd", expr: "status == 404 &&", wantErr: ErrSyntax},
+		{name: "UnclosedParenthesis", expr: "(status == 404", wantErr: ErrSyntax,
+			wantMsg: "syntax error at 15: expected ), got end of expression"},
+		{name: "ExtraToken", expr: "status == 404)", wantErr: ErrSyntax},
+		{name: "UnterminatedString", expr: `method == "DELETE`, wantErr: ErrSyntax},
+		{name: "UnexpectedCharacter", expr: "status = 404", wantErr: ErrSyntax,
+			wantMsg: "syntax error at 8: unexpected character '='"},
+		{name: "SingleAmpersand", expr: "status == 404 & status == 500", wantErr: ErrSyntax},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(tt.expr)
+			require.ErrorIs(t, err, tt.wantErr)
+			if tt.wantMsg != "" {
+				assert.EqualError(t, err, tt.wantMsg)
+			}
+		})
+	}
+}
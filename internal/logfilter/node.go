@@ -0,0 +1,93 @@
+package logfilter
+
+import (
+	"strings"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+type node interface {
+	eval(log models.Log) bool
+}
+
+type andNode struct {
+	left  node
+	right node
+}
+
+func (n andNode) eval(log models.Log) bool {
+	return n.left.eval(log) && n.right.eval(log)
+}
+
+type orNode struct {
+	left  node
+	right node
+}
+
+func (n orNode) eval(log models.Log) bool {
+	return n.left.eval(log) || n.right.eval(log)
+}
+
+type notNode struct {
+	operand node
+}
+
+func (n notNode) eval(log models.Log) bool {
+	return !n.operand.eval(log)
+}
+
+type numberNode struct {
+	get   func(models.Log) int
+	op    string
+	value int
+}
+
+func (n numberNode) eval(log models.Log) bool {
+	field := n.get(log)
+	switch n.op {
+	case "==":
+		return field == n.value
+	case "!=":
+		return field != n.value
+	case "<":
+		return field < n.value
+	case "<=":
+		return field <= n.value
+	case ">":
+		return field > n.value
+	case ">=":
+		return field >= n.value
+	}
+
+	return false
+}
+
+type stringNode struct {
+	get   func(models.Log) string
+	op    string
+	value string
+}
+
+func (n stringNode) eval(log models.Log) bool {
+	field := n.get(log)
+	switch n.op {
+	case "==":
+		return field == n.value
+	case "!=":
+		return field != n.value
+	case "<":
+		return field < n.value
+	case "<=":
+		return field <= n.value
+	case ">":
+		return field > n.value
+	case ">=":
+		return field >= n.value
+	case "~":
+		return strings.Contains(field, n.value)
+	case "!~":
+		return !strings.Contains(field, n.value)
+	}
+
+	return false
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"slices"
 	"time"
 
 	"golang.org/x/crypto/bcrypt"
@@ -21,8 +22,31 @@ type Admin struct {
 
 	// @Description Time when the admin user was created
 	CreatedAt time.Time `json:"created_at"`
+
+	// @Description Role of the admin user: 1 - operator, 2 - supervisor, 3 - superadmin
+	Role Role `json:"role"`
+
+	// @Description Time when the password was changed last, it expires after password max age
+	PasswordChangedAt time.Time `json:"password_changed_at"`
+
+	// @Description Time when the admin user was deactivated, deactivated admins can't log in
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+
+	// APIKeyID is an id of the API key the admin was authenticated with, it's zero for humans
+	APIKeyID int `json:"-"`
+
+	// Scopes limit permissions of admins authenticated with API keys
+	Scopes []Permission `json:"-"`
 }
 
+const (
+	// ActiveAdminStatus is a status of admins that can log in
+	ActiveAdminStatus = "active"
+
+	// DeactivatedAdminStatus is a status of deactivated admins
+	DeactivatedAdminStatus = "deactivated"
+)
+
 func hashPassword(password string) (string, error) {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
 	if err != nil {
@@ -32,19 +56,78 @@ func hashPassword(password string) (string, error) {
 	return string(hashedPassword), nil
 }
 
-// NewAdmin creates an instance of Admin
-func NewAdmin(id int, username string, password string) *Admin {
-	hashedPassword, _ := hashPassword(password)
-
-	return &Admin{
+// NewAdmin creates an instance of Admin with operator role
+func NewAdmin(id int, username string, password string) (*Admin, error) {
+	admin := &Admin{
 		ID:        id,
 		Username:  username,
-		Password:  hashedPassword,
 		CreatedAt: time.Now(),
+		Role:      OperatorRole,
 	}
+
+	if err := admin.SetPassword(password); err != nil {
+		return nil, err
+	}
+
+	return admin, nil
+}
+
+// SetPassword hashes and sets a new password
+func (admin *Admin) SetPassword(password string) error {
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	admin.Password = hashedPassword
+	admin.PasswordChangedAt = time.Now()
+
+	return nil
+}
+
+// NeedsRehash checks if password was hashed with a lower cost than current bcrypt.DefaultCost
+func (admin *Admin) NeedsRehash() bool {
+	cost, err := bcrypt.Cost([]byte(admin.Password))
+
+	return err == nil && cost < bcrypt.DefaultCost
+}
+
+// Rehash hashes the same password with current cost, password age isn't changed
+func (admin *Admin) Rehash(password string) error {
+	hashedPassword, err := hashPassword(password)
+	if err != nil {
+		return err
+	}
+
+	admin.Password = hashedPassword
+
+	return nil
 }
 
 // CheckPassword checks if passwords are the same
 func (admin *Admin) CheckPassword(password string) bool {
 	return bcrypt.CompareHashAndPassword([]byte(admin.Password), []byte(password)) == nil
 }
+
+// IsActive checks if admin wasn't deactivated
+func (admin *Admin) IsActive() bool {
+	return admin.DeactivatedAt == nil
+}
+
+// Status returns ActiveAdminStatus or DeactivatedAdminStatus
+func (admin *Admin) Status() string {
+	if admin.IsActive() {
+		return ActiveAdminStatus
+	}
+
+	return DeactivatedAdminStatus
+}
+
+// HasPermission checks if admin role grants permission, API keys are checked against their scopes
+func (admin *Admin) HasPermission(permission Permission) bool {
+	if admin.APIKeyID != 0 {
+		return slices.Contains(admin.Scopes, permission)
+	}
+
+	return admin.Role.HasPermission(permission)
+}
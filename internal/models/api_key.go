@@ -0,0 +1,97 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	apiKeyTag         = "pvz"
+	apiKeyPrefixBytes = 6
+	apiKeySecretBytes = 32
+)
+
+// APIKey is a key machine clients authenticate with instead of admin credentials,
+// only a hash of the key is stored, its prefix is public and identifies the key
+type APIKey struct {
+	ID         int          `json:"id"`
+	Name       string       `json:"name"`
+	Prefix     string       `json:"prefix"`
+	SecretHash string       `json:"-"`
+	Scopes     []Permission `json:"scopes"`
+	CreatedBy  int          `json:"created_by"`
+	CreatedAt  time.Time    `json:"created_at"`
+	LastUsedAt *time.Time   `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time   `json:"revoked_at,omitempty"`
+
+	// CreatorRole and CreatorDeactivatedAt are loaded with the key, so the key stops working
+	// once its creator is deactivated or demoted
+	CreatorRole          Role       `json:"-"`
+	CreatorDeactivatedAt *time.Time `json:"-"`
+}
+
+// NewAPIKey generates an API key in "pvz_<prefix>_<secret>" format, returns key itself and its model
+func NewAPIKey(name string, scopes []Permission, createdBy int) (string, *APIKey, error) {
+	prefix := make([]byte, apiKeyPrefixBytes)
+	if _, err := rand.Read(prefix); err != nil {
+		return "", nil, err
+	}
+
+	secret := make([]byte, apiKeySecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", nil, err
+	}
+
+	apiKey := &APIKey{
+		Name:      name,
+		Prefix:    hex.EncodeToString(prefix),
+		Scopes:    scopes,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now(),
+	}
+	key := apiKeyTag + "_" + apiKey.Prefix + "_" + base64.RawURLEncoding.EncodeToString(secret)
+	apiKey.SecretHash = HashToken(key)
+
+	return key, apiKey, nil
+}
+
+// ParseAPIKeyPrefix returns prefix of a key, key must be in "pvz_<prefix>_<secret>" format
+func ParseAPIKeyPrefix(key string) (string, bool) {
+	parts := strings.SplitN(key, "_", 3)
+	if len(parts) != 3 || parts[0] != apiKeyTag || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+
+	return parts[1], true
+}
+
+// CheckSecret checks if key hashes to the stored hash, comparison takes constant time
+func (k *APIKey) CheckSecret(key string) bool {
+	return subtle.ConstantTimeCompare([]byte(HashToken(key)), []byte(k.SecretHash)) == 1
+}
+
+// IsRevoked checks if key was revoked
+func (k *APIKey) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsCreatorAllowed checks if key creator is still active and may manage keys
+func (k *APIKey) IsCreatorAllowed() bool {
+	return k.CreatorDeactivatedAt == nil && k.CreatorRole.HasPermission(ManageAdminsPermission)
+}
+
+// Principal returns an admin requests made with the key are performed as,
+// it's attributed to the key creator, but is limited to key scopes
+func (k *APIKey) Principal() Admin {
+	return Admin{
+		ID:        k.CreatedBy,
+		Username:  apiKeyTag + "_" + k.Prefix,
+		CreatedAt: k.CreatedAt,
+		APIKeyID:  k.ID,
+		Scopes:    k.Scopes,
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	minPhoneDigits = 10
+	maxPhoneDigits = 15
+)
+
+var (
+	// ErrWrongPhone happens when phone number can't be normalized
+	ErrWrongPhone = errors.New("wrong phone number")
+)
+
+// Client represents a recipient of orders
+// @Description Client structure represents a person who receives orders at the pickup point
+type Client struct {
+	// @Description Unique ID of the client, used as user_id in orders
+	// @Example 456
+	ID int `db:"id" json:"id"`
+
+	// @Description Full name of the client
+	// @Example "Ivan Ivanov"
+	Name string `db:"name" json:"name"`
+
+	// @Description Phone number of the client in E.164 format
+	// @Example "+79991234567"
+	Phone string `db:"phone" json:"phone"`
+
+	// @Description Email of the client
+	// @Example "ivan@example.com"
+	Email string `db:"email" json:"email"`
+
+	// @Description Time when the client was registered
+	CreatedAt time.Time `db:"created_at" json:"created_at"`
+}
+
+// NewClient creates an instance of Client
+func NewClient(id int, name string, phone string, email string) (*Client, error) {
+	normalizedPhone, err := NormalizePhone(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		ID:        id,
+		Name:      strings.TrimSpace(name),
+		Phone:     normalizedPhone,
+		Email:     strings.TrimSpace(email),
+		CreatedAt: time.Now(),
+	}, nil
+}
+
+// NormalizePhone brings phone number to E.164 format, so "8 (999) 123-45-67" becomes "+79991234567"
+func NormalizePhone(phone string) (string, error) {
+	digits := strings.Builder{}
+	for _, r := range strings.TrimSpace(phone) {
+		switch {
+		case unicode.IsDigit(r):
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || (r == '+' && digits.Len() == 0):
+		default:
+			return "", ErrWrongPhone
+		}
+	}
+
+	result := digits.String()
+	if len(result) == 11 && result[0] == '8' {
+		result = "7" + result[1:]
+	}
+
+	if len(result) < minPhoneDigits || len(result) > maxPhoneDigits {
+		return "", ErrWrongPhone
+	}
+
+	return "+" + result, nil
+}
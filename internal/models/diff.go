@@ -0,0 +1,120 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Rhymond/go-money"
+)
+
+// Change is a change of a single field, Old is nil for created entities and New is nil for deleted ones
+type Change struct {
+	Old interface{} `json:"old"`
+	New interface{} `json:"new"`
+}
+
+// Diff maps names of changed fields to their changes
+type Diff map[string]Change
+
+// orderFieldNames are fields of an order compared by NewOrderDiff
+var orderFieldNames = []string{
+	"user_id", "weight", "price", "packaging", "extra_packaging", "status", "arrival_date", "expiry_date",
+	"last_change",
+}
+
+// NewOrderDiff compares order before and after a change, before is nil for accepted orders
+// and after is nil for deleted ones
+func NewOrderDiff(before *Order, after *Order) Diff {
+	oldFields := orderFields(before)
+	newFields := orderFields(after)
+
+	diff := make(Diff)
+	for _, name := range orderFieldNames {
+		if oldFields[name] != newFields[name] {
+			diff[name] = Change{
+				Old: oldFields[name],
+				New: newFields[name],
+			}
+		}
+	}
+
+	return diff
+}
+
+// orderFields flattens order into comparable values that look the same after a JSON round trip
+func orderFields(order *Order) map[string]interface{} {
+	if order == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"user_id":         order.UserID,
+		"weight":          order.Weight,
+		"price":           priceValue(order.Price),
+		"packaging":       GetPackagingName(order.Packaging),
+		"extra_packaging": GetPackagingName(order.ExtraPackaging),
+		"status":          int(order.Status),
+		"arrival_date":    order.ArrivalDate.Format(time.RFC3339),
+		"expiry_date":     order.ExpiryDate.Format(time.RFC3339),
+		"last_change":     order.LastChange.Format(time.RFC3339),
+	}
+}
+
+// OldJSON returns JSON of the old value
+func (c Change) OldJSON() string {
+	return diffValue(c.Old)
+}
+
+// NewJSON returns JSON of the new value
+func (c Change) NewJSON() string {
+	return diffValue(c.New)
+}
+
+// NewChangeFromJSON creates Change from JSON of old and new values
+func NewChangeFromJSON(oldJSON string, newJSON string) (Change, error) {
+	var change Change
+	if err := json.Unmarshal([]byte(oldJSON), &change.Old); err != nil {
+		return Change{}, err
+	}
+	if err := json.Unmarshal([]byte(newJSON), &change.New); err != nil {
+		return Change{}, err
+	}
+
+	return change, nil
+}
+
+// priceValue displays price, zero price has no currency and can't be displayed
+func priceValue(price money.Money) string {
+	if price.Currency() == nil {
+		return ""
+	}
+
+	return price.Display()
+}
+
+func (d Diff) String() string {
+	names := make([]string, 0, len(d))
+	for name := range d {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	sb := strings.Builder{}
+	for _, name := range names {
+		fmt.Fprintf(&sb, "  %s: %s -> %s\n", name, d[name].OldJSON(), d[name].NewJSON())
+	}
+
+	return sb.String()
+}
+
+func diffValue(value interface{}) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return string(data)
+}
@@ -1,23 +1,98 @@
 package models
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"time"
 )
 
 // Log is a structure that contains all log data and necessary information to make a job from it
 type Log struct {
-	ID           int       `db:"id" json:"id"`
-	OrderID      int       `db:"order_id" json:"order_id"`
-	AdminID      int       `db:"admin_id" json:"admin_id"`
-	Message      string    `db:"message" json:"message"`
-	Date         time.Time `db:"date" json:"date"`
-	URL          string    `db:"url" json:"url"`
-	Method       string    `db:"method" json:"method"`
-	Status       int       `db:"status" json:"status"`
-	JobStatus    int       `db:"job_status" json:"job_status"`
-	AttemptsLeft int       `db:"attempts_left" json:"attempts_left"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+	ID            int       `db:"id" json:"id"`
+	OrderID       int       `db:"order_id" json:"order_id"`
+	AdminID       int       `db:"admin_id" json:"admin_id"`
+	APIKeyID      *int      `db:"api_key_id" json:"api_key_id,omitempty"`
+	Message       string    `db:"message" json:"message"`
+	Date          time.Time `db:"date" json:"date"`
+	URL           string    `db:"url" json:"url"`
+	Method        string    `db:"method" json:"method"`
+	Status        int       `db:"status" json:"status"`
+	JobStatus     int       `db:"job_status" json:"job_status"`
+	AttemptsLeft  int       `db:"attempts_left" json:"attempts_left"`
+	UpdatedAt     time.Time `db:"updated_at" json:"updated_at"`
+	NextAttemptAt time.Time `db:"next_attempt_at" json:"next_attempt_at"`
+	Diff          Diff      `db:"diff" json:"diff,omitempty"`
+	PrevHash      string    `db:"prev_hash" json:"prev_hash"`
+	Hash          string    `db:"hash" json:"hash"`
+}
+
+// chainDateLayout keeps wall clock with microseconds, the way date is stored in db
+const chainDateLayout = "2006-01-02T15:04:05.999999"
+
+// chainedLog is immutable content of a log that is hashed, job fields change after insert and aren't hashed
+type chainedLog struct {
+	PrevHash string `json:"prev_hash"`
+	OrderID  int    `json:"order_id"`
+	AdminID  int    `json:"admin_id"`
+	APIKeyID *int   `json:"api_key_id"`
+	Message  string `json:"message"`
+	Date     string `json:"date"`
+	URL      string `json:"url"`
+	Method   string `json:"method"`
+	Status   int    `json:"status"`
+	Diff     Diff   `json:"diff,omitempty"`
+}
+
+// ChainHash computes SHA-256 of log content chained to hash of the previous log
+func (l *Log) ChainHash(prevHash string) string {
+	data, err := json.Marshal(chainedLog{
+		PrevHash: prevHash,
+		OrderID:  l.OrderID,
+		AdminID:  l.AdminID,
+		APIKeyID: l.APIKeyID,
+		Message:  l.Message,
+		Date:     l.Date.Format(chainDateLayout),
+		URL:      l.URL,
+		Method:   l.Method,
+		Status:   l.Status,
+		Diff:     l.Diff,
+	})
+	if err != nil {
+		// diff holds values decoded from JSON, so it always marshals
+		return ""
+	}
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+// Chain links log to the previous one, date is truncated to precision of db,
+// so hash computed from stored log is the same
+func (l *Log) Chain(prevHash string) {
+	l.Date = l.Date.Truncate(time.Microsecond)
+	l.PrevHash = prevHash
+	l.Hash = l.ChainHash(prevHash)
+}
+
+// AuditChainReport is a result of audit log hash chain verification
+type AuditChainReport struct {
+	Checked     int    `json:"checked"`
+	Valid       bool   `json:"valid"`
+	BrokenLogID int    `json:"broken_log_id,omitempty"`
+	Reason      string `json:"reason,omitempty"`
+}
+
+// LogFilter is a filter of audit logs, zero fields are ignored
+type LogFilter struct {
+	AdminID   int
+	OrderID   int
+	Method    string
+	Status    int
+	From      time.Time
+	To        time.Time
+	JobStatus int
 }
 
 // NewLog creates an instance of Log
@@ -35,6 +110,11 @@ func NewLog(orderID int, adminID int, message string, url string, method string,
 }
 
 func (l *Log) String() string {
-	return fmt.Sprintf("%s\nOrder %d, admin %d:\nResponse: %s\nPath: %s\nMethod: %s\nStatus: %d\n",
+	res := fmt.Sprintf("%s\nOrder %d, admin %d:\nResponse: %s\nPath: %s\nMethod: %s\nStatus: %d\n",
 		l.Date, l.OrderID, l.AdminID, l.Message, l.URL, l.Method, l.Status)
+	if len(l.Diff) != 0 {
+		res += "Changes:\n" + l.Diff.String()
+	}
+
+	return res
 }
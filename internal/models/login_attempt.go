@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+const (
+	// UsernameLoginAttempt is a kind of login attempts tracked per username
+	UsernameLoginAttempt = "username"
+
+	// IPLoginAttempt is a kind of login attempts tracked per client ip
+	IPLoginAttempt = "ip"
+)
+
+// LoginAttempt is a counter of failed logins in a row for a username or an ip
+type LoginAttempt struct {
+	Kind           string     `db:"kind"`
+	Key            string     `db:"key"`
+	FailedAttempts int        `db:"failed_attempts"`
+	LockedUntil    *time.Time `db:"locked_until"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+}
+
+// IsLocked checks if logins are locked at the moment
+func (a *LoginAttempt) IsLocked(now time.Time) bool {
+	return a.LockedUntil != nil && a.LockedUntil.After(now)
+}
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// NotificationKind is a type of event client is notified about
+type NotificationKind string
+
+const (
+	// OrderArrivedNotification is sent when order is accepted at the pickup point
+	OrderArrivedNotification NotificationKind = "order_arrived"
+
+	// OrderGivenNotification is sent when order is given to the client
+	OrderGivenNotification NotificationKind = "order_given"
+
+	// OrderReturnedNotification is sent when client returns an order
+	OrderReturnedNotification NotificationKind = "order_returned"
+
+	// ExpiringSoonNotification is sent when order storage expires in less than a day
+	ExpiringSoonNotification NotificationKind = "expiring_soon"
+
+	// OrderExpiredNotification is sent when order storage has expired
+	OrderExpiredNotification NotificationKind = "order_expired"
+)
+
+const notificationDateLayout = "02.01.2006 15:04"
+
+// Notification is a message to a client, stored in an outbox and sent as a job
+type Notification struct {
+	ID            int              `db:"id" json:"id"`
+	OrderID       int              `db:"order_id" json:"order_id"`
+	ClientID      int              `db:"client_id" json:"client_id"`
+	Kind          NotificationKind `db:"kind" json:"kind"`
+	Message       string           `db:"message" json:"message"`
+	CreatedAt     time.Time        `db:"created_at" json:"created_at"`
+	JobStatus     int              `db:"job_status" json:"-"`
+	AttemptsLeft  int              `db:"attempts_left" json:"-"`
+	NextAttemptAt time.Time        `db:"next_attempt_at" json:"-"`
+	UpdatedAt     time.Time        `db:"updated_at" json:"-"`
+}
+
+// NewNotification creates a notification of a given kind about an order
+func NewNotification(order Order, kind NotificationKind) *Notification {
+	return &Notification{
+		OrderID:   order.ID,
+		ClientID:  order.UserID,
+		Kind:      kind,
+		Message:   notificationMessage(order, kind),
+		CreatedAt: time.Now(),
+	}
+}
+
+func notificationMessage(order Order, kind NotificationKind) string {
+	switch kind {
+	case OrderArrivedNotification:
+		return fmt.Sprintf("Order %d has arrived at the pickup point, it is stored until %s",
+			order.ID, order.ExpiryDate.Format(notificationDateLayout))
+	case OrderGivenNotification:
+		return fmt.Sprintf("Order %d was given to you", order.ID)
+	case OrderReturnedNotification:
+		return fmt.Sprintf("Order %d return was accepted", order.ID)
+	case ExpiringSoonNotification:
+		return fmt.Sprintf("Order %d storage expires at %s, pick it up before it is sent back",
+			order.ID, order.ExpiryDate.Format(notificationDateLayout))
+	case OrderExpiredNotification:
+		return fmt.Sprintf("Order %d storage has expired, it will be sent back", order.ID)
+	}
+
+	return fmt.Sprintf("Order %d was updated", order.ID)
+}
+
+func (n *Notification) String() string {
+	return fmt.Sprintf("%s\nClient %d, order %d [%s]: %s\n",
+		n.CreatedAt, n.ClientID, n.OrderID, n.Kind, n.Message)
+}
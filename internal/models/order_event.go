@@ -0,0 +1,66 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// OrderEventType is a type of order domain event published to Kafka
+type OrderEventType string
+
+const (
+	// OrderAccepted happens when order is accepted at the pickup point
+	OrderAccepted OrderEventType = "OrderAccepted"
+
+	// OrderGiven happens when order is given to the client
+	OrderGiven OrderEventType = "OrderGiven"
+
+	// OrderReturned happens when client returns an order
+	OrderReturned OrderEventType = "OrderReturned"
+
+	// OrderReturnedToCourier happens when expired order is returned to the courier
+	OrderReturnedToCourier OrderEventType = "OrderReturnedToCourier"
+
+	// OrderDeleted happens when order returned to the courier is removed from the pickup point
+	OrderDeleted OrderEventType = "OrderDeleted"
+)
+
+// OrderEvent is an order domain event saved to the outbox, it's sent to Kafka as a job
+type OrderEvent struct {
+	ID            int            `db:"id" json:"id"`
+	OrderID       int            `db:"order_id" json:"order_id"`
+	Type          OrderEventType `db:"event_type" json:"type"`
+	Payload       []byte         `db:"payload" json:"-"`
+	CreatedAt     time.Time      `db:"created_at" json:"created_at"`
+	JobStatus     int            `db:"job_status" json:"job_status"`
+	AttemptsLeft  int            `db:"attempts_left" json:"attempts_left"`
+	NextAttemptAt time.Time      `db:"next_attempt_at" json:"next_attempt_at"`
+	UpdatedAt     time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+// OrderEventPayload is a body of an order event message
+type OrderEventPayload struct {
+	Type       OrderEventType `json:"type"`
+	OrderID    int            `json:"order_id"`
+	Order      Order          `json:"order"`
+	OccurredAt time.Time      `json:"occurred_at"`
+}
+
+// NewOrderEvent creates an event about an order with the order as a payload
+func NewOrderEvent(order Order, eventType OrderEventType) (*OrderEvent, error) {
+	payload, err := json.Marshal(OrderEventPayload{
+		Type:       eventType,
+		OrderID:    order.ID,
+		Order:      order,
+		OccurredAt: time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrderEvent{
+		OrderID: order.ID,
+		Type:    eventType,
+		Payload: payload,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package models
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const pickupCodeDigits = 6
+
+// PickupCode is a one-time code the client tells to get an order, only its hash is stored
+type PickupCode struct {
+	OrderID        int
+	CodeHash       string
+	FailedAttempts int
+	LockedUntil    time.Time
+	CreatedAt      time.Time
+}
+
+func generatePickupCode() (string, error) {
+	upperBound := big.NewInt(1)
+	for range pickupCodeDigits {
+		upperBound.Mul(upperBound, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, upperBound)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%0*d", pickupCodeDigits, n.Int64()), nil
+}
+
+// NewPickupCode generates new code for an order, returns it along with its hashed version
+func NewPickupCode(orderID int) (*PickupCode, string, error) {
+	code, err := generatePickupCode()
+	if err != nil {
+		return nil, "", err
+	}
+
+	hashedCode, err := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &PickupCode{
+		OrderID:   orderID,
+		CodeHash:  string(hashedCode),
+		CreatedAt: time.Now(),
+	}, code, nil
+}
+
+// CheckCode checks if code matches the stored hash
+func (p *PickupCode) CheckCode(code string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(p.CodeHash), []byte(code)) == nil
+}
+
+// IsLocked checks if code is locked after too many failed attempts
+func (p *PickupCode) IsLocked(now time.Time) bool {
+	return now.Before(p.LockedUntil)
+}
@@ -0,0 +1,142 @@
+package models
+
+import (
+	"errors"
+	"slices"
+)
+
+// Role is a type for admin roles in db
+type Role int
+
+const (
+	// OperatorRole is a role of pickup point operator, it can handle orders and clients
+	OperatorRole Role = iota + 1
+
+	// SupervisorRole is a role of pickup point supervisor, it can also delete orders and manage webhooks
+	SupervisorRole
+
+	// SuperadminRole is a role that can do everything, including admins management
+	SuperadminRole
+)
+
+// Permission is an action admin is allowed to perform
+type Permission string
+
+const (
+	// ReadOrdersPermission allows to get orders
+	ReadOrdersPermission Permission = "orders:read"
+
+	// AcceptOrdersPermission allows to accept orders from couriers
+	AcceptOrdersPermission Permission = "orders:accept"
+
+	// WriteOrdersPermission allows to give and return orders and regenerate pickup codes
+	WriteOrdersPermission Permission = "orders:write"
+
+	// DeleteOrdersPermission allows to return expired orders to courier
+	DeleteOrdersPermission Permission = "orders:delete"
+
+	// ManageClientsPermission allows to register and find clients
+	ManageClientsPermission Permission = "clients:manage"
+
+	// ManageWebhooksPermission allows to manage webhook subscriptions and read their delivery log
+	ManageWebhooksPermission Permission = "webhooks:manage"
+
+	// ReadLogsPermission allows to read audit logs
+	ReadLogsPermission Permission = "logs:read"
+
+	// ManageLogsPermission allows to requeue audit jobs that weren't sent to Kafka
+	ManageLogsPermission Permission = "logs:manage"
+
+	// ManageAdminsPermission allows to list, create, update, deactivate and delete admins and their API keys
+	ManageAdminsPermission Permission = "admins:manage"
+)
+
+var (
+	// ErrUnknownRole happens when role name is not known
+	ErrUnknownRole = errors.New("unknown role")
+
+	// ErrUnknownScope happens when API key scope is not known or can't be granted to a key
+	ErrUnknownScope = errors.New("unknown scope")
+)
+
+var roleNames = map[Role]string{
+	OperatorRole:   "operator",
+	SupervisorRole: "supervisor",
+	SuperadminRole: "superadmin",
+}
+
+// apiKeyScopes are permissions that may be granted to API keys, keys can't manage admins or keys
+var apiKeyScopes = []Permission{
+	ReadOrdersPermission,
+	AcceptOrdersPermission,
+	WriteOrdersPermission,
+	DeleteOrdersPermission,
+	ManageClientsPermission,
+	ManageWebhooksPermission,
+	ReadLogsPermission,
+}
+
+var rolePermissions = map[Role][]Permission{
+	OperatorRole: {
+		ReadOrdersPermission,
+		AcceptOrdersPermission,
+		WriteOrdersPermission,
+		ManageClientsPermission,
+	},
+	SupervisorRole: {
+		ReadOrdersPermission,
+		AcceptOrdersPermission,
+		WriteOrdersPermission,
+		ManageClientsPermission,
+		DeleteOrdersPermission,
+		ManageWebhooksPermission,
+	},
+	SuperadminRole: {
+		ReadOrdersPermission,
+		AcceptOrdersPermission,
+		WriteOrdersPermission,
+		ManageClientsPermission,
+		DeleteOrdersPermission,
+		ManageWebhooksPermission,
+		ReadLogsPermission,
+		ManageLogsPermission,
+		ManageAdminsPermission,
+	},
+}
+
+// ParseRole parses role by its name, empty name is an operator
+func ParseRole(name string) (Role, error) {
+	if name == "" {
+		return OperatorRole, nil
+	}
+
+	for role, roleName := range roleNames {
+		if roleName == name {
+			return role, nil
+		}
+	}
+
+	return 0, ErrUnknownRole
+}
+
+func (r Role) String() string {
+	if name, ok := roleNames[r]; ok {
+		return name
+	}
+
+	return "unknown"
+}
+
+// HasPermission checks if role grants permission
+func (r Role) HasPermission(permission Permission) bool {
+	return slices.Contains(rolePermissions[r], permission)
+}
+
+// ParseScope parses API key scope by its name
+func ParseScope(name string) (Permission, error) {
+	if !slices.Contains(apiKeyScopes, Permission(name)) {
+		return "", ErrUnknownScope
+	}
+
+	return Permission(name), nil
+}
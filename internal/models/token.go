@@ -0,0 +1,61 @@
+package models
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"time"
+)
+
+const refreshTokenBytes = 32
+
+// RefreshToken is a long-lived token exchanged for a new pair of tokens, only its hash is stored
+type RefreshToken struct {
+	ID        int        `db:"id"`
+	AdminID   int        `db:"admin_id"`
+	Username  string     `db:"username"`
+	TokenHash string     `db:"token_hash"`
+	ExpiresAt time.Time  `db:"expires_at"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+// NewRefreshToken generates a refresh token for an admin, returns token itself and its model
+func NewRefreshToken(admin Admin, ttl time.Duration) (string, *RefreshToken, error) {
+	raw := make([]byte, refreshTokenBytes)
+	if _, err := rand.Read(raw); err != nil {
+		return "", nil, err
+	}
+
+	token := base64.RawURLEncoding.EncodeToString(raw)
+	now := time.Now()
+
+	return token, &RefreshToken{
+		AdminID:   admin.ID,
+		Username:  admin.Username,
+		TokenHash: HashToken(token),
+		ExpiresAt: now.Add(ttl),
+		CreatedAt: now,
+	}, nil
+}
+
+// HashToken hashes token to store and look it up, tokens are random enough to not need salt
+func HashToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// IsRevoked checks if token was already used or revoked
+func (t *RefreshToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// TokenPair is a pair of tokens issued on login and refresh
+type TokenPair struct {
+	AccessToken      string    `json:"access_token"`
+	AccessExpiresAt  time.Time `json:"access_expires_at"`
+	RefreshToken     string    `json:"refresh_token"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
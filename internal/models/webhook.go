@@ -0,0 +1,96 @@
+package models
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// WebhookEvent is a type of order lifecycle event partners can subscribe to
+type WebhookEvent string
+
+const (
+	// OrderAcceptedEvent happens when order is accepted at the pickup point
+	OrderAcceptedEvent WebhookEvent = "order.accepted"
+
+	// OrderGivenEvent happens when order is given to the client
+	OrderGivenEvent WebhookEvent = "order.given"
+
+	// OrderReturnedEvent happens when client returns an order
+	OrderReturnedEvent WebhookEvent = "order.returned"
+
+	// OrderDeletedEvent happens when expired order is sent back to the courier
+	OrderDeletedEvent WebhookEvent = "order.deleted"
+
+	// OrderExpiredEvent happens when order storage expires
+	OrderExpiredEvent WebhookEvent = "order.expired"
+)
+
+var (
+	// ErrUnknownWebhookEvent happens when event type is not supported
+	ErrUnknownWebhookEvent = errors.New("unknown webhook event")
+)
+
+// ParseWebhookEvent checks that event type is supported
+func ParseWebhookEvent(event string) (WebhookEvent, error) {
+	switch WebhookEvent(event) {
+	case OrderAcceptedEvent, OrderGivenEvent, OrderReturnedEvent, OrderDeletedEvent, OrderExpiredEvent:
+		return WebhookEvent(event), nil
+	}
+
+	return "", ErrUnknownWebhookEvent
+}
+
+// WebhookSubscription is a partner endpoint that is pushed order events
+type WebhookSubscription struct {
+	ID        int            `json:"id"`
+	URL       string         `json:"url"`
+	Secret    string         `json:"-"`
+	Events    []WebhookEvent `json:"events"`
+	CreatedAt time.Time      `json:"created_at"`
+}
+
+// WebhookPayload is a body of a webhook request
+type WebhookPayload struct {
+	Event      WebhookEvent `json:"event"`
+	OrderID    int          `json:"order_id"`
+	Order      Order        `json:"order"`
+	OccurredAt time.Time    `json:"occurred_at"`
+}
+
+// NewWebhookPayload creates a JSON payload of an event about an order
+func NewWebhookPayload(order Order, event WebhookEvent) ([]byte, error) {
+	return json.Marshal(WebhookPayload{
+		Event:      event,
+		OrderID:    order.ID,
+		Order:      order,
+		OccurredAt: time.Now(),
+	})
+}
+
+// WebhookDelivery is an attempt to deliver an event to a subscription, sent as a job
+type WebhookDelivery struct {
+	ID             int          `db:"id" json:"id"`
+	SubscriptionID int          `db:"subscription_id" json:"subscription_id"`
+	Event          WebhookEvent `db:"event_type" json:"event"`
+	OrderID        int          `db:"order_id" json:"order_id"`
+	Payload        []byte       `db:"payload" json:"-"`
+	ResponseStatus int          `db:"response_status" json:"response_status"`
+	LastError      string       `db:"last_error" json:"last_error"`
+	CreatedAt      time.Time    `db:"created_at" json:"created_at"`
+	JobStatus      int          `db:"job_status" json:"job_status"`
+	AttemptsLeft   int          `db:"attempts_left" json:"attempts_left"`
+	NextAttemptAt  time.Time    `db:"next_attempt_at" json:"next_attempt_at"`
+	UpdatedAt      time.Time    `db:"updated_at" json:"updated_at"`
+}
+
+// SignWebhookPayload signs payload with HMAC-SHA256 using subscription secret
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,138 @@
+package password
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultMinLength is a minimal password length used when none is configured
+	DefaultMinLength = 8
+
+	// MaxLength is the longest password bcrypt can hash, longer ones are truncated by it
+	MaxLength = 72
+
+	// DefaultMaxAge is a password lifetime used when none is configured
+	DefaultMaxAge = 90 * 24 * time.Hour
+
+	// DefaultHistory is a number of previous passwords that can't be reused when none is configured
+	DefaultHistory = 5
+)
+
+var (
+	// ErrPolicyViolation is wrapped by all errors of password validation
+	ErrPolicyViolation = errors.New("password violates policy")
+
+	// ErrTooShort happens when password is shorter than policy allows
+	ErrTooShort = errors.New("password is too short")
+
+	// ErrTooLong happens when password is longer than MaxLength bytes
+	ErrTooLong = errors.New("password is too long")
+
+	// ErrBreached happens when password is in the list of breached passwords
+	ErrBreached = errors.New("password is known to be breached")
+
+	// ErrSameAsUsername happens when password equals username
+	ErrSameAsUsername = errors.New("password must differ from username")
+)
+
+// Policy is a set of rules passwords of admins must follow
+type Policy struct {
+	minLength int
+	maxAge    time.Duration
+	history   int
+	breached  map[string]struct{}
+}
+
+// NewPolicy creates an instance of Policy, zero values are replaced with defaults.
+// Breached passwords are compared case-insensitively
+func NewPolicy(minLength int, maxAge time.Duration, history int, breached []string) *Policy {
+	if minLength <= 0 {
+		minLength = DefaultMinLength
+	}
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+	if history <= 0 {
+		history = DefaultHistory
+	}
+
+	breachedSet := make(map[string]struct{}, len(breached))
+	for _, password := range breached {
+		breachedSet[strings.ToLower(password)] = struct{}{}
+	}
+
+	return &Policy{
+		minLength: minLength,
+		maxAge:    maxAge,
+		history:   history,
+		breached:  breachedSet,
+	}
+}
+
+// LoadBreached reads breached passwords from a file, one per line, empty lines
+// and lines starting with # are skipped. Empty path means there is no list
+func LoadBreached(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open breached passwords file: %w", err)
+	}
+	defer file.Close()
+
+	var breached []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		breached = append(breached, line)
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("read breached passwords file: %w", err)
+	}
+
+	return breached, nil
+}
+
+// Validate checks if password of an admin with username follows the policy,
+// returned errors wrap ErrPolicyViolation
+func (p *Policy) Validate(username string, password string) error {
+	if len([]rune(password)) < p.minLength {
+		return fmt.Errorf("%w: %w, at least %d characters required", ErrPolicyViolation, ErrTooShort, p.minLength)
+	}
+
+	if len(password) > MaxLength {
+		return fmt.Errorf("%w: %w, at most %d bytes allowed", ErrPolicyViolation, ErrTooLong, MaxLength)
+	}
+
+	if strings.EqualFold(password, username) {
+		return fmt.Errorf("%w: %w", ErrPolicyViolation, ErrSameAsUsername)
+	}
+
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		return fmt.Errorf("%w: %w", ErrPolicyViolation, ErrBreached)
+	}
+
+	return nil
+}
+
+// IsExpired checks if password changed at changedAt has to be changed at now
+func (p *Policy) IsExpired(changedAt time.Time, now time.Time) bool {
+	return now.Sub(changedAt) > p.maxAge
+}
+
+// History returns number of previous passwords that can't be reused
+func (p *Policy) History() int {
+	return p.history
+}
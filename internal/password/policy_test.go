@@ -0,0 +1,50 @@
+package password
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+	policy := NewPolicy(10, 0, 0, []string{"Password123"})
+
+	assert.ErrorIs(t, policy.Validate("admin", "short"), ErrTooShort)
+	assert.ErrorIs(t, policy.Validate("admin", "short"), ErrPolicyViolation)
+	assert.ErrorIs(t, policy.Validate("admin", string(make([]byte, MaxLength+1))), ErrTooLong)
+	assert.ErrorIs(t, policy.Validate("administrator", "Administrator"), ErrSameAsUsername)
+	assert.ErrorIs(t, policy.Validate("admin", "password123"), ErrBreached)
+	assert.NoError(t, policy.Validate("admin", "correct horse battery"))
+}
+
+func TestPolicy_IsExpired(t *testing.T) {
+	t.Parallel()
+	policy := NewPolicy(0, time.Hour, 0, nil)
+	now := time.Now()
+
+	assert.False(t, policy.IsExpired(now.Add(-time.Minute), now))
+	assert.True(t, policy.IsExpired(now.Add(-2*time.Hour), now))
+	assert.Equal(t, DefaultHistory, policy.History())
+}
+
+func TestLoadBreached(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "breached.txt")
+	require.NoError(t, os.WriteFile(path, []byte("# top passwords\n123456\n\n qwerty \n"), 0o600))
+
+	breached, err := LoadBreached(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"123456", "qwerty"}, breached)
+
+	breached, err = LoadBreached("")
+	require.NoError(t, err)
+	assert.Empty(t, breached)
+
+	_, err = LoadBreached(filepath.Join(t.TempDir(), "missing.txt"))
+	assert.Error(t, err)
+}
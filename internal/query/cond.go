@@ -21,6 +21,12 @@ const (
 
 	// GreaterThan is >
 	GreaterThan
+
+	// IsNull is IS NULL, value of such conditional is ignored
+	IsNull
+
+	// IsNotNull is IS NOT NULL, value of such conditional is ignored
+	IsNotNull
 )
 
 // Cond is a structure for conditional
@@ -66,6 +72,27 @@ func NotEqual(field string, value interface{}) Cond {
 	}
 }
 
+// Null creates conditional for IsNull
+func Null(field string) Cond {
+	return Cond{
+		Operator: IsNull,
+		Field:    field,
+	}
+}
+
+// NotNull creates conditional for IsNotNull
+func NotNull(field string) Cond {
+	return Cond{
+		Operator: IsNotNull,
+		Field:    field,
+	}
+}
+
+// HasValue checks if conditional compares field with a value
+func (c *Cond) HasValue() bool {
+	return c.Operator != IsNull && c.Operator != IsNotNull
+}
+
 func (c *Cond) String() string {
 	switch c.Operator {
 	case Equals:
@@ -78,6 +105,10 @@ func (c *Cond) String() string {
 		return ">"
 	case LessEqualThan:
 		return "<="
+	case IsNull:
+		return "IS NULL"
+	case IsNotNull:
+		return "IS NOT NULL"
 	default:
 		return "<"
 	}
@@ -42,7 +42,7 @@ func BuildSelectQuery(table string, params ...Param) (string, []interface{}) {
 
 	sb.WriteString("SELECT * FROM ")
 	sb.WriteString(s.from)
-	if len(s.args) != 0 {
+	if len(s.wheres) != 0 {
 		sb.WriteString(" WHERE ")
 		sb.WriteString(strings.Join(s.wheres, " AND "))
 	}
@@ -78,11 +78,13 @@ func Where(conds ...Cond) Param {
 		for _, cond := range conds {
 			sb.WriteString(cond.Field + " ")
 			sb.WriteString(cond.String())
-			sb.WriteString(fmt.Sprintf(" $%d", s.currentIndex))
+			if cond.HasValue() {
+				sb.WriteString(fmt.Sprintf(" $%d", s.currentIndex))
 
-			s.currentIndex++
+				s.currentIndex++
 
-			s.args = append(s.args, cond.Value)
+				s.args = append(s.args, cond.Value)
+			}
 			s.wheres = append(s.wheres, sb.String())
 
 			sb.Reset()
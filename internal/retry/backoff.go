@@ -0,0 +1,17 @@
+package retry
+
+import "time"
+
+// Backoff returns delay before the next attempt, it starts at base and doubles
+// after every failed attempt, but never exceeds max
+func Backoff(base time.Duration, max time.Duration, failedAttempts int) time.Duration {
+	delay := base
+	for i := 1; i < failedAttempts; i++ {
+		delay *= 2
+		if delay >= max {
+			return max
+		}
+	}
+
+	return delay
+}
@@ -0,0 +1,32 @@
+package retry
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	base := 30 * time.Second
+	maxDelay := time.Hour
+
+	assert.Equal(t, base, Backoff(base, maxDelay, 0))
+	assert.Equal(t, base, Backoff(base, maxDelay, 1))
+	assert.Equal(t, 2*base, Backoff(base, maxDelay, 2))
+	assert.Equal(t, 8*base, Backoff(base, maxDelay, 4))
+	assert.Equal(t, maxDelay, Backoff(base, maxDelay, 100))
+}
+
+func TestJitter(t *testing.T) {
+	t.Parallel()
+	delay := 10 * time.Second
+
+	for i := 0; i < 100; i++ {
+		jittered := Jitter(delay)
+		assert.GreaterOrEqual(t, jittered, delay/2)
+		assert.LessOrEqual(t, jittered, delay)
+	}
+	assert.Equal(t, time.Duration(1), Jitter(1))
+}
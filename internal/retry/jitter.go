@@ -0,0 +1,17 @@
+package retry
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Jitter returns a random delay between half of delay and delay itself,
+// so jobs that failed together aren't retried at the same moment
+func Jitter(delay time.Duration) time.Duration {
+	half := delay / 2
+	if half <= 0 {
+		return delay
+	}
+
+	return half + rand.N(delay-half+1)
+}
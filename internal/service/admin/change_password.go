@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// ChangePassword sets a new password of an admin whose identity is already checked. New password must
+// follow the policy and differ from current and last previous passwords, current one is moved to history
+func (s *Service) ChangePassword(ctx context.Context, admin models.Admin, newPassword string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ChangePassword")
+	defer span.Finish()
+
+	if err := s.policy.Validate(admin.Username, newPassword); err != nil {
+		s.logger.Error("password violates policy",
+			zap.String("username", admin.Username),
+			zap.Error(err),
+		)
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	history, err := s.Storage.GetPasswordHistory(ctx, admin.ID, s.policy.History())
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	for _, hash := range append(history, admin.Password) {
+		previous := models.Admin{Password: hash}
+		if previous.CheckPassword(newPassword) {
+			s.logger.Error(ErrPasswordReused.Error(),
+				zap.String("username", admin.Username),
+				zap.Error(ErrPasswordReused),
+			)
+			span.SetTag("error", ErrPasswordReused)
+
+			return ErrPasswordReused
+		}
+	}
+
+	err = s.Storage.AddPasswordHistory(ctx, admin.ID, admin.Password, admin.PasswordChangedAt)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	if err = admin.SetPassword(newPassword); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	s.logger.Info("admin password changed",
+		zap.String("username", admin.Username),
+	)
+
+	return s.Storage.UpdateAdmin(ctx, admin.ID, admin)
+}
@@ -9,8 +9,8 @@ import (
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 )
 
-// CreateAdmin creates admin
-func (s *Service) CreateAdmin(ctx context.Context, admin models.Admin) error {
+// CreateAdmin creates admin with id, username and role of passed admin and a given password
+func (s *Service) CreateAdmin(ctx context.Context, admin models.Admin, password string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateAdmin")
 	defer span.Finish()
 
@@ -46,5 +46,23 @@ func (s *Service) CreateAdmin(ctx context.Context, admin models.Admin) error {
 		return ErrIDUsed
 	}
 
-	return s.Storage.CreateAdmin(ctx, admin)
+	if err = s.policy.Validate(admin.Username, password); err != nil {
+		s.logger.Error("password violates policy",
+			zap.String("username", admin.Username),
+			zap.Error(err),
+		)
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	newAdmin, err := models.NewAdmin(admin.ID, admin.Username, password)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	newAdmin.Role = admin.Role
+
+	return s.Storage.CreateAdmin(ctx, *newAdmin)
 }
@@ -0,0 +1,67 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// DeactivateAdmin forbids admin to log in, unlike deletion the admin is kept with their audit logs.
+// Deactivating already deactivated admin keeps the original deactivation time
+func (s *Service) DeactivateAdmin(ctx context.Context, username string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeactivateAdmin")
+	defer span.Finish()
+
+	admin, err := s.GetAdminByUsername(ctx, username)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if !admin.IsActive() {
+		return nil
+	}
+
+	now := time.Now()
+	if err = s.Storage.SetAdminDeactivatedAt(ctx, username, &now); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	s.logger.Info("admin deactivated",
+		zap.String("username", username),
+	)
+
+	return nil
+}
+
+// ReactivateAdmin allows deactivated admin to log in again
+func (s *Service) ReactivateAdmin(ctx context.Context, username string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ReactivateAdmin")
+	defer span.Finish()
+
+	admin, err := s.GetAdminByUsername(ctx, username)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if admin.IsActive() {
+		return nil
+	}
+
+	if err = s.Storage.SetAdminDeactivatedAt(ctx, username, nil); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	s.logger.Info("admin reactivated",
+		zap.String("username", username),
+	)
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+const (
+	roleField          = "role"
+	deactivatedAtField = "deactivated_at"
+)
+
+// ListAdmins gets a page of admins ordered by id, zero role and empty status are ignored,
+// status is either models.ActiveAdminStatus or models.DeactivatedAdminStatus
+func (s *Service) ListAdmins(ctx context.Context, role models.Role, status string, count int,
+	page int) ([]models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ListAdmins")
+	defer span.Finish()
+
+	conds := make([]query.Cond, 0, 2)
+	if role != 0 {
+		conds = append(conds, query.Equal(roleField, role))
+	}
+
+	switch status {
+	case "":
+	case models.ActiveAdminStatus:
+		conds = append(conds, query.Null(deactivatedAtField))
+	case models.DeactivatedAdminStatus:
+		conds = append(conds, query.NotNull(deactivatedAtField))
+	default:
+		s.logger.Error(ErrUnknownStatus.Error(),
+			zap.String("status", status),
+			zap.Error(ErrUnknownStatus),
+		)
+		span.SetTag("error", ErrUnknownStatus)
+
+		return nil, ErrUnknownStatus
+	}
+
+	admins, err := s.Storage.ListAdmins(ctx, conds, count, page)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+
+	return admins, nil
+}
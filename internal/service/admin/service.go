@@ -3,10 +3,14 @@ package admin
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
 
 type adminStorage interface {
@@ -16,11 +20,16 @@ type adminStorage interface {
 	DeleteAdmin(context.Context, string) error
 	ContainsUsername(context.Context, string) (bool, error)
 	ContainsID(context.Context, int) (bool, error)
+	GetPasswordHistory(context.Context, int, int) ([]string, error)
+	AddPasswordHistory(context.Context, int, string, time.Time) error
+	ListAdmins(context.Context, []query.Cond, int, int) ([]models.Admin, error)
+	SetAdminDeactivatedAt(context.Context, string, *time.Time) error
 }
 
 // Service is a struct for admin service
 type Service struct {
 	Storage adminStorage
+	policy  *password.Policy
 	logger  *zap.Logger
 }
 
@@ -36,12 +45,19 @@ var (
 
 	// ErrWrongPassword happens when wrong password was passed
 	ErrWrongPassword = errors.New("wrong password")
+
+	// ErrUnknownStatus happens when admin status filter is neither active nor deactivated
+	ErrUnknownStatus = errors.New("unknown admin status")
+
+	// ErrPasswordReused happens when new password matches current or one of previous passwords
+	ErrPasswordReused = fmt.Errorf("%w: password was used recently", password.ErrPolicyViolation)
 )
 
-// NewService creates instance of admin Service
-func NewService(logger *zap.Logger, storage adminStorage) *Service {
+// NewService creates instance of admin Service, passwords are checked against policy
+func NewService(logger *zap.Logger, storage adminStorage, policy *password.Policy) *Service {
 	return &Service{
 		Storage: storage,
+		policy:  policy,
 		logger:  logger,
 	}
 }
@@ -4,12 +4,10 @@ import (
 	"context"
 
 	"go.uber.org/zap"
-
-	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 )
 
-// UpdateAdmin updates admin with new fields
-func (s *Service) UpdateAdmin(ctx context.Context, username string, password string, admin models.Admin) error {
+// UpdateAdmin changes password of an admin after checking their current password
+func (s *Service) UpdateAdmin(ctx context.Context, username string, password string, newPassword string) error {
 	ok, err := s.ContainsUsername(ctx, username)
 	if err != nil {
 		return err
@@ -36,5 +34,5 @@ func (s *Service) UpdateAdmin(ctx context.Context, username string, password str
 		return ErrWrongPassword
 	}
 
-	return s.UpdateAdmin(ctx, username, password, admin)
+	return s.ChangePassword(ctx, someAdmin, newPassword)
 }
@@ -0,0 +1,79 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Authenticate checks API key and returns an admin requests made with it are performed as,
+// the admin has key scopes as permissions and is attributed to the key creator. Key is rejected
+// once its creator is deactivated or can't manage admins anymore
+func (s *Service) Authenticate(ctx context.Context, key string) (models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AuthenticateAPIKey")
+	defer span.Finish()
+
+	prefix, ok := models.ParseAPIKeyPrefix(key)
+	if !ok {
+		span.SetTag("error", ErrInvalidAPIKey)
+
+		return models.Admin{}, ErrInvalidAPIKey
+	}
+
+	ok, err := s.storage.ContainsAPIKey(ctx, prefix)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Admin{}, err
+	}
+	if !ok {
+		span.SetTag("error", ErrInvalidAPIKey)
+
+		return models.Admin{}, ErrInvalidAPIKey
+	}
+
+	apiKey, err := s.storage.GetAPIKey(ctx, prefix)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Admin{}, err
+	}
+
+	if !apiKey.CheckSecret(key) || apiKey.IsRevoked() {
+		s.logger.Warn(ErrInvalidAPIKey.Error(),
+			zap.String("prefix", prefix),
+			zap.Bool("revoked", apiKey.IsRevoked()),
+		)
+		span.SetTag("error", ErrInvalidAPIKey)
+
+		return models.Admin{}, ErrInvalidAPIKey
+	}
+
+	if !apiKey.IsCreatorAllowed() {
+		s.logger.Warn(ErrInvalidAPIKey.Error(),
+			zap.String("prefix", prefix),
+			zap.Int("created_by", apiKey.CreatedBy),
+			zap.Bool("creator_deactivated", apiKey.CreatorDeactivatedAt != nil),
+		)
+		span.SetTag("error", ErrInvalidAPIKey)
+
+		return models.Admin{}, ErrInvalidAPIKey
+	}
+
+	now := time.Now()
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) >= lastUsedPrecision {
+		// key is valid anyway, so failing to track its usage doesn't fail the request
+		if err = s.storage.TouchAPIKey(ctx, apiKey.ID, now); err != nil {
+			s.logger.Error("failed to track api key usage",
+				zap.String("prefix", prefix),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return apiKey.Principal(), nil
+}
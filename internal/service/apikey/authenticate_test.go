@@ -0,0 +1,132 @@
+package apikey
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	key, apiKey, err := models.NewAPIKey("reports", []models.Permission{models.ReadLogsPermission}, 7)
+	assert.NoError(t, err)
+	apiKey.ID = 3
+	apiKey.CreatorRole = models.SuperadminRole
+
+	recently := time.Now().Add(-time.Second)
+	longAgo := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		key           string
+		mockSetup     func(*MockapiKeyStorage)
+		expectedError error
+	}{
+		{
+			name: "Valid key used long ago",
+			key:  key,
+			mockSetup: func(storage *MockapiKeyStorage) {
+				stored := *apiKey
+				stored.LastUsedAt = &longAgo
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(stored, nil).Times(1)
+				storage.EXPECT().TouchAPIKey(gomock.Any(), 3, gomock.Any()).Return(nil).Times(1)
+			},
+		},
+		{
+			name: "Valid key used recently",
+			key:  key,
+			mockSetup: func(storage *MockapiKeyStorage) {
+				stored := *apiKey
+				stored.LastUsedAt = &recently
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(stored, nil).Times(1)
+			},
+		},
+		{
+			name:          "Malformed key",
+			key:           "not-a-key",
+			mockSetup:     func(_ *MockapiKeyStorage) {},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name: "Unknown prefix",
+			key:  "pvz_000000000000_secret",
+			mockSetup: func(storage *MockapiKeyStorage) {
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), "000000000000").Return(false, nil).Times(1)
+			},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name: "Wrong secret",
+			key:  "pvz_" + apiKey.Prefix + "_secret",
+			mockSetup: func(storage *MockapiKeyStorage) {
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil).Times(1)
+			},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name: "Revoked key",
+			key:  key,
+			mockSetup: func(storage *MockapiKeyStorage) {
+				stored := *apiKey
+				stored.RevokedAt = &recently
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(stored, nil).Times(1)
+			},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name: "Creator deactivated",
+			key:  key,
+			mockSetup: func(storage *MockapiKeyStorage) {
+				stored := *apiKey
+				stored.CreatorDeactivatedAt = &recently
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(stored, nil).Times(1)
+			},
+			expectedError: ErrInvalidAPIKey,
+		},
+		{
+			name: "Creator demoted",
+			key:  key,
+			mockSetup: func(storage *MockapiKeyStorage) {
+				stored := *apiKey
+				stored.CreatorRole = models.SupervisorRole
+				storage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(1)
+				storage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(stored, nil).Times(1)
+			},
+			expectedError: ErrInvalidAPIKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMockapiKeyStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			admin, err := service.Authenticate(t.Context(), tt.key)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.Equal(t, 7, admin.ID)
+				assert.Equal(t, 3, admin.APIKeyID)
+				assert.True(t, admin.HasPermission(models.ReadLogsPermission))
+				assert.False(t, admin.HasPermission(models.ReadOrdersPermission))
+			}
+		})
+	}
+}
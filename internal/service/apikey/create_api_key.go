@@ -0,0 +1,61 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// CreateAPIKey creates API key with given scopes on behalf of an admin.
+// Returned key is the only time it is shown, only its hash is stored
+func (s *Service) CreateAPIKey(ctx context.Context, creatorID int, name string,
+	scopes []string) (string, models.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateAPIKey")
+	defer span.Finish()
+
+	if name == "" {
+		span.SetTag("error", ErrNoName)
+
+		return "", models.APIKey{}, ErrNoName
+	}
+
+	if len(scopes) == 0 {
+		span.SetTag("error", ErrNoScopes)
+
+		return "", models.APIKey{}, ErrNoScopes
+	}
+
+	permissions := make([]models.Permission, 0, len(scopes))
+	for _, scope := range scopes {
+		permission, err := models.ParseScope(scope)
+		if err != nil {
+			s.logger.Error(err.Error(),
+				zap.String("scope", scope),
+				zap.Error(err),
+			)
+			span.SetTag("error", err)
+
+			return "", models.APIKey{}, err
+		}
+		permissions = append(permissions, permission)
+	}
+
+	key, apiKey, err := models.NewAPIKey(name, permissions, creatorID)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return "", models.APIKey{}, err
+	}
+
+	apiKey.ID, err = s.storage.CreateAPIKey(ctx, *apiKey)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return "", models.APIKey{}, err
+	}
+
+	return key, *apiKey, nil
+}
@@ -0,0 +1,91 @@
+package apikey
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_CreateAPIKey(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		keyName       string
+		scopes        []string
+		mockSetup     func(*MockapiKeyStorage)
+		expectedError error
+	}{
+		{
+			name:    "Valid key",
+			keyName: "courier integration",
+			scopes:  []string{"orders:read", "orders:accept"},
+			mockSetup: func(storage *MockapiKeyStorage) {
+				storage.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, key models.APIKey) (int, error) {
+						assert.Equal(t, []models.Permission{models.ReadOrdersPermission,
+							models.AcceptOrdersPermission}, key.Scopes)
+						assert.Equal(t, 7, key.CreatedBy)
+						assert.NotEmpty(t, key.SecretHash)
+
+						return 1, nil
+					}).Times(1)
+			},
+		},
+		{
+			name:          "No name",
+			scopes:        []string{"orders:read"},
+			mockSetup:     func(_ *MockapiKeyStorage) {},
+			expectedError: ErrNoName,
+		},
+		{
+			name:          "No scopes",
+			keyName:       "reports",
+			mockSetup:     func(_ *MockapiKeyStorage) {},
+			expectedError: ErrNoScopes,
+		},
+		{
+			name:          "Unknown scope",
+			keyName:       "reports",
+			scopes:        []string{"orders:steal"},
+			mockSetup:     func(_ *MockapiKeyStorage) {},
+			expectedError: models.ErrUnknownScope,
+		},
+		{
+			name:          "Admins scope",
+			keyName:       "reports",
+			scopes:        []string{"admins:manage"},
+			mockSetup:     func(_ *MockapiKeyStorage) {},
+			expectedError: models.ErrUnknownScope,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMockapiKeyStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			key, apiKey, err := service.CreateAPIKey(t.Context(), 7, tt.keyName, tt.scopes)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.Equal(t, 1, apiKey.ID)
+				assert.True(t, apiKey.CheckSecret(key))
+
+				prefix, ok := models.ParseAPIKeyPrefix(key)
+				assert.True(t, ok)
+				assert.Equal(t, apiKey.Prefix, prefix)
+			}
+		})
+	}
+}
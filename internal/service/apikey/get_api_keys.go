@@ -0,0 +1,24 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// GetAPIKeys gets all API keys, including revoked ones
+func (s *Service) GetAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetAPIKeys")
+	defer span.Finish()
+
+	keys, err := s.storage.GetAPIKeys(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+
+	return keys, nil
+}
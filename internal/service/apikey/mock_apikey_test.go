@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_apikey_test.go -package=apikey
+//
+
+// Package apikey is a generated GoMock package.
+package apikey
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockapiKeyStorage is a mock of apiKeyStorage interface.
+type MockapiKeyStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyStorageMockRecorder is the mock recorder for MockapiKeyStorage.
+type MockapiKeyStorageMockRecorder struct {
+	mock *MockapiKeyStorage
+}
+
+// NewMockapiKeyStorage creates a new mock instance.
+func NewMockapiKeyStorage(ctrl *gomock.Controller) *MockapiKeyStorage {
+	mock := &MockapiKeyStorage{ctrl: ctrl}
+	mock.recorder = &MockapiKeyStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyStorage) EXPECT() *MockapiKeyStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsAPIKey mocks base method.
+func (m *MockapiKeyStorage) ContainsAPIKey(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsAPIKey indicates an expected call of ContainsAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) ContainsAPIKey(arg0, arg1 any) *MockapiKeyStorageContainsAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).ContainsAPIKey), arg0, arg1)
+	return &MockapiKeyStorageContainsAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageContainsAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageContainsAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageContainsAPIKeyCall) Return(arg0 bool, arg1 error) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageContainsAPIKeyCall) Do(f func(context.Context, string) (bool, error)) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageContainsAPIKeyCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockapiKeyStorage) CreateAPIKey(arg0 context.Context, arg1 models.APIKey) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) CreateAPIKey(arg0, arg1 any) *MockapiKeyStorageCreateAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).CreateAPIKey), arg0, arg1)
+	return &MockapiKeyStorageCreateAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageCreateAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageCreateAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageCreateAPIKeyCall) Return(arg0 int, arg1 error) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageCreateAPIKeyCall) Do(f func(context.Context, models.APIKey) (int, error)) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageCreateAPIKeyCall) DoAndReturn(f func(context.Context, models.APIKey) (int, error)) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAPIKey mocks base method.
+func (m *MockapiKeyStorage) GetAPIKey(arg0 context.Context, arg1 string) (models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) GetAPIKey(arg0, arg1 any) *MockapiKeyStorageGetAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).GetAPIKey), arg0, arg1)
+	return &MockapiKeyStorageGetAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageGetAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageGetAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageGetAPIKeyCall) Return(arg0 models.APIKey, arg1 error) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageGetAPIKeyCall) Do(f func(context.Context, string) (models.APIKey, error)) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageGetAPIKeyCall) DoAndReturn(f func(context.Context, string) (models.APIKey, error)) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAPIKeys mocks base method.
+func (m *MockapiKeyStorage) GetAPIKeys(arg0 context.Context) ([]models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeys", arg0)
+	ret0, _ := ret[0].([]models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeys indicates an expected call of GetAPIKeys.
+func (mr *MockapiKeyStorageMockRecorder) GetAPIKeys(arg0 any) *MockapiKeyStorageGetAPIKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeys", reflect.TypeOf((*MockapiKeyStorage)(nil).GetAPIKeys), arg0)
+	return &MockapiKeyStorageGetAPIKeysCall{Call: call}
+}
+
+// MockapiKeyStorageGetAPIKeysCall wrap *gomock.Call
+type MockapiKeyStorageGetAPIKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageGetAPIKeysCall) Return(arg0 []models.APIKey, arg1 error) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageGetAPIKeysCall) Do(f func(context.Context) ([]models.APIKey, error)) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageGetAPIKeysCall) DoAndReturn(f func(context.Context) ([]models.APIKey, error)) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockapiKeyStorage) RevokeAPIKey(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) RevokeAPIKey(arg0, arg1, arg2 any) *MockapiKeyStorageRevokeAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).RevokeAPIKey), arg0, arg1, arg2)
+	return &MockapiKeyStorageRevokeAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageRevokeAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageRevokeAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageRevokeAPIKeyCall) Return(arg0 error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageRevokeAPIKeyCall) Do(f func(context.Context, string, time.Time) error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageRevokeAPIKeyCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockapiKeyStorage) TouchAPIKey(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) TouchAPIKey(arg0, arg1, arg2 any) *MockapiKeyStorageTouchAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).TouchAPIKey), arg0, arg1, arg2)
+	return &MockapiKeyStorageTouchAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageTouchAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageTouchAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageTouchAPIKeyCall) Return(arg0 error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageTouchAPIKeyCall) Do(f func(context.Context, int, time.Time) error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageTouchAPIKeyCall) DoAndReturn(f func(context.Context, int, time.Time) error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
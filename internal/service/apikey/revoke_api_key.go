@@ -0,0 +1,33 @@
+package apikey
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// RevokeAPIKey revokes API key by its prefix, revoking a revoked key does nothing
+func (s *Service) RevokeAPIKey(ctx context.Context, prefix string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RevokeAPIKey")
+	defer span.Finish()
+
+	ok, err := s.storage.ContainsAPIKey(ctx, prefix)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if !ok {
+		s.logger.Error(ErrAPIKeyNotFound.Error(),
+			zap.String("prefix", prefix),
+			zap.Error(ErrAPIKeyNotFound),
+		)
+		span.SetTag("error", ErrAPIKeyNotFound)
+
+		return ErrAPIKeyNotFound
+	}
+
+	return s.storage.RevokeAPIKey(ctx, prefix, time.Now())
+}
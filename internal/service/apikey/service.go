@@ -0,0 +1,54 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_apikey_test.go -package=apikey
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// lastUsedPrecision is how stale last usage time of a key may get, so every request doesn't write to db
+const lastUsedPrecision = time.Minute
+
+var (
+	// ErrAPIKeyNotFound happens when API key is not found
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	// ErrInvalidAPIKey happens when API key is malformed, unknown, wrong or revoked,
+	// or its creator is deactivated or can't manage admins anymore
+	ErrInvalidAPIKey = errors.New("invalid api key")
+
+	// ErrNoName happens when API key has no name
+	ErrNoName = errors.New("api key must have a name")
+
+	// ErrNoScopes happens when API key has no scopes
+	ErrNoScopes = errors.New("api key must have at least one scope")
+)
+
+type apiKeyStorage interface {
+	CreateAPIKey(context.Context, models.APIKey) (int, error)
+	GetAPIKeys(context.Context) ([]models.APIKey, error)
+	GetAPIKey(context.Context, string) (models.APIKey, error)
+	ContainsAPIKey(context.Context, string) (bool, error)
+	RevokeAPIKey(context.Context, string, time.Time) error
+	TouchAPIKey(context.Context, int, time.Time) error
+}
+
+// Service is a structure for API key service, it manages keys of machine clients and authenticates them
+type Service struct {
+	storage apiKeyStorage
+	logger  *zap.Logger
+}
+
+// NewService creates instance of an API key Service
+func NewService(logger *zap.Logger, storage apiKeyStorage) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
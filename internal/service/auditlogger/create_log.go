@@ -2,19 +2,25 @@ package auditlogger
 
 import (
 	"context"
-	"fmt"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
 )
 
-// CreateLog creates log
+// CreateLog passes log to every sink, full sink queues apply their policies, so it doesn't block
+// longer than enqueue timeout of a sink. Logs are dropped once service is shut down, not once ctx is done,
+// so logs of requests served during graceful stop are written
 func (s *Service) CreateLog(ctx context.Context, log models.Log) {
-	select {
-	case <-ctx.Done():
-		fmt.Print("context canceled, writing log canceled")
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 
-		return
-	default:
-		s.jobs <- log
+	for _, queue := range s.queues {
+		if s.closed {
+			monitoring.SetAuditDropped(queue.name, dropReasonShutdown)
+
+			continue
+		}
+
+		queue.enqueue(ctx, log)
 	}
 }
@@ -0,0 +1,59 @@
+package auditlogger
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+type diffContextKey struct{}
+
+// diffRecorder collects changes made while handling a single call
+type diffRecorder struct {
+	diff models.Diff
+}
+
+// ContextWithDiffRecorder returns a copy of ctx service layer records changes into,
+// they are read back by DiffFromContext once the call is handled
+func ContextWithDiffRecorder(ctx context.Context) context.Context {
+	return context.WithValue(ctx, diffContextKey{}, &diffRecorder{})
+}
+
+// CopyDiffRecorder returns a copy of ctx that records changes into the recorder of src, if src has one
+func CopyDiffRecorder(ctx context.Context, src context.Context) context.Context {
+	recorder, ok := src.Value(diffContextKey{}).(*diffRecorder)
+	if !ok {
+		return ctx
+	}
+
+	return context.WithValue(ctx, diffContextKey{}, recorder)
+}
+
+// RecordDiff adds changes to the ones recorded in ctx, it does nothing if the call isn't audited
+func RecordDiff(ctx context.Context, diff models.Diff) {
+	recorder, ok := ctx.Value(diffContextKey{}).(*diffRecorder)
+	if !ok || len(diff) == 0 {
+		return
+	}
+
+	if recorder.diff == nil {
+		recorder.diff = make(models.Diff, len(diff))
+	}
+	for name, change := range diff {
+		// field changed several times keeps its value from before the first change
+		if recorded, ok := recorder.diff[name]; ok {
+			change.Old = recorded.Old
+		}
+		recorder.diff[name] = change
+	}
+}
+
+// DiffFromContext returns changes recorded in ctx
+func DiffFromContext(ctx context.Context) models.Diff {
+	recorder, ok := ctx.Value(diffContextKey{}).(*diffRecorder)
+	if !ok {
+		return nil
+	}
+
+	return recorder.diff
+}
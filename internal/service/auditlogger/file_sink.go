@@ -0,0 +1,183 @@
+package auditlogger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+const (
+	defaultMaxFiles = 5
+
+	// syslogFacility is a log audit facility of RFC 5424
+	syslogFacility = 13
+	syslogVersion  = 1
+	syslogAppName  = "pvz"
+	syslogMsgID    = "audit"
+
+	syslogSeverityError   = 3
+	syslogSeverityWarning = 4
+	syslogSeverityInfo    = 6
+)
+
+var (
+	errNoPath = errors.New("file sink requires path")
+)
+
+// fileSink appends formatted logs to a file, file is rotated once it grows bigger than max size
+type fileSink struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+	file     *os.File
+	size     int64
+	format   func(models.Log) ([]byte, error)
+}
+
+func newFileSink(cfg SinkConfig, format func(models.Log) ([]byte, error)) (*fileSink, error) {
+	if cfg.Path == "" {
+		return nil, errNoPath
+	}
+
+	maxFiles := cfg.MaxFiles
+	if maxFiles <= 0 {
+		maxFiles = defaultMaxFiles
+	}
+
+	s := &fileSink{
+		path:     cfg.Path,
+		maxSize:  cfg.MaxSize,
+		maxFiles: maxFiles,
+		format:   format,
+	}
+	if err := s.open(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// newJSONFileSink creates a sink writing a JSON object per line
+func newJSONFileSink(_ Dependencies, cfg SinkConfig) (Sink, error) {
+	return newFileSink(cfg, func(log models.Log) ([]byte, error) {
+		data, err := json.Marshal(log)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(data, '\n'), nil
+	})
+}
+
+// newSyslogFileSink creates a sink writing lines in RFC 5424 syslog format
+func newSyslogFileSink(_ Dependencies, cfg SinkConfig) (Sink, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+	procID := fmt.Sprint(os.Getpid())
+
+	return newFileSink(cfg, func(log models.Log) ([]byte, error) {
+		return []byte(formatSyslog(log, hostname, procID)), nil
+	})
+}
+
+func formatSyslog(log models.Log, hostname string, procID string) string {
+	severity := syslogSeverityInfo
+	switch {
+	case log.Status >= 500:
+		severity = syslogSeverityError
+	case log.Status >= 400:
+		severity = syslogSeverityWarning
+	}
+
+	message := fmt.Sprintf("order=%d admin=%d method=%s url=%s status=%d message=%q",
+		log.OrderID, log.AdminID, log.Method, log.URL, log.Status, log.Message)
+	if len(log.Diff) != 0 {
+		message += " diff=" + strings.ReplaceAll(strings.TrimSpace(log.Diff.String()), "\n", ";")
+	}
+
+	return fmt.Sprintf("<%d>%d %s %s %s %s %s - %s\n", syslogFacility*8+severity, syslogVersion,
+		log.Date.Format(time.RFC3339Nano), hostname, syslogAppName, procID, syslogMsgID, message)
+}
+
+func (s *fileSink) open() error {
+	file, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return err
+	}
+
+	s.file = file
+	s.size = info.Size()
+
+	return nil
+}
+
+// rotate renames file to file.1, file.1 to file.2 and so on, the oldest file is removed
+func (s *fileSink) rotate() error {
+	if err := s.file.Close(); err != nil {
+		return err
+	}
+
+	for i := s.maxFiles - 1; i > 0; i-- {
+		from := fmt.Sprintf("%s.%d", s.path, i)
+		if _, err := os.Stat(from); err == nil {
+			if err = os.Rename(from, fmt.Sprintf("%s.%d", s.path, i+1)); err != nil {
+				return err
+			}
+		}
+	}
+	if err := os.Rename(s.path, s.path+".1"); err != nil {
+		return err
+	}
+
+	return s.open()
+}
+
+func (s *fileSink) Write(_ context.Context, logs []models.Log) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, log := range logs {
+		data, err := s.format(log)
+		if err != nil {
+			return err
+		}
+
+		if s.maxSize > 0 && s.size > 0 && s.size+int64(len(data)) > s.maxSize {
+			if err = s.rotate(); err != nil {
+				return err
+			}
+		}
+
+		n, err := s.file.Write(data)
+		s.size += int64(n)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *fileSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.file.Close()
+}
@@ -0,0 +1,49 @@
+package auditlogger
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestFileSink_Rotate(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+	sink, err := newJSONFileSink(Dependencies{}, SinkConfig{Path: path, MaxSize: 300, MaxFiles: 2})
+	require.NoError(t, err)
+
+	log := *models.NewLog(1, 1, "success", "/orders", "POST", 200)
+	for i := 0; i < 10; i++ {
+		require.NoError(t, sink.Write(t.Context(), []models.Log{log}))
+	}
+	require.NoError(t, sink.Close())
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		require.NoError(t, err)
+		assert.LessOrEqual(t, info.Size(), int64(300))
+	}
+	_, err = os.Stat(path + ".3")
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestFormatSyslog(t *testing.T) {
+	t.Parallel()
+
+	log := models.Log{OrderID: 1, AdminID: 2, Message: "order not found", URL: "/orders/1", Method: "DELETE",
+		Status: 404, Date: time.Date(2025, 4, 1, 12, 0, 0, 0, time.UTC)}
+
+	line := formatSyslog(log, "host", "42")
+
+	assert.Equal(t, "<108>1 2025-04-01T12:00:00Z host pvz 42 audit - order=1 admin=2 method=DELETE url=/orders/1 "+
+		"status=404 message=\"order not found\"\n", line)
+	assert.False(t, strings.Contains(strings.TrimSuffix(line, "\n"), "\n"))
+}
@@ -1,9 +1,131 @@
 package auditlogger
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/logfilter"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 )
 
+// reloadInterval is how often sinks config file is checked for changes
+const reloadInterval = 2 * time.Second
+
+var (
+	errSinksChanged = errors.New("audit sinks changed, restart is required")
+)
+
+// sinkIdentity tells sinks apart, filters are reloaded only if every sink keeps its place in config
+type sinkIdentity struct {
+	Type  string
+	Path  string
+	Topic string
+}
+
+func identityOf(cfg SinkConfig) sinkIdentity {
+	return sinkIdentity{
+		Type:  cfg.Type,
+		Path:  cfg.Path,
+		Topic: cfg.Topic,
+	}
+}
+
+// sinkFilter is a compiled sink filter that can be replaced while workers use it
+type sinkFilter struct {
+	sink    sinkIdentity
+	current atomic.Pointer[logfilter.Filter]
+}
+
+// newSinkFilter compiles filter expression of a sink, all logs are selected if it's empty
+func newSinkFilter(cfg SinkConfig) (*sinkFilter, error) {
+	filter, err := logfilter.Parse(cfg.Filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter of %s sink: %w", cfg.Type, err)
+	}
+
+	f := &sinkFilter{
+		sink: identityOf(cfg),
+	}
+	f.current.Store(filter)
+
+	return f, nil
+}
+
+// Match checks if log is selected by current filter
+func (f *sinkFilter) Match(log models.Log) bool {
+	return f.current.Load().Match(log)
+}
+
+// reloadFilters compiles filters of changed config and replaces current ones, nothing is replaced
+// if any of them is invalid or sinks were added, removed or reordered
+func reloadFilters(path string, filters []*sinkFilter) error {
+	sinkConfigs, err := LoadSinkConfigs(path)
+	if err != nil {
+		return err
+	}
+	if len(sinkConfigs) != len(filters) {
+		return fmt.Errorf("%w: %d sinks instead of %d", errSinksChanged, len(sinkConfigs), len(filters))
+	}
+
+	compiled := make([]*logfilter.Filter, 0, len(sinkConfigs))
+	for i, sinkConfig := range sinkConfigs {
+		if sink := identityOf(sinkConfig); sink != filters[i].sink {
+			return fmt.Errorf("%w: sink %d is %+v instead of %+v", errSinksChanged, i, sink, filters[i].sink)
+		}
+
+		filter, err := logfilter.Parse(sinkConfig.Filter)
+		if err != nil {
+			return fmt.Errorf("invalid filter of %s sink: %w", sinkConfig.Type, err)
+		}
+		compiled = append(compiled, filter)
+	}
+
+	for i, filter := range compiled {
+		filters[i].current.Store(filter)
+	}
+
+	return nil
+}
+
+// watchFilters reloads sink filters once sinks config file changes, other sink settings require restart
+func watchFilters(ctx context.Context, path string, filters []*sinkFilter) {
+	info, err := os.Stat(path)
+	if err != nil {
+		log.Printf("Error watching audit sinks config: %v", err)
+
+		return
+	}
+	modTime, size := info.ModTime(), info.Size()
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			info, err = os.Stat(path)
+			if err != nil || (info.ModTime().Equal(modTime) && info.Size() == size) {
+				continue
+			}
+			modTime, size = info.ModTime(), info.Size()
+
+			if err = reloadFilters(path, filters); err != nil {
+				log.Printf("Error reloading audit sink filters, old ones are kept: %v", err)
+
+				continue
+			}
+			log.Printf("Audit sink filters reloaded from %s", path)
+		}
+	}
+}
+
 func (s *Service) filter(inputChannel <-chan models.Log, operator func(log models.Log) bool) <-chan models.Log {
 	outputChannel := make(chan models.Log)
 
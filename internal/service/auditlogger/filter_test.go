@@ -0,0 +1,106 @@
+package auditlogger
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/logfilter"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func runFilter(filter *sinkFilter, logs []models.Log) []models.Log {
+	s := &Service{}
+	input := make(chan models.Log, len(logs))
+	for _, log := range logs {
+		input <- log
+	}
+	close(input)
+
+	res := make([]models.Log, 0)
+	for log := range s.filter(input, filter.Match) {
+		res = append(res, log)
+	}
+
+	return res
+}
+
+func TestService_Filter(t *testing.T) {
+	t.Parallel()
+
+	deleted := *models.NewLog(1, 1, "order not found", "/orders/1", "DELETE", 404)
+	created := *models.NewLog(2, 1, "success", "/orders", "POST", 200)
+	failed := *models.NewLog(-1, 1, "failed", "/admins", "DELETE", 500)
+	logs := []models.Log{deleted, created, failed}
+
+	tests := []struct {
+		name string
+		expr string
+		want []models.Log
+	}{
+		{name: "All", expr: "", want: logs},
+		{name: "Status", expr: "status >= 400", want: []models.Log{deleted, failed}},
+		{name: "StatusAndMethod", expr: `status >= 400 && method == "DELETE" && url ~ "/orders"`,
+			want: []models.Log{deleted}},
+		{name: "None", expr: `method == "GET"`, want: []models.Log{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := newSinkFilter(SinkConfig{Type: "stdout", Filter: tt.expr})
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.want, runFilter(filter, logs))
+		})
+	}
+}
+
+func TestNewSinkFilter_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := newSinkFilter(SinkConfig{Type: "stdout", Filter: "status >= \"400\""})
+
+	require.ErrorIs(t, err, logfilter.ErrTypeMismatch)
+	assert.Contains(t, err.Error(), "stdout sink")
+}
+
+func TestReloadFilters(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "logger.config")
+	filter, err := newSinkFilter(SinkConfig{Type: "stdout", Filter: "status >= 400"})
+	require.NoError(t, err)
+	fileFilter, err := newSinkFilter(SinkConfig{Type: "file", Path: "audit.log"})
+	require.NoError(t, err)
+	filters := []*sinkFilter{filter, fileFilter}
+	created := *models.NewLog(2, 1, "success", "/orders", "POST", 200)
+
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"sinks":[{"type":"stdout","filter":"status == 200"},{"type":"file","path":"audit.log"}]}`), 0o600))
+	require.NoError(t, reloadFilters(path, filters))
+	assert.Equal(t, []models.Log{created}, runFilter(filter, []models.Log{created}))
+
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"sinks":[{"type":"stdout","filter":"status = 200"},{"type":"file","path":"audit.log"}]}`), 0o600))
+	require.ErrorIs(t, reloadFilters(path, filters), logfilter.ErrSyntax)
+	assert.Equal(t, "status == 200", filter.current.Load().String())
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"sinks":[{"type":"stdout"}]}`), 0o600))
+	require.ErrorIs(t, reloadFilters(path, filters), errSinksChanged)
+
+	// reordered sinks would get each other's filters
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"sinks":[{"type":"file","path":"audit.log"},{"type":"stdout","filter":"status == 500"}]}`), 0o600))
+	require.ErrorIs(t, reloadFilters(path, filters), errSinksChanged)
+	assert.Equal(t, "status == 200", filter.current.Load().String())
+
+	require.NoError(t, os.WriteFile(path,
+		[]byte(`{"sinks":[{"type":"stdout"},{"type":"file","path":"other.log","filter":"status == 500"}]}`), 0o600))
+	require.ErrorIs(t, reloadFilters(path, filters), errSinksChanged)
+	assert.Equal(t, "", fileFilter.current.Load().String())
+}
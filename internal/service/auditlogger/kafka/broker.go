@@ -0,0 +1,60 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+)
+
+const (
+	saramaBrokerType = "sarama"
+	memoryBrokerType = "memory"
+)
+
+var (
+	// ErrNoBroker happens when Kafka broker isn't configured
+	ErrNoBroker = errors.New("kafka broker isn't configured")
+
+	errUnknownBroker = errors.New("unknown kafka broker")
+)
+
+// Producer sends messages to topics
+type Producer interface {
+	SendMessage(*sarama.ProducerMessage) (int32, int64, error)
+	SendMessages([]*sarama.ProducerMessage) error
+	Close() error
+}
+
+// ConsumerGroup consumes topics as a member of a group, Consume returns once the group is rebalanced
+// or ctx is done, handler gets claims of partitions assigned to the member
+type ConsumerGroup interface {
+	Consume(context.Context, []string, sarama.ConsumerGroupHandler) error
+	Close() error
+}
+
+// Broker creates producers and consumer groups, so Kafka workers don't depend on a client implementation
+type Broker interface {
+	NewProducer() (Producer, error)
+	NewConsumerGroup(groupID string) (ConsumerGroup, error)
+}
+
+// NewBroker creates broker selected by KAFKA_BROKER, ErrNoBroker is returned if sarama broker is selected,
+// but config isn't loaded
+func NewBroker(cfg config.Config) (Broker, error) {
+	switch cfg.KafkaBroker() {
+	case saramaBrokerType:
+		if cfg.IsEmpty() {
+			return nil, ErrNoBroker
+		}
+
+		return NewSaramaBroker(cfg), nil
+	case memoryBrokerType:
+		return NewMemoryBroker(), nil
+	}
+
+	return nil, fmt.Errorf("%w: %q", errUnknownBroker, cfg.KafkaBroker())
+}
@@ -0,0 +1,177 @@
+package kafka
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	auditevent_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auditevent/proto"
+)
+
+const (
+	// SchemaVersion is a version of AuditEvent messages are encoded with
+	SchemaVersion = 1
+
+	contentTypeHeader   = "content-type"
+	schemaVersionHeader = "schema-version"
+
+	protobufContentType = "application/x-protobuf"
+	jsonContentType     = "application/json"
+)
+
+var (
+	errUnknownContentType       = errors.New("unknown content type of audit message")
+	errInvalidSchemaVersion     = errors.New("invalid schema version of audit message")
+	errUnsupportedSchemaVersion = errors.New("unsupported schema version of audit message")
+)
+
+// EncodeLog encodes log as AuditEvent, returned headers describe encoding
+func EncodeLog(log models.Log) ([]byte, []sarama.RecordHeader, error) {
+	data, err := proto.Marshal(toEvent(log))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, []sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(protobufContentType)},
+		{Key: []byte(schemaVersionHeader), Value: []byte(strconv.Itoa(SchemaVersion))},
+	}, nil
+}
+
+// DecodeLog decodes log of schema version up to SchemaVersion, messages without content type
+// were written before AuditEvent and are decoded as JSON. Newer versions are rejected,
+// as their fields may change meaning of the ones known here
+func DecodeLog(headers []*sarama.RecordHeader, value []byte) (models.Log, error) {
+	contentType := jsonContentType
+	for _, header := range headers {
+		if header == nil {
+			continue
+		}
+
+		switch string(header.Key) {
+		case contentTypeHeader:
+			contentType = string(header.Value)
+		case schemaVersionHeader:
+			version, err := strconv.Atoi(string(header.Value))
+			if err != nil {
+				return models.Log{}, fmt.Errorf("%w: %q", errInvalidSchemaVersion, header.Value)
+			}
+			if version > SchemaVersion {
+				return models.Log{}, fmt.Errorf("%w: %d", errUnsupportedSchemaVersion, version)
+			}
+		}
+	}
+
+	switch contentType {
+	case protobufContentType:
+		var event auditevent_proto.AuditEvent
+		if err := proto.Unmarshal(value, &event); err != nil {
+			return models.Log{}, err
+		}
+
+		return fromEvent(&event)
+	case jsonContentType:
+		var log models.Log
+		if err := json.Unmarshal(value, &log); err != nil {
+			return models.Log{}, err
+		}
+
+		return log, nil
+	default:
+		return models.Log{}, fmt.Errorf("%w: %s", errUnknownContentType, contentType)
+	}
+}
+
+func toEvent(log models.Log) *auditevent_proto.AuditEvent {
+	event := &auditevent_proto.AuditEvent{
+		SchemaVersion: SchemaVersion,
+		Id:            int32(log.ID),
+		OrderId:       int32(log.OrderID),
+		AdminId:       int32(log.AdminID),
+		Message:       log.Message,
+		Date:          toTimestamp(log.Date),
+		Url:           log.URL,
+		Method:        log.Method,
+		Status:        int32(log.Status),
+		JobStatus:     int32(log.JobStatus),
+		AttemptsLeft:  int32(log.AttemptsLeft),
+		UpdatedAt:     toTimestamp(log.UpdatedAt),
+		NextAttemptAt: toTimestamp(log.NextAttemptAt),
+		PrevHash:      log.PrevHash,
+		Hash:          log.Hash,
+	}
+	if log.APIKeyID != nil {
+		apiKeyID := int32(*log.APIKeyID)
+		event.ApiKeyId = &apiKeyID
+	}
+	if len(log.Diff) != 0 {
+		event.Diff = make(map[string]*auditevent_proto.Change, len(log.Diff))
+		for name, change := range log.Diff {
+			event.Diff[name] = &auditevent_proto.Change{
+				Old: change.OldJSON(),
+				New: change.NewJSON(),
+			}
+		}
+	}
+
+	return event
+}
+
+func fromEvent(event *auditevent_proto.AuditEvent) (models.Log, error) {
+	log := models.Log{
+		ID:            int(event.GetId()),
+		OrderID:       int(event.GetOrderId()),
+		AdminID:       int(event.GetAdminId()),
+		Message:       event.GetMessage(),
+		Date:          fromTimestamp(event.GetDate()),
+		URL:           event.GetUrl(),
+		Method:        event.GetMethod(),
+		Status:        int(event.GetStatus()),
+		JobStatus:     int(event.GetJobStatus()),
+		AttemptsLeft:  int(event.GetAttemptsLeft()),
+		UpdatedAt:     fromTimestamp(event.GetUpdatedAt()),
+		NextAttemptAt: fromTimestamp(event.GetNextAttemptAt()),
+		PrevHash:      event.GetPrevHash(),
+		Hash:          event.GetHash(),
+	}
+	if event.ApiKeyId != nil {
+		apiKeyID := int(event.GetApiKeyId())
+		log.APIKeyID = &apiKeyID
+	}
+	if len(event.GetDiff()) != 0 {
+		log.Diff = make(models.Diff, len(event.GetDiff()))
+		for name, change := range event.GetDiff() {
+			decoded, err := models.NewChangeFromJSON(change.GetOld(), change.GetNew())
+			if err != nil {
+				return models.Log{}, err
+			}
+			log.Diff[name] = decoded
+		}
+	}
+
+	return log, nil
+}
+
+// toTimestamp leaves zero time unset, so it's decoded as zero time
+func toTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
+
+func fromTimestamp(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
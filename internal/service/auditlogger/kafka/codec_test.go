@@ -0,0 +1,175 @@
+package kafka
+
+import (
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	auditevent_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auditevent/proto"
+)
+
+func testLog() models.Log {
+	apiKeyID := 7
+	date := time.Date(2025, 4, 1, 12, 0, 0, 123000, time.UTC)
+
+	return models.Log{
+		ID:            42,
+		OrderID:       1,
+		AdminID:       2,
+		APIKeyID:      &apiKeyID,
+		Message:       "order accepted",
+		Date:          date,
+		URL:           "/orders",
+		Method:        "POST",
+		Status:        200,
+		JobStatus:     models.ProcessingStatus,
+		AttemptsLeft:  3,
+		UpdatedAt:     date.Add(time.Minute),
+		NextAttemptAt: date.Add(time.Hour),
+		Diff: models.Diff{
+			"status":    {Old: float64(1), New: float64(2)},
+			"packaging": {Old: nil, New: "box"},
+		},
+		PrevHash: "prev",
+		Hash:     "hash",
+	}
+}
+
+func toHeaders(headers []sarama.RecordHeader) []*sarama.RecordHeader {
+	res := make([]*sarama.RecordHeader, 0, len(headers))
+	for i := range headers {
+		res = append(res, &headers[i])
+	}
+
+	return res
+}
+
+func TestEncodeLog_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	log := testLog()
+
+	value, headers, err := EncodeLog(log)
+	require.NoError(t, err)
+	assert.Equal(t, []sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(protobufContentType)},
+		{Key: []byte(schemaVersionHeader), Value: []byte("1")},
+	}, headers)
+
+	decoded, err := DecodeLog(toHeaders(headers), value)
+	require.NoError(t, err)
+	assert.Equal(t, log, decoded)
+}
+
+func TestEncodeLog_FieldNumbers(t *testing.T) {
+	t.Parallel()
+
+	// field numbers are a wire contract, consumers of older versions break if they change
+	value, err := proto.Marshal(&auditevent_proto.AuditEvent{SchemaVersion: 1, Id: 42, Message: "ok"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []byte{0x08, 0x01, 0x10, 0x2a, 0x32, 0x02, 'o', 'k'}, value)
+}
+
+func TestDecodeLog_NewerVersion(t *testing.T) {
+	t.Parallel()
+
+	value, _, err := EncodeLog(testLog())
+	require.NoError(t, err)
+
+	// fields of newer versions may change meaning of known ones, so such messages aren't decoded
+	_, err = DecodeLog([]*sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(protobufContentType)},
+		{Key: []byte(schemaVersionHeader), Value: []byte(strconv.Itoa(SchemaVersion + 1))},
+	}, value)
+	assert.ErrorIs(t, err, errUnsupportedSchemaVersion)
+
+	_, err = DecodeLog([]*sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(protobufContentType)},
+		{Key: []byte(schemaVersionHeader), Value: []byte("v2")},
+	}, value)
+	assert.ErrorIs(t, err, errInvalidSchemaVersion)
+}
+
+func TestDecodeLog_UnknownFields(t *testing.T) {
+	t.Parallel()
+
+	log := testLog()
+	value, headers, err := EncodeLog(log)
+	require.NoError(t, err)
+
+	// fields unknown to this version are skipped
+	value = protowire.AppendTag(value, 100, protowire.BytesType)
+	value = protowire.AppendString(value, "unknown field")
+
+	decoded, err := DecodeLog(toHeaders(headers), value)
+	require.NoError(t, err)
+	assert.Equal(t, log, decoded)
+}
+
+func TestDecodeLog_OlderVersion(t *testing.T) {
+	t.Parallel()
+
+	// older producers don't set fields added later, they are decoded as zero values
+	value, err := proto.Marshal(&auditevent_proto.AuditEvent{SchemaVersion: 1, Id: 42, OrderId: -1, Message: "ok"})
+	require.NoError(t, err)
+
+	decoded, err := DecodeLog([]*sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(protobufContentType)},
+	}, value)
+	require.NoError(t, err)
+	assert.Equal(t, models.Log{
+		ID:      42,
+		OrderID: -1,
+		Message: "ok",
+	}, decoded)
+}
+
+func TestDecodeLog_JSON(t *testing.T) {
+	t.Parallel()
+
+	log := testLog()
+	value, err := json.Marshal(log)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		headers []*sarama.RecordHeader
+	}{
+		{
+			name: "Without headers",
+		},
+		{
+			name:    "JSON content type",
+			headers: []*sarama.RecordHeader{{Key: []byte(contentTypeHeader), Value: []byte(jsonContentType)}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			decoded, err := DecodeLog(tt.headers, value)
+			require.NoError(t, err)
+			assert.Equal(t, log, decoded)
+		})
+	}
+}
+
+func TestDecodeLog_UnknownContentType(t *testing.T) {
+	t.Parallel()
+
+	_, err := DecodeLog([]*sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte("application/avro")},
+	}, []byte{})
+
+	require.ErrorIs(t, err, errUnknownContentType)
+}
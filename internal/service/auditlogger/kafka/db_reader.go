@@ -22,8 +22,13 @@ func dbReader(ctx context.Context, interval time.Duration, storage logsStorage,
 				return err
 			}
 
+			// logs left unsent once ctx is done stay processing and are sent again after restart
 			for _, log := range logs {
-				jobs <- log
+				select {
+				case <-ctx.Done():
+					return nil
+				case jobs <- log:
+				}
 			}
 		}
 	}
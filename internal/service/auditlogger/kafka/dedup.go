@@ -0,0 +1,55 @@
+package kafka
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/IBM/sarama"
+)
+
+const (
+	// dedupHeader is a header with a stable key of a log, it's the same for every send of the log
+	dedupHeader = "dedup-key"
+
+	dedupKeyPrefix = "audit-log-"
+
+	orderEventKeyPrefix = "order-event-"
+)
+
+var (
+	errWrongDedupKey = errors.New("wrong dedup key")
+)
+
+// dedupKey returns a key that identifies log with such id
+func dedupKey(id int) string {
+	return dedupKeyPrefix + strconv.Itoa(id)
+}
+
+// orderEventDedupKey returns a key that identifies order event with such id
+func orderEventDedupKey(id int) string {
+	return orderEventKeyPrefix + strconv.Itoa(id)
+}
+
+// parseDedupKey returns id of a log message is about, ok is false if message has no dedup header
+func parseDedupKey(headers []*sarama.RecordHeader) (int, bool, error) {
+	for _, header := range headers {
+		if header == nil || string(header.Key) != dedupHeader {
+			continue
+		}
+
+		raw, found := strings.CutPrefix(string(header.Value), dedupKeyPrefix)
+		if !found {
+			return 0, true, errWrongDedupKey
+		}
+
+		id, err := strconv.Atoi(raw)
+		if err != nil {
+			return 0, true, errWrongDedupKey
+		}
+
+		return id, true, nil
+	}
+
+	return 0, false, nil
+}
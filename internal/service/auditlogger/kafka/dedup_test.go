@@ -0,0 +1,58 @@
+package kafka
+
+import (
+	"testing"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDedupKey(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		headers []*sarama.RecordHeader
+		wantID  int
+		wantOK  bool
+		wantErr error
+	}{
+		{
+			name: "Key",
+			headers: []*sarama.RecordHeader{
+				{Key: []byte("other"), Value: []byte("1")},
+				{Key: []byte(dedupHeader), Value: []byte(dedupKey(42))},
+			},
+			wantID: 42,
+			wantOK: true,
+		},
+		{
+			name:    "NoKey",
+			headers: []*sarama.RecordHeader{{Key: []byte("other"), Value: []byte("1")}},
+		},
+		{
+			name:    "WrongPrefix",
+			headers: []*sarama.RecordHeader{{Key: []byte(dedupHeader), Value: []byte("log-42")}},
+			wantOK:  true,
+			wantErr: errWrongDedupKey,
+		},
+		{
+			name:    "WrongID",
+			headers: []*sarama.RecordHeader{{Key: []byte(dedupHeader), Value: []byte(dedupKeyPrefix + "x")}},
+			wantOK:  true,
+			wantErr: errWrongDedupKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, ok, err := parseDedupKey(tt.headers)
+			require.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.wantID, id)
+			assert.Equal(t, tt.wantOK, ok)
+		})
+	}
+}
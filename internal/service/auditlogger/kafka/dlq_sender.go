@@ -0,0 +1,38 @@
+package kafka
+
+import (
+	"context"
+	"log"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// dlqSender publishes jobs without attempts left to dead letter topic, jobs stay dead in db
+// even if publishing fails, so they can be requeued anyway
+func dlqSender(ctx context.Context, cfg config.Config, broker Broker, dead <-chan models.Log) error {
+	producer, err := broker.NewProducer()
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	log.Print("Starting dlqSender")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case job := <-dead:
+			message, err := newMessage(cfg.KafkaDLQTopic(), job)
+			if err != nil {
+				log.Printf("Error encoding log: %v\n", err)
+
+				continue
+			}
+
+			if _, _, err = producer.SendMessage(message); err != nil {
+				log.Printf("error sending dead job: %v, jobId: %d", err, job.ID)
+			}
+		}
+	}
+}
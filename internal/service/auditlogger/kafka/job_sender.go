@@ -2,7 +2,6 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"log"
 	"strconv"
 
@@ -12,43 +11,71 @@ import (
 	"github.com/IBM/sarama"
 )
 
-func jobSender(ctx context.Context, cfg config.Config, jobs <-chan models.Log, failed chan<- models.Log) error {
+// producerRetries is a number of times idempotent producer resends a message before it's failed
+const producerRetries = 5
+
+// newProducer creates idempotent producer, so retries of a send don't duplicate messages
+func newProducer(cfg config.Config) (sarama.SyncProducer, error) {
 	kafkaConfig := sarama.NewConfig()
+	kafkaConfig.Version = sarama.V2_1_0_0
 	kafkaConfig.Producer.Return.Successes = true
 	kafkaConfig.Producer.Return.Errors = true
+	kafkaConfig.Producer.Idempotent = true
+	kafkaConfig.Producer.RequiredAcks = sarama.WaitForAll
+	kafkaConfig.Producer.Retry.Max = producerRetries
+	kafkaConfig.Net.MaxOpenRequests = 1
+
+	return sarama.NewSyncProducer([]string{cfg.KafkaHost() + ":" + cfg.KafkaPort()}, kafkaConfig)
+}
+
+// newMessage creates message with a job encoded as AuditEvent and keyed by its id
+func newMessage(topic string, job models.Log) (*sarama.ProducerMessage, error) {
+	value, headers, err := EncodeLog(job)
+	if err != nil {
+		return nil, err
+	}
 
-	producer, err := sarama.NewSyncProducer([]string{cfg.KafkaHost() + ":" + cfg.KafkaPort()}, kafkaConfig)
+	return &sarama.ProducerMessage{
+		Topic:   topic,
+		Key:     sarama.StringEncoder(strconv.Itoa(job.ID)),
+		Value:   sarama.ByteEncoder(value),
+		Headers: append(headers, sarama.RecordHeader{Key: []byte(dedupHeader), Value: []byte(dedupKey(job.ID))}),
+	}, nil
+}
+
+// jobSender sends jobs to logs topic, jobs resent by dbReader are duplicated, but carry the same dedup key
+func jobSender(ctx context.Context, cfg config.Config, broker Broker, jobs <-chan models.Log,
+	failed chan<- models.Log) error {
+	producer, err := broker.NewProducer()
 	if err != nil {
 		return err
 	}
 	defer producer.Close()
 
 	log.Print("Starting jobSender")
-	for job := range jobs {
+	for {
+		var job models.Log
+
 		select {
 		case <-ctx.Done():
 			return nil
-		default:
+		case job = <-jobs:
 		}
 
-		jobBytes, err := json.Marshal(job)
+		message, err := newMessage(cfg.KafkaLogsTopic(), job)
 		if err != nil {
-			log.Printf("Error marshaling JSON: %v\n", err)
+			log.Printf("Error encoding log: %v\n", err)
 
 			continue
 		}
 
-		message := &sarama.ProducerMessage{
-			Topic: "logs",
-			Key:   sarama.StringEncoder(strconv.Itoa(job.ID)),
-			Value: sarama.ByteEncoder(jobBytes),
-		}
-
 		_, _, err = producer.SendMessage(message)
 		if err != nil {
-			failed <- job
+			select {
+			case <-ctx.Done():
+				return nil
+			case failed <- job:
+			}
 		}
 	}
-
-	return nil
 }
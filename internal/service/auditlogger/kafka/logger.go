@@ -2,45 +2,115 @@ package kafka
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"log"
 
+	"github.com/IBM/sarama"
+
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 )
 
-var (
-	errClosedChannel = errors.New("channel closed")
-)
+// consumedLog is a log read from Kafka, commit marks its offset once updater has saved its status
+type consumedLog struct {
+	log    models.Log
+	commit func()
+}
 
-func logger(ctx context.Context, cfg config.Config, done chan<- models.Log) error {
-	partConsumer, err := initConsumer(ctx, cfg)
-	if err != nil {
-		return err
-	}
+// logsHandler passes logs of claimed partitions to updater, a log may be consumed more than once,
+// updater ignores logs that are already done
+type logsHandler struct {
+	done chan<- consumedLog
+}
+
+// Setup is called once partitions are assigned to the group member
+func (h *logsHandler) Setup(session sarama.ConsumerGroupSession) error {
+	log.Printf("Consuming logs partitions: %v", session.Claims())
+
+	return nil
+}
+
+// Cleanup is called before partitions are revoked, offsets marked so far are committed,
+// logs passed to updater but not marked yet are consumed again by a new owner
+func (h *logsHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	session.Commit()
 
-	log.Print("Starting partition logger")
+	return nil
+}
+
+// ConsumeClaim reads messages of a single partition until it's revoked
+func (h *logsHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for {
 		select {
-		case <-ctx.Done():
+		case <-session.Context().Done():
 			return nil
-		case msg, ok := <-partConsumer.Messages():
+		case msg, ok := <-claim.Messages():
 			if !ok {
-				return errClosedChannel
+				return nil
+			}
+
+			receivedLog, err := DecodeLog(msg.Headers, msg.Value)
+			if err != nil {
+				log.Print("Error decoding log:", err)
+				// malformed message won't become valid, so it's skipped
+				session.MarkMessage(msg, "")
+
+				continue
 			}
 
-			var receivedLog models.Log
-			err = json.Unmarshal(msg.Value, &receivedLog)
+			// dedup key identifies the log even if its body is changed
+			id, ok, err := parseDedupKey(msg.Headers)
 			if err != nil {
-				log.Print("Error unmarshalling log:", err)
+				log.Print("Error reading log dedup key:", err)
+				session.MarkMessage(msg, "")
 
 				continue
 			}
+			if ok {
+				receivedLog.ID = id
+			}
 
-			done <- receivedLog
+			select {
+			case <-session.Context().Done():
+				return nil
+			case h.done <- consumedLog{
+				log: receivedLog,
+				commit: func() {
+					session.MarkMessage(msg, "")
+				},
+			}:
+			}
 
 			log.Print(receivedLog.String())
 		}
 	}
 }
+
+func logger(ctx context.Context, cfg config.Config, broker Broker, done chan<- consumedLog) error {
+	group, err := broker.NewConsumerGroup(cfg.KafkaConsumerGroup())
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	handler := &logsHandler{
+		done: done,
+	}
+
+	log.Print("Starting logs consumer group")
+	for {
+		// Consume returns on every rebalance, so it's called again to join the group with a new assignment
+		err = group.Consume(ctx, []string{cfg.KafkaLogsTopic()}, handler)
+		if err != nil {
+			if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+				return nil
+			}
+
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,330 @@
+package kafka
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"golang.org/x/sync/errgroup"
+)
+
+// claimBufferSize is a number of messages claim reads ahead of a handler, it's sarama default
+const claimBufferSize = 256
+
+// MemoryBroker keeps topics in process, so Kafka workers run in tests and offline without Kafka.
+// Every topic has a single partition and messages are never deleted. Offsets marked by a group are kept,
+// so a group resumes where it stopped and messages that weren't marked are consumed again,
+// a new group starts from the oldest message
+type MemoryBroker struct {
+	mu     sync.Mutex
+	topics map[string][]*sarama.ConsumerMessage
+	// offsets are next offsets to be consumed by groups
+	offsets map[string]map[string]int64
+	// appended is closed and replaced once a message is appended
+	appended chan struct{}
+}
+
+// NewMemoryBroker creates empty in-process broker
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		topics:   make(map[string][]*sarama.ConsumerMessage),
+		offsets:  make(map[string]map[string]int64),
+		appended: make(chan struct{}),
+	}
+}
+
+// NewProducer creates producer that appends messages to topics of the broker
+func (b *MemoryBroker) NewProducer() (Producer, error) {
+	return &memoryProducer{
+		broker: b,
+	}, nil
+}
+
+// NewConsumerGroup creates group member that claims the only partition of every consumed topic
+func (b *MemoryBroker) NewConsumerGroup(groupID string) (ConsumerGroup, error) {
+	return &memoryConsumerGroup{
+		broker:  b,
+		groupID: groupID,
+		closed:  make(chan struct{}),
+	}, nil
+}
+
+// Messages returns messages of a topic in order they were sent
+func (b *MemoryBroker) Messages(topic string) []*sarama.ConsumerMessage {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return append([]*sarama.ConsumerMessage(nil), b.topics[topic]...)
+}
+
+func (b *MemoryBroker) append(msg *sarama.ProducerMessage) (int64, error) {
+	var key, value []byte
+	var err error
+	if msg.Key != nil {
+		if key, err = msg.Key.Encode(); err != nil {
+			return 0, err
+		}
+	}
+	if msg.Value != nil {
+		if value, err = msg.Value.Encode(); err != nil {
+			return 0, err
+		}
+	}
+
+	headers := make([]*sarama.RecordHeader, 0, len(msg.Headers))
+	for _, header := range msg.Headers {
+		headers = append(headers, &header)
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	offset := int64(len(b.topics[msg.Topic]))
+	b.topics[msg.Topic] = append(b.topics[msg.Topic], &sarama.ConsumerMessage{
+		Headers:   headers,
+		Timestamp: time.Now(),
+		Key:       key,
+		Value:     value,
+		Topic:     msg.Topic,
+		Offset:    offset,
+	})
+
+	close(b.appended)
+	b.appended = make(chan struct{})
+
+	return offset, nil
+}
+
+// next returns message of a topic at offset, if there is no such message yet,
+// returned channel is closed once a message is appended
+func (b *MemoryBroker) next(topic string, offset int64) (*sarama.ConsumerMessage, <-chan struct{}) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if offset < int64(len(b.topics[topic])) {
+		return b.topics[topic][offset], nil
+	}
+
+	return nil, b.appended
+}
+
+func (b *MemoryBroker) highWaterMark(topic string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return int64(len(b.topics[topic]))
+}
+
+func (b *MemoryBroker) offset(groupID string, topic string) int64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.offsets[groupID][topic]
+}
+
+// setOffset saves next offset of a group, marked offsets only move forward like in Kafka
+func (b *MemoryBroker) setOffset(groupID string, topic string, offset int64, reset bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.offsets[groupID] == nil {
+		b.offsets[groupID] = make(map[string]int64)
+	}
+	if reset || offset > b.offsets[groupID][topic] {
+		b.offsets[groupID][topic] = offset
+	}
+}
+
+type memoryProducer struct {
+	broker *MemoryBroker
+}
+
+func (p *memoryProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	offset, err := p.broker.append(msg)
+	if err != nil {
+		return 0, 0, err
+	}
+	msg.Offset = offset
+
+	return 0, offset, nil
+}
+
+func (p *memoryProducer) SendMessages(msgs []*sarama.ProducerMessage) error {
+	for _, msg := range msgs {
+		if _, _, err := p.SendMessage(msg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *memoryProducer) Close() error {
+	return nil
+}
+
+type memoryConsumerGroup struct {
+	broker    *MemoryBroker
+	groupID   string
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+// Consume runs a session until ctx is done or the group is closed, since in-process group isn't rebalanced
+func (g *memoryConsumerGroup) Consume(ctx context.Context, topics []string,
+	handler sarama.ConsumerGroupHandler) error {
+	select {
+	case <-g.closed:
+		return sarama.ErrClosedConsumerGroup
+	default:
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-g.closed:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	session := &memorySession{
+		ctx:     egCtx,
+		broker:  g.broker,
+		groupID: g.groupID,
+		claims:  make(map[string][]int32, len(topics)),
+	}
+	for _, topic := range topics {
+		session.claims[topic] = []int32{0}
+	}
+
+	if err := handler.Setup(session); err != nil {
+		return err
+	}
+
+	for _, topic := range topics {
+		claim := &memoryClaim{
+			topic:         topic,
+			initialOffset: g.broker.offset(g.groupID, topic),
+			broker:        g.broker,
+			messages:      make(chan *sarama.ConsumerMessage, claimBufferSize),
+		}
+
+		eg.Go(func() error {
+			claim.feed(egCtx)
+
+			return nil
+		})
+		eg.Go(func() error {
+			return handler.ConsumeClaim(session, claim)
+		})
+	}
+
+	err := eg.Wait()
+	if cleanupErr := handler.Cleanup(session); err == nil {
+		err = cleanupErr
+	}
+
+	return err
+}
+
+func (g *memoryConsumerGroup) Close() error {
+	g.closeOnce.Do(func() {
+		close(g.closed)
+	})
+
+	return nil
+}
+
+// memorySession marks offsets straight in the broker, so Commit has nothing to do
+type memorySession struct {
+	ctx     context.Context
+	broker  *MemoryBroker
+	groupID string
+	claims  map[string][]int32
+}
+
+func (s *memorySession) Claims() map[string][]int32 {
+	return s.claims
+}
+
+func (s *memorySession) MemberID() string {
+	return s.groupID
+}
+
+func (s *memorySession) GenerationID() int32 {
+	return 1
+}
+
+func (s *memorySession) MarkOffset(topic string, _ int32, offset int64, _ string) {
+	s.broker.setOffset(s.groupID, topic, offset, false)
+}
+
+func (s *memorySession) Commit() {}
+
+func (s *memorySession) ResetOffset(topic string, _ int32, offset int64, _ string) {
+	s.broker.setOffset(s.groupID, topic, offset, true)
+}
+
+func (s *memorySession) MarkMessage(msg *sarama.ConsumerMessage, metadata string) {
+	s.MarkOffset(msg.Topic, msg.Partition, msg.Offset+1, metadata)
+}
+
+func (s *memorySession) Context() context.Context {
+	return s.ctx
+}
+
+type memoryClaim struct {
+	topic         string
+	initialOffset int64
+	broker        *MemoryBroker
+	messages      chan *sarama.ConsumerMessage
+}
+
+func (c *memoryClaim) Topic() string {
+	return c.topic
+}
+
+func (c *memoryClaim) Partition() int32 {
+	return 0
+}
+
+func (c *memoryClaim) InitialOffset() int64 {
+	return c.initialOffset
+}
+
+func (c *memoryClaim) HighWaterMarkOffset() int64 {
+	return c.broker.highWaterMark(c.topic)
+}
+
+func (c *memoryClaim) Messages() <-chan *sarama.ConsumerMessage {
+	return c.messages
+}
+
+// feed passes messages appended to the topic to the claim until the session is over
+func (c *memoryClaim) feed(ctx context.Context) {
+	defer close(c.messages)
+
+	offset := c.initialOffset
+	for {
+		msg, appended := c.broker.next(c.topic, offset)
+		if msg == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-appended:
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case c.messages <- msg:
+			offset++
+		}
+	}
+}
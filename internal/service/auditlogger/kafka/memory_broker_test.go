@@ -0,0 +1,101 @@
+package kafka
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// markingHandler passes consumed messages to the test and marks ones with offset below markBelow
+type markingHandler struct {
+	markBelow int64
+	consumed  chan *sarama.ConsumerMessage
+}
+
+func (h *markingHandler) Setup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *markingHandler) Cleanup(sarama.ConsumerGroupSession) error {
+	return nil
+}
+
+func (h *markingHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for msg := range claim.Messages() {
+		if msg.Offset < h.markBelow {
+			session.MarkMessage(msg, "")
+		}
+		h.consumed <- msg
+	}
+
+	return nil
+}
+
+func send(t *testing.T, producer Producer, values ...string) {
+	t.Helper()
+
+	for _, value := range values {
+		_, _, err := producer.SendMessage(&sarama.ProducerMessage{Topic: "topic", Value: sarama.StringEncoder(value)})
+		require.NoError(t, err)
+	}
+}
+
+// consume reads count messages as a member of a group and leaves the group
+func consume(t *testing.T, broker *MemoryBroker, groupID string, markBelow int64, count int,
+	whileConsuming func()) []string {
+	t.Helper()
+
+	group, err := broker.NewConsumerGroup(groupID)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	handler := &markingHandler{markBelow: markBelow, consumed: make(chan *sarama.ConsumerMessage, count)}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- group.Consume(ctx, []string{"topic"}, handler)
+	}()
+
+	if whileConsuming != nil {
+		whileConsuming()
+	}
+
+	values := make([]string, 0, count)
+	for range count {
+		select {
+		case msg := <-handler.consumed:
+			values = append(values, string(msg.Value))
+		case <-time.After(time.Second):
+			require.FailNow(t, "message isn't consumed")
+		}
+	}
+
+	require.NoError(t, group.Close())
+	require.NoError(t, <-errCh)
+	require.ErrorIs(t, group.Consume(ctx, []string{"topic"}, handler), sarama.ErrClosedConsumerGroup)
+
+	return values
+}
+
+func TestMemoryBroker_ConsumerGroup(t *testing.T) {
+	t.Parallel()
+
+	broker := NewMemoryBroker()
+	producer, err := broker.NewProducer()
+	require.NoError(t, err)
+
+	send(t, producer, "a", "b", "c")
+
+	assert.Equal(t, []string{"a", "b", "c"}, consume(t, broker, "audit", 2, 3, nil))
+	// unmarked message is consumed again, messages sent while consuming are delivered too
+	assert.Equal(t, []string{"c", "d"}, consume(t, broker, "audit", 4, 2, func() {
+		send(t, producer, "d")
+	}))
+	assert.Equal(t, []string{"a", "b", "c", "d"}, consume(t, broker, "other", 0, 4, nil))
+	assert.Len(t, broker.Messages("topic"), 4)
+}
@@ -0,0 +1,98 @@
+package kafka
+
+import (
+	"context"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// eventTypeHeader is a header with a type of order event, so consumers may skip events without decoding them
+const eventTypeHeader = "event-type"
+
+type orderEventsStorage interface {
+	GetAndMarkOrderEvents(context.Context, int) ([]models.OrderEvent, error)
+	UpdateOrderEvent(context.Context, int, int, int, time.Time) error
+}
+
+// StartOrderEvents starts relay that publishes order events from the outbox to order events topic
+func StartOrderEvents(ctx context.Context, cfg config.Config, broker Broker, interval time.Duration,
+	storage orderEventsStorage, batchSize int) {
+	go func() {
+		if err := orderEventsRelay(ctx, cfg, broker, interval, storage, batchSize); err != nil {
+			log.Fatalf("Error occurred during order events relay execution: %v", err)
+		}
+	}()
+}
+
+// newOrderEventMessage creates message with an order event keyed by order id, so events of an order
+// get to the same partition and are consumed in order they happened
+func newOrderEventMessage(topic string, event models.OrderEvent) *sarama.ProducerMessage {
+	return &sarama.ProducerMessage{
+		Topic: topic,
+		Key:   sarama.StringEncoder(strconv.Itoa(event.OrderID)),
+		Value: sarama.ByteEncoder(event.Payload),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(contentTypeHeader), Value: []byte(jsonContentType)},
+			{Key: []byte(eventTypeHeader), Value: []byte(event.Type)},
+			{Key: []byte(dedupHeader), Value: []byte(orderEventDedupKey(event.ID))},
+		},
+	}
+}
+
+// orderEventsRelay sends events taken from the outbox one by one, storage gives at most one unsent event
+// of an order at a time, so retries don't reorder events of an order
+func orderEventsRelay(ctx context.Context, cfg config.Config, broker Broker, interval time.Duration,
+	storage orderEventsStorage, batchSize int) error {
+	producer, err := broker.NewProducer()
+	if err != nil {
+		return err
+	}
+	defer producer.Close()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	log.Print("Starting orderEventsRelay")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			events, err := storage.GetAndMarkOrderEvents(ctx, batchSize)
+			if err != nil {
+				log.Printf("error getting order events: %v", err)
+
+				continue
+			}
+
+			for _, event := range events {
+				publishOrderEvent(ctx, producer, storage, cfg.KafkaOrderEventsTopic(),
+					cfg.OrderEventsMaxAttempts(), event)
+			}
+		}
+	}
+}
+
+// publishOrderEvent sends event and saves its status, event which status wasn't saved stays processing
+// and is sent again, consumers drop such duplicates by dedup key
+func publishOrderEvent(ctx context.Context, producer Producer, storage orderEventsStorage,
+	topic string, maxAttempts int, event models.OrderEvent) {
+	event.JobStatus = models.DoneStatus
+	if _, _, err := producer.SendMessage(newOrderEventMessage(topic, event)); err != nil {
+		log.Printf("error sending order event: %v, eventId: %d", err, event.ID)
+
+		event.JobStatus, event.AttemptsLeft, event.NextAttemptAt = failJob(event.AttemptsLeft, maxAttempts,
+			event.NextAttemptAt)
+	}
+
+	err := storage.UpdateOrderEvent(ctx, event.ID, event.JobStatus, event.AttemptsLeft, event.NextAttemptAt)
+	if err != nil {
+		log.Printf("error updating order event: %v, eventId: %d", err, event.ID)
+	}
+}
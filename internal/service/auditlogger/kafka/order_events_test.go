@@ -0,0 +1,112 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// updatedEvent is a status saved for an event
+type updatedEvent struct {
+	id            int
+	status        int
+	attemptsLeft  int
+	nextAttemptAt time.Time
+}
+
+type fakeOrderEventsStorage struct {
+	updated []updatedEvent
+}
+
+func (s *fakeOrderEventsStorage) GetAndMarkOrderEvents(context.Context, int) ([]models.OrderEvent, error) {
+	return nil, nil
+}
+
+func (s *fakeOrderEventsStorage) UpdateOrderEvent(_ context.Context, id int, status int, attemptsLeft int,
+	nextAttemptAt time.Time) error {
+	s.updated = append(s.updated, updatedEvent{id, status, attemptsLeft, nextAttemptAt})
+
+	return nil
+}
+
+func TestNewOrderEventMessage(t *testing.T) {
+	t.Parallel()
+
+	event, err := models.NewOrderEvent(models.Order{ID: 42}, models.OrderGiven)
+	require.NoError(t, err)
+	event.ID = 7
+
+	message := newOrderEventMessage("order-events", *event)
+
+	key, err := message.Key.Encode()
+	require.NoError(t, err)
+	assert.Equal(t, "42", string(key))
+	assert.Equal(t, []sarama.RecordHeader{
+		{Key: []byte(contentTypeHeader), Value: []byte(jsonContentType)},
+		{Key: []byte(eventTypeHeader), Value: []byte("OrderGiven")},
+		{Key: []byte(dedupHeader), Value: []byte("order-event-7")},
+	}, message.Headers)
+}
+
+func TestPublishOrderEvent(t *testing.T) {
+	t.Parallel()
+
+	errSend := errors.New("send failed")
+	nextAttemptAt := time.Date(2025, 4, 25, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		sendErr          error
+		attemptsLeft     int
+		wantStatus       int
+		wantAttemptsLeft int
+		wantRetry        bool
+	}{
+		{name: "Sent", attemptsLeft: 3, wantStatus: models.DoneStatus, wantAttemptsLeft: 3},
+		{name: "Failed", sendErr: errSend, attemptsLeft: 3, wantStatus: models.FailedStatus, wantAttemptsLeft: 2,
+			wantRetry: true},
+		{name: "NoAttemptsLeft", sendErr: errSend, attemptsLeft: 1, wantStatus: models.NoAttemptsLeftStatus},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			producer := mocks.NewSyncProducer(t, nil)
+			if tt.sendErr != nil {
+				producer.ExpectSendMessageAndFail(tt.sendErr)
+			} else {
+				producer.ExpectSendMessageAndSucceed()
+			}
+
+			storage := &fakeOrderEventsStorage{}
+			publishOrderEvent(t.Context(), producer, storage, "order-events", 3, models.OrderEvent{
+				ID:            7,
+				OrderID:       42,
+				Type:          models.OrderAccepted,
+				AttemptsLeft:  tt.attemptsLeft,
+				NextAttemptAt: nextAttemptAt,
+			})
+			require.NoError(t, producer.Close())
+
+			require.Len(t, storage.updated, 1)
+			updated := storage.updated[0]
+			assert.Equal(t, 7, updated.id)
+			assert.Equal(t, tt.wantStatus, updated.status)
+			assert.Equal(t, tt.wantAttemptsLeft, updated.attemptsLeft)
+			if tt.wantRetry {
+				assert.True(t, updated.nextAttemptAt.After(time.Now()))
+			} else {
+				assert.Equal(t, nextAttemptAt, updated.nextAttemptAt)
+			}
+		})
+	}
+}
@@ -15,44 +15,47 @@ import (
 
 const (
 	batchCount = 10
+
+	// baseRetryDelay is a delay before the second attempt, it doubles after every failed attempt
+	baseRetryDelay = 10 * time.Second
+
+	// maxRetryDelay limits delay between attempts
+	maxRetryDelay = 10 * time.Minute
 )
 
 type logsStorage interface {
 	GetAndMarkLogs(context.Context, int) ([]models.Log, error)
-	UpdateLog(context.Context, int, int, int) error
+	UpdateLog(context.Context, int, int, int, time.Time) error
 }
 
-// Start starts pool of Kafka related workers
-func Start(ctx context.Context, cfg config.Config, interval time.Duration,
+// Start starts pool of Kafka related workers, Kafka clients are created by broker
+func Start(ctx context.Context, cfg config.Config, broker Broker, interval time.Duration,
 	storage logsStorage, batchSize int) {
-	if cfg.IsEmpty() {
-		return
-	}
-
+	// channels aren't closed, since workers may still send to them once context is done,
+	// every worker stops on context instead
 	jobs := make(chan models.Log, batchSize*batchCount)
-	done := make(chan models.Log, batchSize*batchCount)
+	done := make(chan consumedLog, batchSize*batchCount)
 	failed := make(chan models.Log, batchSize*batchCount)
+	dead := make(chan models.Log, batchSize*batchCount)
 
 	g, gCtx := errgroup.WithContext(ctx)
-	go func() {
-		<-gCtx.Done()
-		close(jobs)
-		close(failed)
-		close(done)
-	}()
 
 	g.Go(func() error {
 		return dbReader(gCtx, interval, storage, batchSize, jobs)
 	})
 
 	g.Go(func() error {
-		return jobSender(gCtx, cfg, jobs, failed)
+		return jobSender(gCtx, cfg, broker, jobs, failed)
+	})
+
+	g.Go(func() error {
+		return dlqSender(gCtx, cfg, broker, dead)
 	})
 
-	go updater(gCtx, storage, done, failed)
+	go updater(gCtx, storage, cfg.AuditMaxAttempts(), done, failed, dead)
 
 	g.Go(func() error {
-		return logger(gCtx, cfg, done)
+		return logger(gCtx, cfg, broker, done)
 	})
 
 	go func() {
@@ -62,23 +65,16 @@ func Start(ctx context.Context, cfg config.Config, interval time.Duration,
 	}()
 }
 
-func initConsumer(ctx context.Context, cfg config.Config) (sarama.PartitionConsumer, error) {
-	consumer, err := sarama.NewConsumer([]string{fmt.Sprintf("%s:%s", cfg.KafkaHost(), cfg.KafkaPort())},
-		sarama.NewConfig())
-	if err != nil {
-		return nil, err
-	}
-
-	partConsumer, err := consumer.ConsumePartition("logs", 0, sarama.OffsetOldest)
-	if err != nil {
-		return nil, err
+// initConsumerGroup creates consumer group, offsets marked by updater are committed periodically
+// and before partitions are revoked, a new group starts from the oldest message
+func initConsumerGroup(cfg config.Config, groupID string) (sarama.ConsumerGroup, error) {
+	kafkaConfig := sarama.NewConfig()
+	kafkaConfig.Consumer.Offsets.Initial = sarama.OffsetOldest
+	kafkaConfig.Consumer.Offsets.AutoCommit.Enable = true
+	kafkaConfig.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{
+		sarama.NewBalanceStrategySticky(),
 	}
 
-	go func() {
-		<-ctx.Done()
-		consumer.Close()
-		partConsumer.Close()
-	}()
-
-	return partConsumer, nil
+	return sarama.NewConsumerGroup([]string{fmt.Sprintf("%s:%s", cfg.KafkaHost(), cfg.KafkaPort())},
+		groupID, kafkaConfig)
 }
@@ -0,0 +1,228 @@
+package kafka
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// memoryLogsStorage is logs table kept in memory, logs are marked and updated like LogsRepo does
+type memoryLogsStorage struct {
+	mu   sync.Mutex
+	logs map[int]models.Log
+}
+
+func newMemoryLogsStorage(attemptsLeft int, ids ...int) *memoryLogsStorage {
+	logs := make(map[int]models.Log, len(ids))
+	for _, id := range ids {
+		log := *models.NewLog(id, 1, "success", "/orders", "POST", 200)
+		log.ID = id
+		log.JobStatus = models.CreatedStatus
+		log.AttemptsLeft = attemptsLeft
+		logs[id] = log
+	}
+
+	return &memoryLogsStorage{
+		logs: logs,
+	}
+}
+
+func (s *memoryLogsStorage) GetAndMarkLogs(_ context.Context, batchSize int) ([]models.Log, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ready := make([]models.Log, 0, batchSize)
+	for _, log := range s.logs {
+		if (log.JobStatus == models.CreatedStatus || log.JobStatus == models.FailedStatus) &&
+			!log.NextAttemptAt.After(time.Now()) {
+			ready = append(ready, log)
+		}
+	}
+	sort.Slice(ready, func(i, j int) bool {
+		return ready[i].ID < ready[j].ID
+	})
+	if len(ready) > batchSize {
+		ready = ready[:batchSize]
+	}
+
+	for x := range ready {
+		ready[x].JobStatus = models.ProcessingStatus
+		s.logs[ready[x].ID] = ready[x]
+	}
+
+	return ready, nil
+}
+
+func (s *memoryLogsStorage) UpdateLog(_ context.Context, id int, status int, attemptsLeft int,
+	nextAttemptAt time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	log := s.logs[id]
+	if log.JobStatus == models.DoneStatus {
+		return nil
+	}
+	log.JobStatus = status
+	log.AttemptsLeft = attemptsLeft
+	log.NextAttemptAt = nextAttemptAt
+	s.logs[id] = log
+
+	return nil
+}
+
+func (s *memoryLogsStorage) statuses() map[int]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	statuses := make(map[int]int, len(s.logs))
+	for id, log := range s.logs {
+		statuses[id] = log.JobStatus
+	}
+
+	return statuses
+}
+
+// unavailableTopicBroker fails sends to a topic, other topics are kept by MemoryBroker
+type unavailableTopicBroker struct {
+	*MemoryBroker
+	topic string
+}
+
+func (b unavailableTopicBroker) NewProducer() (Producer, error) {
+	producer, err := b.MemoryBroker.NewProducer()
+
+	return unavailableTopicProducer{Producer: producer, topic: b.topic}, err
+}
+
+type unavailableTopicProducer struct {
+	Producer
+	topic string
+}
+
+func (p unavailableTopicProducer) SendMessage(msg *sarama.ProducerMessage) (int32, int64, error) {
+	if msg.Topic == p.topic {
+		return 0, 0, sarama.ErrLeaderNotAvailable
+	}
+
+	return p.Producer.SendMessage(msg)
+}
+
+// cancelingLogsStorage cancels pool ctx while a batch is read and returns the batch anyway,
+// so workers are sending logs to each other once pool is stopped
+type cancelingLogsStorage struct {
+	*memoryLogsStorage
+	mu          sync.Mutex
+	reads       int
+	cancelAfter int
+	cancel      context.CancelFunc
+}
+
+func (s *cancelingLogsStorage) GetAndMarkLogs(ctx context.Context, batchSize int) ([]models.Log, error) {
+	s.mu.Lock()
+	s.reads++
+	reads := s.reads
+	s.mu.Unlock()
+
+	if reads > s.cancelAfter {
+		s.cancel()
+		<-ctx.Done()
+		// let pool react to the stop before the batch is returned
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	return s.memoryLogsStorage.GetAndMarkLogs(ctx, batchSize)
+}
+
+func dedupKeys(t *testing.T, messages []*sarama.ConsumerMessage) []int {
+	t.Helper()
+
+	ids := make([]int, 0, len(messages))
+	for _, msg := range messages {
+		id, ok, err := parseDedupKey(msg.Headers)
+		require.NoError(t, err)
+		require.True(t, ok)
+		ids = append(ids, id)
+	}
+
+	return ids
+}
+
+func TestStart(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.Config{}
+
+	t.Run("Delivered", func(t *testing.T) {
+		t.Parallel()
+
+		broker := NewMemoryBroker()
+		storage := newMemoryLogsStorage(3, 1, 2, 3)
+		Start(t.Context(), cfg, broker, 10*time.Millisecond, storage, 2)
+
+		done := map[int]int{1: models.DoneStatus, 2: models.DoneStatus, 3: models.DoneStatus}
+		require.Eventually(t, func() bool {
+			return assert.ObjectsAreEqual(done, storage.statuses())
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, []int{1, 2, 3}, dedupKeys(t, broker.Messages(cfg.KafkaLogsTopic())))
+		// offsets are marked only after logs are saved as done
+		assert.Equal(t, int64(3), broker.offset(cfg.KafkaConsumerGroup(), cfg.KafkaLogsTopic()))
+		assert.Empty(t, broker.Messages(cfg.KafkaDLQTopic()))
+	})
+
+	t.Run("DeadLettered", func(t *testing.T) {
+		t.Parallel()
+
+		broker := NewMemoryBroker()
+		storage := newMemoryLogsStorage(1, 1, 2)
+		Start(t.Context(), cfg, unavailableTopicBroker{MemoryBroker: broker, topic: cfg.KafkaLogsTopic()},
+			10*time.Millisecond, storage, 2)
+
+		require.Eventually(t, func() bool {
+			return len(broker.Messages(cfg.KafkaDLQTopic())) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+
+		assert.Equal(t, map[int]int{1: models.NoAttemptsLeftStatus, 2: models.NoAttemptsLeftStatus},
+			storage.statuses())
+		assert.ElementsMatch(t, []int{1, 2}, dedupKeys(t, broker.Messages(cfg.KafkaDLQTopic())))
+		assert.Empty(t, broker.Messages(cfg.KafkaLogsTopic()))
+
+		// dead letter keeps HTTP status of the logged request and tells job status apart
+		for _, msg := range broker.Messages(cfg.KafkaDLQTopic()) {
+			dead, err := DecodeLog(msg.Headers, msg.Value)
+			require.NoError(t, err)
+			assert.Equal(t, 200, dead.Status)
+			assert.Equal(t, models.NoAttemptsLeftStatus, dead.JobStatus)
+			assert.Equal(t, 0, dead.AttemptsLeft)
+			assert.Equal(t, "/orders", dead.URL)
+		}
+	})
+	t.Run("Canceled while sending", func(t *testing.T) {
+		t.Parallel()
+
+		ids := make([]int, 0, 100)
+		for id := 1; id <= 100; id++ {
+			ids = append(ids, id)
+		}
+
+		ctx, cancel := context.WithCancel(t.Context())
+		broker := NewMemoryBroker()
+		storage := &cancelingLogsStorage{memoryLogsStorage: newMemoryLogsStorage(3, ids...), cancel: cancel,
+			cancelAfter: 2}
+		Start(ctx, cfg, broker, time.Millisecond, storage, 20)
+
+		<-ctx.Done()
+		// workers still sending once ctx is canceled would panic on closed channels and crash the test binary
+		time.Sleep(100 * time.Millisecond)
+		assert.NotEmpty(t, broker.Messages(cfg.KafkaLogsTopic()))
+	})
+}
@@ -0,0 +1,27 @@
+package kafka
+
+import (
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+)
+
+// SaramaBroker is a broker that connects to Kafka with sarama clients
+type SaramaBroker struct {
+	cfg config.Config
+}
+
+// NewSaramaBroker creates broker that connects to Kafka at address from config
+func NewSaramaBroker(cfg config.Config) *SaramaBroker {
+	return &SaramaBroker{
+		cfg: cfg,
+	}
+}
+
+// NewProducer creates idempotent producer
+func (b *SaramaBroker) NewProducer() (Producer, error) {
+	return newProducer(b.cfg)
+}
+
+// NewConsumerGroup creates consumer group that starts from the oldest message
+func (b *SaramaBroker) NewConsumerGroup(groupID string) (ConsumerGroup, error) {
+	return initConsumerGroup(b.cfg, groupID)
+}
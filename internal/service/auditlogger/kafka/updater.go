@@ -3,41 +3,65 @@ package kafka
 import (
 	"context"
 	"log"
+	"time"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/retry"
 )
 
+// updater saves statuses of sent and failed jobs, offsets of consumed logs are marked only after
+// they are saved as done, logs which status wasn't saved stay processing and are sent again by dbReader.
+// Done status is final, so duplicates of consumed logs and late failures don't change it.
+// Failed jobs are retried with exponential backoff, jobs without attempts left are sent to dead.
+// Job status is kept in JobStatus, Status is an HTTP status of the logged request and isn't changed
+//
 //nolint:gocognit
-func updater(ctx context.Context, storage logsStorage, done <-chan models.Log, failed <-chan models.Log) {
+func updater(ctx context.Context, storage logsStorage, maxAttempts int, done <-chan consumedLog,
+	failed <-chan models.Log, dead chan<- models.Log) {
 	for {
-		var ok bool
 		var job models.Log
+		var consumed consumedLog
 
 		select {
 		case <-ctx.Done():
 			return
-		case job, ok = <-done:
-			if !ok {
-				continue
-			}
-
-			job.Status = models.DoneStatus
-		case job, ok = <-failed:
-			if !ok {
-				continue
-			}
-
-			job.AttemptsLeft--
-			if job.AttemptsLeft == 0 {
-				job.Status = models.NoAttemptsLeftStatus
-			} else {
-				job.Status = models.FailedStatus
-			}
+		case consumed = <-done:
+			job = consumed.log
+			job.JobStatus = models.DoneStatus
+		case job = <-failed:
+			job.JobStatus, job.AttemptsLeft, job.NextAttemptAt = failJob(job.AttemptsLeft, maxAttempts,
+				job.NextAttemptAt)
 		}
 
-		err := storage.UpdateLog(ctx, job.ID, job.Status, job.AttemptsLeft)
+		err := storage.UpdateLog(ctx, job.ID, job.JobStatus, job.AttemptsLeft, job.NextAttemptAt)
 		if err != nil {
 			log.Printf("error updating jog: %v, jogId: %d", err, job.ID)
+
+			continue
+		}
+
+		if consumed.commit != nil {
+			consumed.commit()
+		}
+
+		if job.JobStatus == models.NoAttemptsLeftStatus {
+			select {
+			case <-ctx.Done():
+				return
+			case dead <- job:
+			}
 		}
 	}
 }
+
+// failJob returns status, attempts left and time of the next attempt of a job which send has failed,
+// failed job is retried with exponential backoff, job without attempts left keeps its time of the next attempt
+func failJob(attemptsLeft int, maxAttempts int, nextAttemptAt time.Time) (int, int, time.Time) {
+	attemptsLeft--
+	if attemptsLeft <= 0 {
+		return models.NoAttemptsLeftStatus, attemptsLeft, nextAttemptAt
+	}
+
+	return models.FailedStatus, attemptsLeft, time.Now().Add(retry.Jitter(retry.Backoff(baseRetryDelay,
+		maxRetryDelay, maxAttempts-attemptsLeft)))
+}
@@ -0,0 +1,67 @@
+package auditlogger
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/IBM/sarama"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+)
+
+var (
+	errNoTopic = errors.New("kafka sink requires topic")
+)
+
+// kafkaSink sends logs straight to a Kafka topic, unlike relay of logs written by postgres sink
+// it doesn't retry failed messages
+type kafkaSink struct {
+	producer kafka.Producer
+	topic    string
+}
+
+func newKafkaSink(deps Dependencies, cfg SinkConfig) (Sink, error) {
+	if cfg.Topic == "" {
+		return nil, errNoTopic
+	}
+
+	if deps.Broker == nil {
+		return nil, kafka.ErrNoBroker
+	}
+
+	producer, err := deps.Broker.NewProducer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &kafkaSink{
+		producer: producer,
+		topic:    cfg.Topic,
+	}, nil
+}
+
+func (s *kafkaSink) Write(_ context.Context, logs []models.Log) error {
+	messages := make([]*sarama.ProducerMessage, 0, len(logs))
+	for _, log := range logs {
+		data, headers, err := kafka.EncodeLog(log)
+		if err != nil {
+			return err
+		}
+
+		messages = append(messages, &sarama.ProducerMessage{
+			Topic: s.topic,
+			// logs of an order keep their order within a partition
+			Key:     sarama.StringEncoder(strconv.Itoa(log.OrderID)),
+			Value:   sarama.ByteEncoder(data),
+			Headers: headers,
+		})
+	}
+
+	return s.producer.SendMessages(messages)
+}
+
+func (s *kafkaSink) Close() error {
+	return s.producer.Close()
+}
@@ -0,0 +1,26 @@
+package auditlogger
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// postgresSink writes logs to logs table, Kafka relay sends them from there
+type postgresSink struct {
+	storage auditLoggerStorage
+}
+
+func newPostgresSink(deps Dependencies, _ SinkConfig) (Sink, error) {
+	return &postgresSink{
+		storage: deps.Storage,
+	}, nil
+}
+
+func (s *postgresSink) Write(ctx context.Context, logs []models.Log) error {
+	return s.storage.CreateLog(ctx, logs)
+}
+
+func (s *postgresSink) Close() error {
+	return nil
+}
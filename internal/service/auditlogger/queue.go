@@ -0,0 +1,126 @@
+package auditlogger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
+)
+
+const (
+	// blockPolicy waits for a free place in queue, log is dropped after enqueue timeout
+	blockPolicy = "block"
+
+	// dropOldestPolicy drops the oldest log in queue to make place for a new one
+	dropOldestPolicy = "drop_oldest"
+
+	// spillPolicy writes log straight to db, it's allowed only for postgres sink, which writes there anyway
+	spillPolicy = "spill"
+
+	// spillSinkType is a type of sink spilled logs are equivalent to the written ones for
+	spillSinkType = "postgres"
+
+	defaultEnqueueTimeout = 100 * time.Millisecond
+
+	dropReasonTimeout  = "timeout"
+	dropReasonOldest   = "oldest"
+	dropReasonSpill    = "spill_failed"
+	dropReasonShutdown = "shutdown"
+)
+
+var (
+	errUnknownQueuePolicy  = errors.New("unknown audit queue policy")
+	errWrongEnqueueTimeout = errors.New("wrong audit enqueue timeout")
+	errSpillNotSupported   = errors.New("audit queue policy spill is supported only by postgres sink")
+)
+
+// sinkQueue is a bounded queue of logs waiting to be written to a sink
+type sinkQueue struct {
+	name    string
+	jobs    chan models.Log
+	policy  string
+	timeout time.Duration
+	filter  *sinkFilter
+	storage auditLoggerStorage
+}
+
+// newSinkQueue creates queue of a sink, name identifies sink in metrics, filter is applied to spilled logs
+// the same way sink workers apply it
+func newSinkQueue(name string, cfg SinkConfig, filter *sinkFilter, storage auditLoggerStorage) (*sinkQueue, error) {
+	policy := cfg.QueuePolicy
+	if policy == "" {
+		policy = blockPolicy
+	}
+	if policy != blockPolicy && policy != dropOldestPolicy && policy != spillPolicy {
+		return nil, fmt.Errorf("%w of %s sink: %q", errUnknownQueuePolicy, cfg.Type, policy)
+	}
+	// logs spilled from other sinks would never reach them and would be duplicated in db
+	if policy == spillPolicy && cfg.Type != spillSinkType {
+		return nil, fmt.Errorf("%w, got %s sink", errSpillNotSupported, cfg.Type)
+	}
+
+	timeout := defaultEnqueueTimeout
+	if cfg.EnqueueTimeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(cfg.EnqueueTimeout)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("%w of %s sink: %q", errWrongEnqueueTimeout, cfg.Type, cfg.EnqueueTimeout)
+		}
+	}
+
+	return &sinkQueue{
+		name:    name,
+		jobs:    make(chan models.Log, cfg.QueueSize),
+		policy:  policy,
+		timeout: timeout,
+		filter:  filter,
+		storage: storage,
+	}, nil
+}
+
+// enqueue puts log into queue applying queue policy if it's full, it never blocks longer than enqueue timeout
+func (q *sinkQueue) enqueue(ctx context.Context, log models.Log) {
+	select {
+	case q.jobs <- log:
+		return
+	default:
+	}
+
+	switch q.policy {
+	case dropOldestPolicy:
+		select {
+		case <-q.jobs:
+			monitoring.SetAuditDropped(q.name, dropReasonOldest)
+		default:
+		}
+
+		select {
+		case q.jobs <- log:
+		default:
+			// workers may have taken the place and another writer filled it
+			monitoring.SetAuditDropped(q.name, dropReasonOldest)
+		}
+	case spillPolicy:
+		if !q.filter.Match(log) {
+			return
+		}
+		if err := q.storage.CreateLog(ctx, []models.Log{log}); err != nil {
+			monitoring.SetAuditDropped(q.name, dropReasonSpill)
+
+			return
+		}
+		monitoring.SetAuditSpilled(q.name)
+	default:
+		timer := time.NewTimer(q.timeout)
+		defer timer.Stop()
+
+		select {
+		case q.jobs <- log:
+		case <-timer.C:
+			monitoring.SetAuditDropped(q.name, dropReasonTimeout)
+		}
+	}
+}
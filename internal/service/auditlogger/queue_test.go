@@ -0,0 +1,221 @@
+package auditlogger
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// memoryStorage keeps created logs in memory
+type memoryStorage struct {
+	mu   sync.Mutex
+	logs []models.Log
+}
+
+func (s *memoryStorage) GetAndMarkLogs(context.Context, int) ([]models.Log, error) {
+	return nil, nil
+}
+
+func (s *memoryStorage) UpdateLog(context.Context, int, int, int, time.Time) error {
+	return nil
+}
+
+func (s *memoryStorage) CreateLog(_ context.Context, logs []models.Log) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.logs = append(s.logs, logs...)
+
+	return nil
+}
+
+func (s *memoryStorage) created() []models.Log {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]models.Log(nil), s.logs...)
+}
+
+func queued(queue *sinkQueue) []models.Log {
+	res := make([]models.Log, 0, len(queue.jobs))
+	for len(queue.jobs) > 0 {
+		res = append(res, <-queue.jobs)
+	}
+
+	return res
+}
+
+func TestSinkQueue_Enqueue(t *testing.T) {
+	t.Parallel()
+
+	first := models.Log{ID: 1}
+	second := models.Log{ID: 2}
+	third := models.Log{ID: 3}
+
+	tests := []struct {
+		name           string
+		sinkType       string
+		policy         string
+		filter         string
+		expectedQueued []models.Log
+		expectedSpill  []models.Log
+	}{
+		{name: "Block", sinkType: "stdout", policy: blockPolicy, expectedQueued: []models.Log{first, second}},
+		{name: "Drop oldest", sinkType: "stdout", policy: dropOldestPolicy,
+			expectedQueued: []models.Log{second, third}},
+		{name: "Spill", sinkType: spillSinkType, policy: spillPolicy, expectedQueued: []models.Log{first, second},
+			expectedSpill: []models.Log{third}},
+		{name: "Spill filtered out", sinkType: spillSinkType, policy: spillPolicy, filter: "id != 3",
+			expectedQueued: []models.Log{first, second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := SinkConfig{Type: tt.sinkType, Filter: tt.filter, QueueSize: 2, QueuePolicy: tt.policy,
+				EnqueueTimeout: "10ms"}
+			filter, err := newSinkFilter(cfg)
+			require.NoError(t, err)
+			storage := &memoryStorage{}
+			queue, err := newSinkQueue(tt.sinkType+"-0", cfg, filter, storage)
+			require.NoError(t, err)
+
+			start := time.Now()
+			for _, log := range []models.Log{first, second, third} {
+				queue.enqueue(t.Context(), log)
+			}
+
+			assert.Less(t, time.Since(start), time.Second)
+			assert.Equal(t, tt.expectedQueued, queued(queue))
+			assert.Equal(t, tt.expectedSpill, storage.created())
+		})
+	}
+}
+
+func TestNewSinkQueue_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := newSinkQueue("stdout-0", SinkConfig{Type: "stdout", QueuePolicy: "drop_newest"}, nil, nil)
+	require.ErrorIs(t, err, errUnknownQueuePolicy)
+
+	_, err = newSinkQueue("stdout-0", SinkConfig{Type: "stdout", EnqueueTimeout: "soon"}, nil, nil)
+	require.ErrorIs(t, err, errWrongEnqueueTimeout)
+
+	_, err = newSinkQueue("stdout-0", SinkConfig{Type: "stdout", QueuePolicy: spillPolicy}, nil, nil)
+	require.ErrorIs(t, err, errSpillNotSupported)
+}
+
+func TestService_Spill(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		config        string
+		expectedError error
+	}{
+		{
+			name: "Postgres sink spills",
+			config: `{"sinks": [
+				{"type": "postgres", "workers": 1, "queue_size": 1, "queue_policy": "spill"},
+				{"type": "stdout", "workers": 1, "filter": "url ~ \"order\""}
+			]}`,
+		},
+		{
+			name: "Stdout sink can't spill",
+			config: `{"sinks": [
+				{"type": "postgres", "workers": 1},
+				{"type": "stdout", "workers": 1, "filter": "url ~ \"order\"", "queue_size": 1, "queue_policy": "spill"}
+			]}`,
+			expectedError: errSpillNotSupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "logger.config")
+			require.NoError(t, os.WriteFile(path, []byte(tt.config), 0o600))
+
+			storage := &memoryStorage{}
+			s, err := newService(t.Context(), config.Config{}, path, storage, nil, 1, 100, time.Hour)
+			require.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError != nil {
+				return
+			}
+
+			// logs are either batched or spilled, but each of them is written to db once
+			logs := make([]models.Log, 0, 50)
+			for i := range 50 {
+				log := *models.NewLog(i, 1, "success", "/orders", "DELETE", 200)
+				logs = append(logs, log)
+				s.CreateLog(t.Context(), log)
+			}
+
+			shutdownCtx, shutdownCancel := context.WithTimeout(t.Context(), time.Second)
+			defer shutdownCancel()
+			require.NoError(t, s.Shutdown(shutdownCtx))
+			assert.ElementsMatch(t, logs, storage.created())
+		})
+	}
+}
+
+func TestService_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	storage := &memoryStorage{}
+	// batches are flushed only by shutdown, since they are neither full nor timed out
+	s, err := NewService(ctx, config.Config{}, storage, nil, 1, 100, time.Hour)
+	require.NoError(t, err)
+
+	logs := []models.Log{
+		*models.NewLog(-1, 1, "success", "/admins", "POST", 200),
+		*models.NewLog(-1, 1, "success", "/admins", "PUT", 200),
+		*models.NewLog(-1, 1, "success", "/admins", "DELETE", 200),
+	}
+	for _, log := range logs {
+		s.CreateLog(ctx, log)
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, time.Second)
+	defer shutdownCancel()
+	require.NoError(t, s.Shutdown(shutdownCtx))
+	assert.Equal(t, logs, storage.created())
+
+	// logs after shutdown are dropped instead of being sent to closed queues
+	s.CreateLog(ctx, logs[0])
+	require.NoError(t, s.Shutdown(shutdownCtx))
+	assert.Len(t, storage.created(), len(logs))
+}
+
+func TestService_CreateLog_AfterCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	storage := &memoryStorage{}
+	s, err := NewService(ctx, config.Config{}, storage, nil, 1, 100, time.Hour)
+	require.NoError(t, err)
+
+	// server ctx is canceled before its graceful stop, logs of requests finishing meanwhile are still written
+	cancel()
+	log := *models.NewLog(-1, 1, "success", "/admins", "DELETE", 200)
+	s.CreateLog(ctx, log)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), time.Second)
+	defer shutdownCancel()
+	require.NoError(t, s.Shutdown(shutdownCtx))
+	assert.Equal(t, []models.Log{log}, storage.created())
+}
@@ -2,12 +2,11 @@ package auditlogger
 
 import (
 	"context"
+	"fmt"
 	"log"
-	"strings"
+	"sync"
 	"time"
 
-	"golang.org/x/sync/errgroup"
-
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
@@ -15,67 +14,141 @@ import (
 
 type auditLoggerStorage interface {
 	GetAndMarkLogs(context.Context, int) ([]models.Log, error)
-	UpdateLog(context.Context, int, int, int) error
+	UpdateLog(context.Context, int, int, int, time.Time) error
 	CreateLog(context.Context, []models.Log) error
 }
 
-// Service is structure of audit log service
+// Service is structure of audit log service, every log is passed to all configured sinks
 type Service struct {
 	Storage auditLoggerStorage
-	jobs    chan models.Log
+	queues  []*sinkQueue
+
+	// mu guards closed, logs are enqueued under read lock, so queues aren't closed while they are written to
+	mu      sync.RWMutex
+	closed  bool
+	stop    sync.Once
+	drained chan struct{}
+
+	// ctx is a lifetime of the service, sinks write with it and it's canceled only by Shutdown,
+	// so logs of requests finishing during server graceful stop are still written
+	ctx    context.Context
+	cancel context.CancelFunc
 }
 
-// NewService creates instance of Service
-func NewService(ctx context.Context, cfg config.Config, logs auditLoggerStorage,
+// NewService creates instance of Service, sinks are read from AUDIT_SINKS_CONFIG file or logger.config,
+// their filters are reloaded once the file changes, workerCount, batchSize and timeout are used for sinks
+// that don't set their own. Logs are sent to Kafka by broker, they stay in db if broker is nil.
+// Kafka relay stops once ctx is done, logs are accepted until Shutdown is called
+func NewService(ctx context.Context, cfg config.Config, logs auditLoggerStorage, broker kafka.Broker,
 	workerCount int, batchSize int, timeout time.Duration) (*Service, error) {
-	kafka.Start(ctx, cfg, timeout/2, logs, batchSize)
-
-	jobs := make(chan models.Log, batchSize*20*workerCount)
-
-	g, gCtx := errgroup.WithContext(ctx)
+	path := cfg.AuditSinksConfig()
+	if path == "" {
+		rootDir, err := config.GetRootDir()
+		if err != nil {
+			return nil, err
+		}
+		path = rootDir + "/logger.config"
+	}
 
-	go func() {
-		<-gCtx.Done()
-		close(jobs)
-	}()
+	return newService(ctx, cfg, path, logs, broker, workerCount, batchSize, timeout)
+}
 
-	rootDir, err := config.GetRootDir()
+// newService creates instance of Service with sinks from config file at path
+func newService(ctx context.Context, cfg config.Config, path string, logs auditLoggerStorage, broker kafka.Broker,
+	workerCount int, batchSize int, timeout time.Duration) (*Service, error) {
+	sinkConfigs, err := LoadSinkConfigs(path)
 	if err != nil {
 		return nil, err
 	}
 
-	word, err := config.ReadFirstFileWord(rootDir + "/logger.config")
-	if err != nil {
-		return nil, err
+	// logs stay in db until they are sent, so they aren't lost if Kafka isn't configured
+	if broker == nil {
+		log.Print("Kafka isn't configured, audit logs aren't sent to Kafka")
+	} else {
+		kafka.Start(ctx, cfg, broker, timeout/2, logs, batchSize)
 	}
 
 	s := &Service{
 		Storage: logs,
-		jobs:    jobs,
+		queues:  make([]*sinkQueue, 0, len(sinkConfigs)),
+		drained: make(chan struct{}),
+	}
+
+	filters := make([]*sinkFilter, 0, len(sinkConfigs))
+	for i := range sinkConfigs {
+		sinkConfig := &sinkConfigs[i]
+		if sinkConfig.Workers <= 0 {
+			sinkConfig.Workers = workerCount
+		}
+		if sinkConfig.BatchSize <= 0 {
+			sinkConfig.BatchSize = batchSize
+		}
+		if sinkConfig.QueueSize <= 0 {
+			sinkConfig.QueueSize = sinkConfig.BatchSize * 20 * sinkConfig.Workers
+		}
+
+		filter, err := newSinkFilter(*sinkConfig)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+
+		queue, err := newSinkQueue(fmt.Sprintf("%s-%d", sinkConfig.Type, i), *sinkConfig, filter, logs)
+		if err != nil {
+			return nil, err
+		}
+		s.queues = append(s.queues, queue)
 	}
 
-	dbWorkerCount := workerCount/2 + workerCount%2
-	for i := 0; i < dbWorkerCount; i++ {
-		g.Go(func() error {
-			return s.dbWorker(gCtx, batchSize, timeout, jobs)
-		})
+	deps := Dependencies{
+		Config:  cfg,
+		Storage: logs,
+		Broker:  broker,
 	}
+	sinks := make([]Sink, 0, len(sinkConfigs))
+	for _, sinkConfig := range sinkConfigs {
+		sink, err := newSink(deps, sinkConfig)
+		if err != nil {
+			closeSinks(sinks)
 
-	operator := func(log models.Log) bool {
-		return strings.Contains(log.String(), word)
+			return nil, err
+		}
+		sinks = append(sinks, sink)
 	}
-	stdoutWorkerCount := workerCount / 2
-	for i := 0; i < stdoutWorkerCount; i++ {
-		g.Go(func() error {
-			return s.stdoutWorker(gCtx, batchSize, timeout, jobs, operator)
-		})
+
+	// service outlives ctx, but keeps its values like tracer spans
+	s.ctx, s.cancel = context.WithCancel(context.WithoutCancel(ctx))
+
+	var wg sync.WaitGroup
+	for i, sink := range sinks {
+		sinkConfig := sinkConfigs[i]
+		queue := s.queues[i]
+		operator := filters[i].Match
+		for j := 0; j < sinkConfig.Workers; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				s.sinkWorker(s.ctx, queue.name, sinkConfig, sink, timeout, queue.jobs, operator)
+			}()
+		}
 	}
 
+	go watchFilters(s.ctx, path, filters)
+	go s.reportQueueDepth()
+
 	go func() {
-		if err = g.Wait(); err != nil {
-			log.Fatalf("Error occurred during service execution: %v", err)
-		}
+		wg.Wait()
+		closeSinks(sinks)
+		close(s.drained)
 	}()
 
 	return s, nil
 }
+
+func closeSinks(sinks []Sink) {
+	for _, sink := range sinks {
+		if err := sink.Close(); err != nil {
+			log.Printf("Error closing audit sink: %v", err)
+		}
+	}
+}
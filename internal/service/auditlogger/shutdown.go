@@ -0,0 +1,64 @@
+package auditlogger
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
+)
+
+// depthReportInterval is how often queue depth metric is updated
+const depthReportInterval = time.Second
+
+var (
+	errShutdownTimedOut = errors.New("audit logger wasn't drained before shutdown deadline, some logs may be lost")
+)
+
+// Shutdown stops accepting logs and waits until logs already queued are filtered, batched and written
+// to sinks and sinks are closed, logs passed to CreateLog after it are dropped. Writes still in progress
+// are canceled once ctx is done
+func (s *Service) Shutdown(ctx context.Context) error {
+	s.closeQueues()
+	defer s.cancel()
+
+	select {
+	case <-s.drained:
+		return nil
+	case <-ctx.Done():
+		return errShutdownTimedOut
+	}
+}
+
+// closeQueues closes sink queues once, workers flush their batches after queues are closed
+func (s *Service) closeQueues() {
+	s.stop.Do(func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.closed = true
+		for _, queue := range s.queues {
+			close(queue.jobs)
+		}
+	})
+}
+
+func (s *Service) reportQueueDepth() {
+	ticker := time.NewTicker(depthReportInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.drained:
+			for _, queue := range s.queues {
+				monitoring.SetAuditQueueDepth(queue.name, 0)
+			}
+
+			return
+		case <-ticker.C:
+			for _, queue := range s.queues {
+				monitoring.SetAuditQueueDepth(queue.name, len(queue.jobs))
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package auditlogger
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+)
+
+var (
+	errUnknownSink = errors.New("unknown audit sink type")
+	errNoSinks     = errors.New("no audit sinks configured")
+)
+
+// Sink is a destination audit logs are written to, Write may be called from several workers at once
+type Sink interface {
+	Write(ctx context.Context, logs []models.Log) error
+	Close() error
+}
+
+// SinkConfig configures a single sink, zero batch size and worker count are replaced with service defaults
+type SinkConfig struct {
+	// Type is a name sink factory is registered with
+	Type string `json:"type"`
+
+	// Filter is a logfilter expression selecting logs written to the sink, all logs are written if it's empty
+	Filter string `json:"filter"`
+
+	BatchSize int `json:"batch_size"`
+	Workers   int `json:"workers"`
+
+	// Path is a file that file and syslog sinks write to
+	Path string `json:"path"`
+
+	// MaxSize is a size in bytes file is rotated after, file isn't rotated if it's zero
+	MaxSize int64 `json:"max_size"`
+
+	// MaxFiles is a number of rotated files kept
+	MaxFiles int `json:"max_files"`
+
+	// Topic is a Kafka topic kafka sink writes to
+	Topic string `json:"topic"`
+
+	// QueueSize is a number of logs waiting to be written, it's 20 batches per worker if it's zero
+	QueueSize int `json:"queue_size"`
+
+	// QueuePolicy is what happens to a log once queue is full: block, drop_oldest or spill (postgres sink only),
+	// block is default
+	QueuePolicy string `json:"queue_policy"`
+
+	// EnqueueTimeout is a duration like "100ms" block policy waits for before log is dropped
+	EnqueueTimeout string `json:"enqueue_timeout"`
+}
+
+// sinksConfig is a structure of audit sinks config file
+type sinksConfig struct {
+	Sinks []SinkConfig `json:"sinks"`
+}
+
+// Dependencies are shared resources sinks may need, Broker is nil if Kafka isn't configured
+type Dependencies struct {
+	Config  config.Config
+	Storage auditLoggerStorage
+	Broker  kafka.Broker
+}
+
+// SinkFactory creates a sink from its config
+type SinkFactory func(deps Dependencies, cfg SinkConfig) (Sink, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = map[string]SinkFactory{
+		"postgres": newPostgresSink,
+		"stdout":   newStdoutSink,
+		"file":     newJSONFileSink,
+		"syslog":   newSyslogFileSink,
+		"kafka":    newKafkaSink,
+	}
+)
+
+// RegisterSink registers a factory of sinks of a type, so they can be used in config
+func RegisterSink(sinkType string, factory SinkFactory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	registry[sinkType] = factory
+}
+
+func newSink(deps Dependencies, cfg SinkConfig) (Sink, error) {
+	registryMu.RLock()
+	factory, ok := registry[cfg.Type]
+	registryMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", errUnknownSink, cfg.Type)
+	}
+
+	return factory(deps, cfg)
+}
+
+// LoadSinkConfigs reads sink configs from a JSON file
+func LoadSinkConfigs(path string) ([]SinkConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cfg sinksConfig
+	if err = json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse audit sinks config %s: %w", path, err)
+	}
+
+	if len(cfg.Sinks) == 0 {
+		return nil, errNoSinks
+	}
+
+	return cfg.Sinks, nil
+}
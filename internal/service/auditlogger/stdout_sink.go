@@ -0,0 +1,40 @@
+package auditlogger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// stdoutSink prints logs in a human-readable format
+type stdoutSink struct {
+	mu  sync.Mutex
+	out io.Writer
+}
+
+func newStdoutSink(_ Dependencies, _ SinkConfig) (Sink, error) {
+	return &stdoutSink{
+		out: os.Stdout,
+	}, nil
+}
+
+func (s *stdoutSink) Write(_ context.Context, logs []models.Log) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, log := range logs {
+		if _, err := fmt.Fprint(s.out, log.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *stdoutSink) Close() error {
+	return nil
+}
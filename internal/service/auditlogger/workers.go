@@ -2,21 +2,29 @@ package auditlogger
 
 import (
 	"context"
+	"log"
 	"time"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
 )
 
-func (s *Service) dbWorker(ctx context.Context, batchSize int, timeout time.Duration, jobs chan models.Log) error {
-	batches := s.batcher(jobs, batchSize, timeout)
+// sinkWorker writes logs of a sink queue, name identifies sink in logs and metrics
+func (s *Service) sinkWorker(ctx context.Context, name string, cfg SinkConfig, sink Sink, timeout time.Duration,
+	jobs chan models.Log, operator func(log models.Log) bool) {
+	filtered := s.filter(jobs, operator)
+	batches := s.batcher(filtered, cfg.BatchSize, timeout)
 
-	return s.dbLogger(ctx, batches)
+	s.sinkWriter(ctx, name, sink, batches)
 }
 
-func (s *Service) stdoutWorker(ctx context.Context, batchSize int, timeout time.Duration,
-	jobs chan models.Log, operator func(log models.Log) bool) error {
-	filtered := s.filter(jobs, operator)
-	batches := s.batcher(filtered, batchSize, timeout)
-
-	return s.stdoutLogger(ctx, batches)
+// sinkWriter writes batches until they are over, batch that sink failed to write is dropped,
+// so a failing sink doesn't stop the others
+func (s *Service) sinkWriter(ctx context.Context, name string, sink Sink, batches <-chan []models.Log) {
+	for batch := range batches {
+		if err := sink.Write(ctx, batch); err != nil {
+			log.Printf("Failed to write %d audit logs to %s sink: %v", len(batch), name, err)
+			monitoring.SetAuditFailedBatch(name)
+		}
+	}
 }
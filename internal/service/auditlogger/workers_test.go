@@ -0,0 +1,49 @@
+package auditlogger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+var errSinkUnavailable = errors.New("sink unavailable")
+
+// failingSink fails to write the first failures batches
+type failingSink struct {
+	failures int
+	written  [][]models.Log
+}
+
+func (s *failingSink) Write(_ context.Context, logs []models.Log) error {
+	if s.failures > 0 {
+		s.failures--
+
+		return errSinkUnavailable
+	}
+	s.written = append(s.written, logs)
+
+	return nil
+}
+
+func (s *failingSink) Close() error {
+	return nil
+}
+
+func TestService_SinkWriter_ContinuesAfterFailure(t *testing.T) {
+	t.Parallel()
+
+	batches := make(chan []models.Log, 3)
+	batches <- []models.Log{{ID: 1}}
+	batches <- []models.Log{{ID: 2}}
+	batches <- []models.Log{{ID: 3}, {ID: 4}}
+	close(batches)
+
+	sink := &failingSink{failures: 1}
+	(&Service{}).sinkWriter(t.Context(), "failing-0", sink, batches)
+
+	assert.Equal(t, [][]models.Log{{{ID: 2}}, {{ID: 3}, {ID: 4}}}, sink.written)
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/jwt"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Authenticate checks access token and returns admin it was issued to.
+// Returned admin has only id, username and role set, password isn't checked on every request
+func (s *Service) Authenticate(ctx context.Context, accessToken string) (models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Authenticate")
+	defer span.Finish()
+
+	claims, err := jwt.Parse(accessToken, s.secret, time.Now())
+	if err != nil {
+		s.logger.Error(ErrInvalidToken.Error(),
+			zap.Error(err),
+		)
+		span.SetTag("error", ErrInvalidToken)
+
+		return models.Admin{}, ErrInvalidToken
+	}
+
+	revoked, err := s.tokens.IsAccessTokenRevoked(ctx, claims.ID)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Admin{}, err
+	}
+	if revoked {
+		s.logger.Error(ErrInvalidToken.Error(),
+			zap.String("username", claims.Subject),
+			zap.String("jti", claims.ID),
+			zap.Error(ErrInvalidToken),
+		)
+		span.SetTag("error", ErrInvalidToken)
+
+		return models.Admin{}, ErrInvalidToken
+	}
+
+	return models.Admin{
+		ID:       claims.AdminID,
+		Username: claims.Subject,
+		Role:     models.Role(claims.Role),
+	}, nil
+}
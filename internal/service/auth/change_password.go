@@ -0,0 +1,31 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// ChangePassword changes password of an admin identified by their current credentials, it's the only
+// way to log in again once password is expired. Failed attempts count towards lockout as failed logins
+func (s *Service) ChangePassword(ctx context.Context, username string, password string, newPassword string,
+	ip string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ChangePassword")
+	defer span.Finish()
+
+	admin, err := s.VerifyCredentials(ctx, username, password, ip)
+	if err != nil && !errors.Is(err, ErrPasswordExpired) {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	if err = s.passwords.ChangePassword(ctx, admin, newPassword); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	return nil
+}
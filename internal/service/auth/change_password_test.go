@@ -0,0 +1,79 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+)
+
+func TestService_ChangePassword(t *testing.T) {
+	t.Parallel()
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	admin := models.Admin{ID: 1, Username: "admin", Password: string(hash),
+		PasswordChangedAt: time.Now().Add(-password.DefaultMaxAge)}
+
+	tests := []struct {
+		name          string
+		password      string
+		mockSetup     func(*MockadminStorage, *MockloginAttemptStorage, *MockpasswordChanger)
+		expectedError error
+	}{
+		{
+			name:     "Expired password can be changed",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage,
+				passwords *MockpasswordChanger) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				passwords.EXPECT().ChangePassword(gomock.Any(), gomock.Any(), "new password").
+					DoAndReturn(func(_ context.Context, changed models.Admin, _ string) error {
+						assert.Equal(t, 1, changed.ID)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:     "Wrong password",
+			password: "wrong",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, _ *MockpasswordChanger) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).Return(1, nil).Times(2)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			attempts := NewMockloginAttemptStorage(ctrl)
+			passwords := NewMockpasswordChanger(ctrl)
+			tt.mockSetup(admins, attempts, passwords)
+
+			service := NewService(zap.NewNop(), admins, NewMocktokenStorage(ctrl), attempts,
+				NewMocklogStorage(ctrl), passwords, password.NewPolicy(0, 0, 0, nil),
+				NewMocktxManager(ctrl), "secret", time.Minute, time.Hour)
+
+			err := service.ChangePassword(t.Context(), "admin", tt.password, "new password", "10.0.0.1")
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
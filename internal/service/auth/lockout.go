@@ -0,0 +1,116 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/retry"
+)
+
+const (
+	// noOrderID is an order id of audit logs not related to any order
+	noOrderID = -1
+
+	lockoutLogURL = "login"
+	lockMethod    = "LOCK"
+	unlockMethod  = "UNLOCK"
+)
+
+// registerFailedLogin counts failed login of a username and an ip and locks them once they reach their thresholds,
+// adminID is 0 for unknown usernames, their lockouts are audited without an admin
+func (s *Service) registerFailedLogin(ctx context.Context, now time.Time, adminID int, username string,
+	ip string) error {
+	counters := []struct {
+		kind      string
+		key       string
+		threshold int
+	}{
+		{kind: models.UsernameLoginAttempt, key: username, threshold: usernameLockThreshold},
+		{kind: models.IPLoginAttempt, key: ip, threshold: ipLockThreshold},
+	}
+
+	for _, counter := range counters {
+		failedAttempts, err := s.attempts.RegisterFailedLogin(ctx, counter.kind, counter.key, now,
+			now.Add(-failedAttemptsTTL))
+		if err != nil {
+			return err
+		}
+		if failedAttempts < counter.threshold {
+			continue
+		}
+
+		lockedUntil := now.Add(retry.Backoff(lockoutBase, lockoutMax, failedAttempts-counter.threshold+1))
+		if err = s.attempts.LockLogin(ctx, counter.kind, counter.key, lockedUntil); err != nil {
+			return err
+		}
+
+		s.logger.Warn("login locked",
+			zap.String("kind", counter.kind),
+			zap.String("key", counter.key),
+			zap.Int("failed_attempts", failedAttempts),
+			zap.Time("locked_until", lockedUntil),
+		)
+
+		message := fmt.Sprintf("%s %q locked until %s after %d failed login attempts",
+			counter.kind, counter.key, lockedUntil.Format(time.RFC3339), failedAttempts)
+		// failed audit doesn't fail the login, logs of unknown usernames have no admin
+		if err = s.audit(ctx, adminID, message, lockMethod, http.StatusTooManyRequests); err != nil {
+			s.logger.Error("failed to audit login lock",
+				zap.String("kind", counter.kind),
+				zap.String("key", counter.key),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return nil
+}
+
+// Unlock lifts lock of an admin username and forgets its failed logins, locks of ips expire on their own,
+// the unlock is audited as done by actorID
+func (s *Service) Unlock(ctx context.Context, actorID int, username string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Unlock")
+	defer span.Finish()
+
+	ok, err := s.admins.ContainsUsername(ctx, username)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if !ok {
+		s.logger.Error(ErrAdminDoesntExist.Error(),
+			zap.String("username", username),
+		)
+		span.SetTag("error", ErrAdminDoesntExist)
+
+		return ErrAdminDoesntExist
+	}
+
+	if err = s.attempts.ResetLoginAttempts(ctx, models.UsernameLoginAttempt, username); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	s.logger.Info("login unlocked",
+		zap.String("username", username),
+		zap.Int("actor_id", actorID),
+	)
+
+	return s.audit(ctx, actorID, fmt.Sprintf("%s %q unlocked", models.UsernameLoginAttempt, username),
+		unlockMethod, http.StatusOK)
+}
+
+// audit writes an audit log entry of a lockout event
+func (s *Service) audit(ctx context.Context, adminID int, message string, method string, status int) error {
+	log := *models.NewLog(noOrderID, adminID, message, lockoutLogURL, method, status)
+
+	return s.logs.CreateLog(ctx, []models.Log{log})
+}
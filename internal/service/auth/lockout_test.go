@@ -0,0 +1,236 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+)
+
+func TestService_VerifyCredentials(t *testing.T) {
+	t.Parallel()
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	admin := models.Admin{ID: 1, Username: "admin", Password: string(hash),
+		PasswordChangedAt: time.Now(), Role: models.OperatorRole}
+	lockedUntil := time.Now().Add(time.Minute)
+	errLogStorage := errors.New("insert or update on table \"logs\" violates foreign key constraint")
+	expiredLock := time.Now().Add(-time.Minute)
+
+	tests := []struct {
+		name          string
+		password      string
+		mockSetup     func(*MockadminStorage, *MockloginAttemptStorage, *MocklogStorage)
+		expectedError error
+	}{
+		{
+			name:     "Locked username",
+			password: "password",
+			mockSetup: func(_ *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return([]models.LoginAttempt{
+					{Kind: models.UsernameLoginAttempt, Key: "admin", FailedAttempts: 5, LockedUntil: &lockedUntil},
+				}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			name:     "Locked ip",
+			password: "password",
+			mockSetup: func(_ *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return([]models.LoginAttempt{
+					{Kind: models.IPLoginAttempt, Key: "10.0.0.1", FailedAttempts: 20, LockedUntil: &lockedUntil},
+				}, nil)
+			},
+			expectedError: ErrLoginLocked,
+		},
+		{
+			name:     "Success after expired lock resets failures",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return([]models.LoginAttempt{
+					{Kind: models.UsernameLoginAttempt, Key: "admin", FailedAttempts: 5, LockedUntil: &expiredLock},
+				}, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				attempts.EXPECT().ResetLoginAttempts(gomock.Any(), models.UsernameLoginAttempt, "admin").Return(nil)
+			},
+		},
+		{
+			name:     "Expired password",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				expired := admin
+				expired.PasswordChangedAt = time.Now().Add(-password.DefaultMaxAge)
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(expired, nil)
+			},
+			expectedError: ErrPasswordExpired,
+		},
+		{
+			name:     "Deactivated admin",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				deactivated := admin
+				deactivated.DeactivatedAt = &expiredLock
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(deactivated, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any(), gomock.Any()).Return(1, nil).Times(2)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:     "Outdated hash is rehashed",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, _ *MocklogStorage) {
+				cheap, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.MinCost)
+				require.NoError(t, err)
+				outdated := admin
+				outdated.Password = string(cheap)
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(outdated, nil)
+				admins.EXPECT().UpdateAdmin(gomock.Any(), 1, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, updated models.Admin) error {
+						assert.False(t, updated.NeedsRehash())
+						assert.True(t, updated.CheckPassword("password"))
+						assert.Equal(t, admin.PasswordChangedAt, updated.PasswordChangedAt)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:     "Failure reaching threshold locks username",
+			password: "wrong",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, logs *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.UsernameLoginAttempt, "admin",
+					gomock.Any(), gomock.Any()).Return(usernameLockThreshold+2, nil)
+				attempts.EXPECT().LockLogin(gomock.Any(), models.UsernameLoginAttempt, "admin", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, _ string, until time.Time) error {
+						// third failure past threshold is locked for four times base duration
+						assert.WithinDuration(t, time.Now().Add(4*lockoutBase), until, time.Second)
+
+						return nil
+					})
+				logs.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, logs []models.Log) error {
+						require.Len(t, logs, 1)
+						assert.Equal(t, 1, logs[0].AdminID)
+						assert.Equal(t, lockMethod, logs[0].Method)
+
+						return nil
+					})
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.IPLoginAttempt, "10.0.0.1",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:     "Unknown username reaching threshold is locked",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, logs *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(false, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.UsernameLoginAttempt, "admin",
+					gomock.Any(), gomock.Any()).Return(usernameLockThreshold, nil)
+				attempts.EXPECT().LockLogin(gomock.Any(), models.UsernameLoginAttempt, "admin", gomock.Any()).
+					Return(nil)
+				logs.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, logs []models.Log) error {
+						require.Len(t, logs, 1)
+						assert.Equal(t, 0, logs[0].AdminID)
+						assert.Equal(t, lockMethod, logs[0].Method)
+
+						return nil
+					})
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.IPLoginAttempt, "10.0.0.1",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:     "Failed lock audit doesn't fail login",
+			password: "wrong",
+			mockSetup: func(admins *MockadminStorage, attempts *MockloginAttemptStorage, logs *MocklogStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "10.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.UsernameLoginAttempt, "admin",
+					gomock.Any(), gomock.Any()).Return(usernameLockThreshold, nil)
+				attempts.EXPECT().LockLogin(gomock.Any(), models.UsernameLoginAttempt, "admin", gomock.Any()).
+					Return(nil)
+				logs.EXPECT().CreateLog(gomock.Any(), gomock.Any()).Return(errLogStorage)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.IPLoginAttempt, "10.0.0.1",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			attempts := NewMockloginAttemptStorage(ctrl)
+			logs := NewMocklogStorage(ctrl)
+			tt.mockSetup(admins, attempts, logs)
+
+			service := NewService(zap.NewNop(), admins, NewMocktokenStorage(ctrl), attempts, logs,
+				NewMockpasswordChanger(ctrl), password.NewPolicy(0, 0, 0, nil),
+				NewMocktxManager(ctrl), "secret", time.Minute, time.Hour)
+
+			verified, err := service.VerifyCredentials(t.Context(), "admin", tt.password, "10.0.0.1")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, admin.ID, verified.ID)
+			assert.Equal(t, admin.Username, verified.Username)
+		})
+	}
+}
+
+func TestService_Unlock(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	admins := NewMockadminStorage(ctrl)
+	attempts := NewMockloginAttemptStorage(ctrl)
+	logs := NewMocklogStorage(ctrl)
+	service := NewService(zap.NewNop(), admins, NewMocktokenStorage(ctrl), attempts, logs,
+		NewMockpasswordChanger(ctrl), password.NewPolicy(0, 0, 0, nil),
+		NewMocktxManager(ctrl), "secret", time.Minute, time.Hour)
+
+	admins.EXPECT().ContainsUsername(gomock.Any(), "nobody").Return(false, nil)
+	assert.ErrorIs(t, service.Unlock(t.Context(), 2, "nobody"), ErrAdminDoesntExist)
+
+	admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+	attempts.EXPECT().ResetLoginAttempts(gomock.Any(), models.UsernameLoginAttempt, "admin").Return(nil)
+	logs.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, logs []models.Log) error {
+			require.Len(t, logs, 1)
+			assert.Equal(t, 2, logs[0].AdminID)
+			assert.Equal(t, unlockMethod, logs[0].Method)
+
+			return nil
+		})
+	require.NoError(t, service.Unlock(t.Context(), 2, "admin"))
+}
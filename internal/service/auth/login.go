@@ -0,0 +1,37 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Login checks admin credentials passed from ip and issues a pair of tokens
+func (s *Service) Login(ctx context.Context, username string, password string,
+	ip string) (models.TokenPair, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Login")
+	defer span.Finish()
+
+	admin, err := s.VerifyCredentials(ctx, username, password, ip)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.TokenPair{}, err
+	}
+
+	tokens, err := s.issueTokens(ctx, nil, admin)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.TokenPair{}, err
+	}
+
+	s.logger.Info("admin logged in",
+		zap.String("username", username),
+	)
+
+	return tokens, nil
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+)
+
+func TestService_Login(t *testing.T) {
+	t.Parallel()
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	admin := models.Admin{ID: 1, Username: "admin", Password: string(hash),
+		PasswordChangedAt: time.Now(), Role: models.SupervisorRole}
+
+	tests := []struct {
+		name          string
+		username      string
+		password      string
+		mockSetup     func(*MockadminStorage, *MocktokenStorage, *MockloginAttemptStorage)
+		expectedError error
+	}{
+		{
+			name:     "Valid credentials",
+			username: "admin",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "127.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Nil(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ pgx.Tx, token models.RefreshToken) error {
+						assert.Equal(t, 1, token.AdminID)
+						assert.Len(t, token.TokenHash, 64)
+
+						return nil
+					})
+			},
+		},
+		{
+			name:     "Unknown username",
+			username: "nobody",
+			password: "password",
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "nobody", "127.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "nobody").Return(false, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.UsernameLoginAttempt, "nobody",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.IPLoginAttempt, "127.0.0.1",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+		{
+			name:     "Wrong password",
+			username: "admin",
+			password: "wrong",
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "admin", "127.0.0.1").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "admin").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").Return(admin, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.UsernameLoginAttempt, "admin",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), models.IPLoginAttempt, "127.0.0.1",
+					gomock.Any(), gomock.Any()).Return(1, nil)
+			},
+			expectedError: ErrInvalidCredentials,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			tokens := NewMocktokenStorage(ctrl)
+			attempts := NewMockloginAttemptStorage(ctrl)
+			tt.mockSetup(admins, tokens, attempts)
+
+			service := NewService(zap.NewNop(), admins, tokens, attempts, NewMocklogStorage(ctrl),
+				NewMockpasswordChanger(ctrl), password.NewPolicy(0, 0, 0, nil),
+				NewMocktxManager(ctrl), "secret", time.Minute, time.Hour)
+
+			pair, err := service.Login(t.Context(), tt.username, tt.password, "127.0.0.1")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+			require.NoError(t, err)
+
+			tokens.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+			authenticated, err := service.Authenticate(t.Context(), pair.AccessToken)
+			require.NoError(t, err)
+			assert.Equal(t, models.Admin{ID: 1, Username: "admin", Role: models.SupervisorRole}, authenticated)
+		})
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	tokens := NewMocktokenStorage(ctrl)
+	service := NewService(zap.NewNop(), NewMockadminStorage(ctrl), tokens, NewMockloginAttemptStorage(ctrl),
+		NewMocklogStorage(ctrl), NewMockpasswordChanger(ctrl), password.NewPolicy(0, 0, 0, nil),
+		NewMocktxManager(ctrl), "secret", time.Minute, time.Hour)
+	tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Nil(), gomock.Any()).Return(nil)
+
+	pair, err := service.issueTokens(t.Context(), nil, models.Admin{ID: 1, Username: "admin"})
+	require.NoError(t, err)
+
+	tokens.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(true, nil)
+	_, err = service.Authenticate(t.Context(), pair.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+
+	other := NewService(zap.NewNop(), nil, tokens, nil, nil, nil, nil, nil, "other secret", time.Minute, time.Hour)
+	_, err = other.Authenticate(t.Context(), pair.AccessToken)
+	assert.ErrorIs(t, err, ErrInvalidToken)
+}
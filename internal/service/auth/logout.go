@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/jwt"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Logout revokes access token and, if it's passed, refresh token of the same admin
+func (s *Service) Logout(ctx context.Context, accessToken string, refreshToken string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Logout")
+	defer span.Finish()
+
+	claims, err := jwt.Parse(accessToken, s.secret, time.Now())
+	if err != nil {
+		s.logger.Error(ErrInvalidToken.Error(),
+			zap.Error(err),
+		)
+		span.SetTag("error", ErrInvalidToken)
+
+		return ErrInvalidToken
+	}
+
+	if err = s.tokens.RevokeAccessToken(ctx, claims.ID, claims.Expiry()); err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	tokenHash := models.HashToken(refreshToken)
+
+	return s.txManager.RunReadCommitted(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if ok, err := s.tokens.ContainsRefreshToken(ctx, tx, tokenHash); err != nil || !ok {
+			span.SetTag("error", ErrInvalidRefreshToken)
+
+			return ErrInvalidRefreshToken
+		}
+
+		stored, err := s.tokens.GetRefreshToken(ctx, tx, tokenHash)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		if stored.AdminID != claims.AdminID {
+			s.logger.Error(ErrInvalidRefreshToken.Error(),
+				zap.String("username", claims.Subject),
+				zap.Error(ErrInvalidRefreshToken),
+			)
+			span.SetTag("error", ErrInvalidRefreshToken)
+
+			return ErrInvalidRefreshToken
+		}
+
+		return s.tokens.RevokeRefreshToken(ctx, tx, stored.ID, time.Now())
+	})
+}
@@ -0,0 +1,893 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_auth_test.go -package=auth
+//
+
+// Package auth is a generated GoMock package.
+package auth
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockadminStorage is a mock of adminStorage interface.
+type MockadminStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockadminStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockadminStorageMockRecorder is the mock recorder for MockadminStorage.
+type MockadminStorageMockRecorder struct {
+	mock *MockadminStorage
+}
+
+// NewMockadminStorage creates a new mock instance.
+func NewMockadminStorage(ctrl *gomock.Controller) *MockadminStorage {
+	mock := &MockadminStorage{ctrl: ctrl}
+	mock.recorder = &MockadminStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockadminStorage) EXPECT() *MockadminStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsUsername mocks base method.
+func (m *MockadminStorage) ContainsUsername(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsUsername", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsUsername indicates an expected call of ContainsUsername.
+func (mr *MockadminStorageMockRecorder) ContainsUsername(arg0, arg1 any) *MockadminStorageContainsUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsUsername", reflect.TypeOf((*MockadminStorage)(nil).ContainsUsername), arg0, arg1)
+	return &MockadminStorageContainsUsernameCall{Call: call}
+}
+
+// MockadminStorageContainsUsernameCall wrap *gomock.Call
+type MockadminStorageContainsUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageContainsUsernameCall) Return(arg0 bool, arg1 error) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageContainsUsernameCall) Do(f func(context.Context, string) (bool, error)) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageContainsUsernameCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAdminByUsername mocks base method.
+func (m *MockadminStorage) GetAdminByUsername(arg0 context.Context, arg1 string) (models.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminByUsername", arg0, arg1)
+	ret0, _ := ret[0].(models.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminByUsername indicates an expected call of GetAdminByUsername.
+func (mr *MockadminStorageMockRecorder) GetAdminByUsername(arg0, arg1 any) *MockadminStorageGetAdminByUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByUsername", reflect.TypeOf((*MockadminStorage)(nil).GetAdminByUsername), arg0, arg1)
+	return &MockadminStorageGetAdminByUsernameCall{Call: call}
+}
+
+// MockadminStorageGetAdminByUsernameCall wrap *gomock.Call
+type MockadminStorageGetAdminByUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageGetAdminByUsernameCall) Return(arg0 models.Admin, arg1 error) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageGetAdminByUsernameCall) Do(f func(context.Context, string) (models.Admin, error)) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageGetAdminByUsernameCall) DoAndReturn(f func(context.Context, string) (models.Admin, error)) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAdmin mocks base method.
+func (m *MockadminStorage) UpdateAdmin(arg0 context.Context, arg1 int, arg2 models.Admin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdmin", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAdmin indicates an expected call of UpdateAdmin.
+func (mr *MockadminStorageMockRecorder) UpdateAdmin(arg0, arg1, arg2 any) *MockadminStorageUpdateAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdmin", reflect.TypeOf((*MockadminStorage)(nil).UpdateAdmin), arg0, arg1, arg2)
+	return &MockadminStorageUpdateAdminCall{Call: call}
+}
+
+// MockadminStorageUpdateAdminCall wrap *gomock.Call
+type MockadminStorageUpdateAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageUpdateAdminCall) Return(arg0 error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageUpdateAdminCall) Do(f func(context.Context, int, models.Admin) error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageUpdateAdminCall) DoAndReturn(f func(context.Context, int, models.Admin) error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpasswordChanger is a mock of passwordChanger interface.
+type MockpasswordChanger struct {
+	ctrl     *gomock.Controller
+	recorder *MockpasswordChangerMockRecorder
+	isgomock struct{}
+}
+
+// MockpasswordChangerMockRecorder is the mock recorder for MockpasswordChanger.
+type MockpasswordChangerMockRecorder struct {
+	mock *MockpasswordChanger
+}
+
+// NewMockpasswordChanger creates a new mock instance.
+func NewMockpasswordChanger(ctrl *gomock.Controller) *MockpasswordChanger {
+	mock := &MockpasswordChanger{ctrl: ctrl}
+	mock.recorder = &MockpasswordChangerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpasswordChanger) EXPECT() *MockpasswordChangerMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockpasswordChanger) ChangePassword(arg0 context.Context, arg1 models.Admin, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockpasswordChangerMockRecorder) ChangePassword(arg0, arg1, arg2 any) *MockpasswordChangerChangePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockpasswordChanger)(nil).ChangePassword), arg0, arg1, arg2)
+	return &MockpasswordChangerChangePasswordCall{Call: call}
+}
+
+// MockpasswordChangerChangePasswordCall wrap *gomock.Call
+type MockpasswordChangerChangePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpasswordChangerChangePasswordCall) Return(arg0 error) *MockpasswordChangerChangePasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpasswordChangerChangePasswordCall) Do(f func(context.Context, models.Admin, string) error) *MockpasswordChangerChangePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpasswordChangerChangePasswordCall) DoAndReturn(f func(context.Context, models.Admin, string) error) *MockpasswordChangerChangePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenStorage is a mock of tokenStorage interface.
+type MocktokenStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenStorageMockRecorder is the mock recorder for MocktokenStorage.
+type MocktokenStorageMockRecorder struct {
+	mock *MocktokenStorage
+}
+
+// NewMocktokenStorage creates a new mock instance.
+func NewMocktokenStorage(ctrl *gomock.Controller) *MocktokenStorage {
+	mock := &MocktokenStorage{ctrl: ctrl}
+	mock.recorder = &MocktokenStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenStorage) EXPECT() *MocktokenStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsRefreshToken mocks base method.
+func (m *MocktokenStorage) ContainsRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsRefreshToken indicates an expected call of ContainsRefreshToken.
+func (mr *MocktokenStorageMockRecorder) ContainsRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageContainsRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).ContainsRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageContainsRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageContainsRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageContainsRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageContainsRefreshTokenCall) Return(arg0 bool, arg1 error) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageContainsRefreshTokenCall) Do(f func(context.Context, pgx.Tx, string) (bool, error)) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageContainsRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, string) (bool, error)) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MocktokenStorage) CreateRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 models.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MocktokenStorageMockRecorder) CreateRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageCreateRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).CreateRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageCreateRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageCreateRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageCreateRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageCreateRefreshTokenCall) Return(arg0 error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageCreateRefreshTokenCall) Do(f func(context.Context, pgx.Tx, models.RefreshToken) error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageCreateRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, models.RefreshToken) error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRefreshToken mocks base method.
+func (m *MocktokenStorage) GetRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 string) (models.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MocktokenStorageMockRecorder) GetRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageGetRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).GetRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageGetRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageGetRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageGetRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageGetRefreshTokenCall) Return(arg0 models.RefreshToken, arg1 error) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageGetRefreshTokenCall) Do(f func(context.Context, pgx.Tx, string) (models.RefreshToken, error)) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageGetRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, string) (models.RefreshToken, error)) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsAccessTokenRevoked mocks base method.
+func (m *MocktokenStorage) IsAccessTokenRevoked(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccessTokenRevoked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAccessTokenRevoked indicates an expected call of IsAccessTokenRevoked.
+func (mr *MocktokenStorageMockRecorder) IsAccessTokenRevoked(arg0, arg1 any) *MocktokenStorageIsAccessTokenRevokedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccessTokenRevoked", reflect.TypeOf((*MocktokenStorage)(nil).IsAccessTokenRevoked), arg0, arg1)
+	return &MocktokenStorageIsAccessTokenRevokedCall{Call: call}
+}
+
+// MocktokenStorageIsAccessTokenRevokedCall wrap *gomock.Call
+type MocktokenStorageIsAccessTokenRevokedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageIsAccessTokenRevokedCall) Return(arg0 bool, arg1 error) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageIsAccessTokenRevokedCall) Do(f func(context.Context, string) (bool, error)) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageIsAccessTokenRevokedCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MocktokenStorage) RevokeAccessToken(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MocktokenStorageMockRecorder) RevokeAccessToken(arg0, arg1, arg2 any) *MocktokenStorageRevokeAccessTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MocktokenStorage)(nil).RevokeAccessToken), arg0, arg1, arg2)
+	return &MocktokenStorageRevokeAccessTokenCall{Call: call}
+}
+
+// MocktokenStorageRevokeAccessTokenCall wrap *gomock.Call
+type MocktokenStorageRevokeAccessTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeAccessTokenCall) Return(arg0 error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeAccessTokenCall) Do(f func(context.Context, string, time.Time) error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeAccessTokenCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAdminRefreshTokens mocks base method.
+func (m *MocktokenStorage) RevokeAdminRefreshTokens(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAdminRefreshTokens", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAdminRefreshTokens indicates an expected call of RevokeAdminRefreshTokens.
+func (mr *MocktokenStorageMockRecorder) RevokeAdminRefreshTokens(arg0, arg1, arg2, arg3 any) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAdminRefreshTokens", reflect.TypeOf((*MocktokenStorage)(nil).RevokeAdminRefreshTokens), arg0, arg1, arg2, arg3)
+	return &MocktokenStorageRevokeAdminRefreshTokensCall{Call: call}
+}
+
+// MocktokenStorageRevokeAdminRefreshTokensCall wrap *gomock.Call
+type MocktokenStorageRevokeAdminRefreshTokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) Return(arg0 error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) Do(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) DoAndReturn(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *MocktokenStorage) RevokeRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MocktokenStorageMockRecorder) RevokeRefreshToken(arg0, arg1, arg2, arg3 any) *MocktokenStorageRevokeRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).RevokeRefreshToken), arg0, arg1, arg2, arg3)
+	return &MocktokenStorageRevokeRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageRevokeRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageRevokeRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeRefreshTokenCall) Return(arg0 error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeRefreshTokenCall) Do(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockloginAttemptStorage is a mock of loginAttemptStorage interface.
+type MockloginAttemptStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockloginAttemptStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockloginAttemptStorageMockRecorder is the mock recorder for MockloginAttemptStorage.
+type MockloginAttemptStorageMockRecorder struct {
+	mock *MockloginAttemptStorage
+}
+
+// NewMockloginAttemptStorage creates a new mock instance.
+func NewMockloginAttemptStorage(ctrl *gomock.Controller) *MockloginAttemptStorage {
+	mock := &MockloginAttemptStorage{ctrl: ctrl}
+	mock.recorder = &MockloginAttemptStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloginAttemptStorage) EXPECT() *MockloginAttemptStorageMockRecorder {
+	return m.recorder
+}
+
+// GetLoginAttempts mocks base method.
+func (m *MockloginAttemptStorage) GetLoginAttempts(arg0 context.Context, arg1, arg2 string) ([]models.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginAttempts indicates an expected call of GetLoginAttempts.
+func (mr *MockloginAttemptStorageMockRecorder) GetLoginAttempts(arg0, arg1, arg2 any) *MockloginAttemptStorageGetLoginAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginAttempts", reflect.TypeOf((*MockloginAttemptStorage)(nil).GetLoginAttempts), arg0, arg1, arg2)
+	return &MockloginAttemptStorageGetLoginAttemptsCall{Call: call}
+}
+
+// MockloginAttemptStorageGetLoginAttemptsCall wrap *gomock.Call
+type MockloginAttemptStorageGetLoginAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) Return(arg0 []models.LoginAttempt, arg1 error) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) Do(f func(context.Context, string, string) ([]models.LoginAttempt, error)) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) DoAndReturn(f func(context.Context, string, string) ([]models.LoginAttempt, error)) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockLogin mocks base method.
+func (m *MockloginAttemptStorage) LockLogin(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockloginAttemptStorageMockRecorder) LockLogin(arg0, arg1, arg2, arg3 any) *MockloginAttemptStorageLockLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockloginAttemptStorage)(nil).LockLogin), arg0, arg1, arg2, arg3)
+	return &MockloginAttemptStorageLockLoginCall{Call: call}
+}
+
+// MockloginAttemptStorageLockLoginCall wrap *gomock.Call
+type MockloginAttemptStorageLockLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageLockLoginCall) Return(arg0 error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageLockLoginCall) Do(f func(context.Context, string, string, time.Time) error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageLockLoginCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegisterFailedLogin mocks base method.
+func (m *MockloginAttemptStorage) RegisterFailedLogin(arg0 context.Context, arg1, arg2 string, arg3, arg4 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailedLogin", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailedLogin indicates an expected call of RegisterFailedLogin.
+func (mr *MockloginAttemptStorageMockRecorder) RegisterFailedLogin(arg0, arg1, arg2, arg3, arg4 any) *MockloginAttemptStorageRegisterFailedLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailedLogin", reflect.TypeOf((*MockloginAttemptStorage)(nil).RegisterFailedLogin), arg0, arg1, arg2, arg3, arg4)
+	return &MockloginAttemptStorageRegisterFailedLoginCall{Call: call}
+}
+
+// MockloginAttemptStorageRegisterFailedLoginCall wrap *gomock.Call
+type MockloginAttemptStorageRegisterFailedLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) Return(arg0 int, arg1 error) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) Do(f func(context.Context, string, string, time.Time, time.Time) (int, error)) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) DoAndReturn(f func(context.Context, string, string, time.Time, time.Time) (int, error)) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResetLoginAttempts mocks base method.
+func (m *MockloginAttemptStorage) ResetLoginAttempts(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginAttempts indicates an expected call of ResetLoginAttempts.
+func (mr *MockloginAttemptStorageMockRecorder) ResetLoginAttempts(arg0, arg1, arg2 any) *MockloginAttemptStorageResetLoginAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginAttempts", reflect.TypeOf((*MockloginAttemptStorage)(nil).ResetLoginAttempts), arg0, arg1, arg2)
+	return &MockloginAttemptStorageResetLoginAttemptsCall{Call: call}
+}
+
+// MockloginAttemptStorageResetLoginAttemptsCall wrap *gomock.Call
+type MockloginAttemptStorageResetLoginAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) Return(arg0 error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) Do(f func(context.Context, string, string) error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) DoAndReturn(f func(context.Context, string, string) error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocklogStorage is a mock of logStorage interface.
+type MocklogStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocklogStorageMockRecorder is the mock recorder for MocklogStorage.
+type MocklogStorageMockRecorder struct {
+	mock *MocklogStorage
+}
+
+// NewMocklogStorage creates a new mock instance.
+func NewMocklogStorage(ctrl *gomock.Controller) *MocklogStorage {
+	mock := &MocklogStorage{ctrl: ctrl}
+	mock.recorder = &MocklogStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogStorage) EXPECT() *MocklogStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateLog mocks base method.
+func (m *MocklogStorage) CreateLog(arg0 context.Context, arg1 []models.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLog indicates an expected call of CreateLog.
+func (mr *MocklogStorageMockRecorder) CreateLog(arg0, arg1 any) *MocklogStorageCreateLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLog", reflect.TypeOf((*MocklogStorage)(nil).CreateLog), arg0, arg1)
+	return &MocklogStorageCreateLogCall{Call: call}
+}
+
+// MocklogStorageCreateLogCall wrap *gomock.Call
+type MocklogStorageCreateLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageCreateLogCall) Return(arg0 error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageCreateLogCall) Do(f func(context.Context, []models.Log) error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageCreateLogCall) DoAndReturn(f func(context.Context, []models.Log) error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunReadCommitted mocks base method.
+func (m *MocktxManager) RunReadCommitted(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunReadCommitted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunReadCommitted indicates an expected call of RunReadCommitted.
+func (mr *MocktxManagerMockRecorder) RunReadCommitted(arg0, arg1 any) *MocktxManagerRunReadCommittedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReadCommitted", reflect.TypeOf((*MocktxManager)(nil).RunReadCommitted), arg0, arg1)
+	return &MocktxManagerRunReadCommittedCall{Call: call}
+}
+
+// MocktxManagerRunReadCommittedCall wrap *gomock.Call
+type MocktxManagerRunReadCommittedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunReadCommittedCall) Return(arg0 error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunReadCommittedCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunReadCommittedCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunRepeatableRead mocks base method.
+func (m *MocktxManager) RunRepeatableRead(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRepeatableRead", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunRepeatableRead indicates an expected call of RunRepeatableRead.
+func (mr *MocktxManagerMockRecorder) RunRepeatableRead(arg0, arg1 any) *MocktxManagerRunRepeatableReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRepeatableRead", reflect.TypeOf((*MocktxManager)(nil).RunRepeatableRead), arg0, arg1)
+	return &MocktxManagerRunRepeatableReadCall{Call: call}
+}
+
+// MocktxManagerRunRepeatableReadCall wrap *gomock.Call
+type MocktxManagerRunRepeatableReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunRepeatableReadCall) Return(arg0 error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunRepeatableReadCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunRepeatableReadCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunSerializable mocks base method.
+func (m *MocktxManager) RunSerializable(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSerializable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunSerializable indicates an expected call of RunSerializable.
+func (mr *MocktxManagerMockRecorder) RunSerializable(arg0, arg1 any) *MocktxManagerRunSerializableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSerializable", reflect.TypeOf((*MocktxManager)(nil).RunSerializable), arg0, arg1)
+	return &MocktxManagerRunSerializableCall{Call: call}
+}
+
+// MocktxManagerRunSerializableCall wrap *gomock.Call
+type MocktxManagerRunSerializableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunSerializableCall) Return(arg0 error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunSerializableCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunSerializableCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
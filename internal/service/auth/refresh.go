@@ -0,0 +1,112 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Refresh exchanges refresh token for a new pair of tokens, the used refresh token is revoked.
+// If an already used token is presented again, it is considered stolen and all admin sessions are revoked
+func (s *Service) Refresh(ctx context.Context, refreshToken string) (models.TokenPair, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Refresh")
+	defer span.Finish()
+
+	tokenHash := models.HashToken(refreshToken)
+	reused := false
+
+	var tokens models.TokenPair
+	err := s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if ok, err := s.tokens.ContainsRefreshToken(ctx, tx, tokenHash); err != nil || !ok {
+			s.logger.Error(ErrInvalidRefreshToken.Error(),
+				zap.Error(ErrInvalidRefreshToken),
+			)
+			span.SetTag("error", ErrInvalidRefreshToken)
+
+			return ErrInvalidRefreshToken
+		}
+
+		stored, err := s.tokens.GetRefreshToken(ctx, tx, tokenHash)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		now := time.Now()
+		if stored.IsRevoked() {
+			s.logger.Warn("refresh token reused, revoking all sessions",
+				zap.String("username", stored.Username),
+			)
+			reused = true
+
+			// revocation has to be committed, so the error is returned after the transaction
+			return s.tokens.RevokeAdminRefreshTokens(ctx, tx, stored.AdminID, now)
+		}
+
+		if !now.Before(stored.ExpiresAt) {
+			s.logger.Error(ErrInvalidRefreshToken.Error(),
+				zap.String("username", stored.Username),
+				zap.Time("expires_at", stored.ExpiresAt),
+				zap.Error(ErrInvalidRefreshToken),
+			)
+			span.SetTag("error", ErrInvalidRefreshToken)
+
+			return ErrInvalidRefreshToken
+		}
+
+		// admin is fetched again, so changed role gets into the new access token
+		admin, err := s.admins.GetAdminByUsername(ctx, stored.Username)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		if !admin.IsActive() {
+			s.logger.Error(ErrInvalidRefreshToken.Error(),
+				zap.String("username", stored.Username),
+				zap.String("reason", "admin is deactivated"),
+				zap.Error(ErrInvalidRefreshToken),
+			)
+			span.SetTag("error", ErrInvalidRefreshToken)
+
+			return ErrInvalidRefreshToken
+		}
+
+		if s.policy.IsExpired(admin.PasswordChangedAt, now) {
+			s.logger.Error(ErrPasswordExpired.Error(),
+				zap.String("username", stored.Username),
+				zap.Error(ErrPasswordExpired),
+			)
+			span.SetTag("error", ErrPasswordExpired)
+
+			return ErrPasswordExpired
+		}
+
+		if err = s.tokens.RevokeRefreshToken(ctx, tx, stored.ID, now); err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		tokens, err = s.issueTokens(ctx, tx, admin)
+
+		return err
+	})
+	if err != nil {
+		return models.TokenPair{}, err
+	}
+	if reused {
+		span.SetTag("error", ErrInvalidRefreshToken)
+
+		return models.TokenPair{}, ErrInvalidRefreshToken
+	}
+
+	return tokens, nil
+}
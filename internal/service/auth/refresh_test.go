@@ -0,0 +1,129 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+)
+
+func TestService_Refresh(t *testing.T) {
+	t.Parallel()
+	revokedAt := time.Now().Add(-time.Minute)
+	tokenHash := models.HashToken("refresh")
+
+	tests := []struct {
+		name          string
+		mockSetup     func(*MockadminStorage, *MocktokenStorage)
+		expectedError error
+	}{
+		{
+			name: "Valid token is rotated",
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(true, nil)
+				tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(models.RefreshToken{
+					ID: 7, AdminID: 1, Username: "admin", TokenHash: tokenHash,
+					ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").
+					Return(models.Admin{ID: 1, Username: "admin", Role: models.OperatorRole,
+						PasswordChangedAt: time.Now()}, nil)
+				tokens.EXPECT().RevokeRefreshToken(gomock.Any(), gomock.Any(), 7, gomock.Any()).Return(nil)
+				tokens.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ pgx.Tx, token models.RefreshToken) error {
+						assert.NotEqual(t, tokenHash, token.TokenHash)
+
+						return nil
+					})
+			},
+		},
+		{
+			name: "Unknown token",
+			mockSetup: func(_ *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(false, nil)
+			},
+			expectedError: ErrInvalidRefreshToken,
+		},
+		{
+			name: "Expired token",
+			mockSetup: func(_ *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(true, nil)
+				tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(models.RefreshToken{
+					ID: 7, AdminID: 1, Username: "admin", ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+			},
+			expectedError: ErrInvalidRefreshToken,
+		},
+		{
+			name: "Deactivated admin",
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(true, nil)
+				tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(models.RefreshToken{
+					ID: 7, AdminID: 1, Username: "admin", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").
+					Return(models.Admin{ID: 1, Username: "admin", Role: models.OperatorRole,
+						PasswordChangedAt: time.Now(), DeactivatedAt: &revokedAt}, nil)
+			},
+			expectedError: ErrInvalidRefreshToken,
+		},
+		{
+			name: "Expired password",
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(true, nil)
+				tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(models.RefreshToken{
+					ID: 7, AdminID: 1, Username: "admin", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "admin").
+					Return(models.Admin{ID: 1, Username: "admin", Role: models.OperatorRole,
+						PasswordChangedAt: time.Now().Add(-password.DefaultMaxAge)}, nil)
+			},
+			expectedError: ErrPasswordExpired,
+		},
+		{
+			name: "Reused token revokes all sessions",
+			mockSetup: func(_ *MockadminStorage, tokens *MocktokenStorage) {
+				tokens.EXPECT().ContainsRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(true, nil)
+				tokens.EXPECT().GetRefreshToken(gomock.Any(), gomock.Any(), tokenHash).Return(models.RefreshToken{
+					ID: 7, AdminID: 1, Username: "admin", ExpiresAt: time.Now().Add(time.Hour),
+					RevokedAt: &revokedAt}, nil)
+				tokens.EXPECT().RevokeAdminRefreshTokens(gomock.Any(), gomock.Any(), 1, gomock.Any()).Return(nil)
+			},
+			expectedError: ErrInvalidRefreshToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			tokens := NewMocktokenStorage(ctrl)
+			txManager := NewMocktxManager(ctrl)
+			txManager.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, f func(context.Context, pgx.Tx) error) error {
+					return f(ctx, nil)
+				})
+			tt.mockSetup(admins, tokens)
+
+			service := NewService(zap.NewNop(), admins, tokens, NewMockloginAttemptStorage(ctrl),
+				NewMocklogStorage(ctrl), NewMockpasswordChanger(ctrl), password.NewPolicy(0, 0, 0, nil),
+				txManager, "secret", time.Minute, time.Hour)
+
+			pair, err := service.Refresh(t.Context(), "refresh")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+
+				return
+			}
+			require.NoError(t, err)
+			assert.NotEmpty(t, pair.AccessToken)
+			assert.NotEqual(t, "refresh", pair.RefreshToken)
+		})
+	}
+}
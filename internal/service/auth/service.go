@@ -0,0 +1,166 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_auth_test.go -package=auth
+
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/jwt"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+)
+
+const (
+	jtiBytes = 16
+
+	// usernameLockThreshold is a number of failed logins in a row after which username gets locked
+	usernameLockThreshold = 5
+
+	// ipLockThreshold is higher than usernameLockThreshold, several admins may share an ip
+	ipLockThreshold = 20
+
+	// lockoutBase is a duration of the first lock, every next failure doubles it up to lockoutMax
+	lockoutBase = time.Minute
+	lockoutMax  = 24 * time.Hour
+
+	// failedAttemptsTTL is a time after which counting of failed logins starts over
+	failedAttemptsTTL = 24 * time.Hour
+)
+
+var (
+	// ErrInvalidCredentials happens when username or password is wrong
+	ErrInvalidCredentials = errors.New("invalid username or password")
+
+	// ErrInvalidToken happens when access token is malformed, forged, expired or revoked
+	ErrInvalidToken = errors.New("invalid access token")
+
+	// ErrInvalidRefreshToken happens when refresh token wasn't issued, is expired or was already used
+	ErrInvalidRefreshToken = errors.New("invalid refresh token")
+
+	// ErrLoginLocked happens when username or ip is locked after too many failed logins
+	ErrLoginLocked = errors.New("too many failed login attempts, try again later")
+
+	// ErrPasswordExpired happens when credentials are right, but password has to be changed first
+	ErrPasswordExpired = errors.New("password expired, change it to log in")
+
+	// ErrAdminDoesntExist happens when admin doesn't exist
+	ErrAdminDoesntExist = errors.New("admin with such username doesn't exist")
+)
+
+type adminStorage interface {
+	GetAdminByUsername(context.Context, string) (models.Admin, error)
+	UpdateAdmin(context.Context, int, models.Admin) error
+	ContainsUsername(context.Context, string) (bool, error)
+}
+
+type passwordChanger interface {
+	ChangePassword(context.Context, models.Admin, string) error
+}
+
+type tokenStorage interface {
+	CreateRefreshToken(context.Context, pgx.Tx, models.RefreshToken) error
+	GetRefreshToken(context.Context, pgx.Tx, string) (models.RefreshToken, error)
+	ContainsRefreshToken(context.Context, pgx.Tx, string) (bool, error)
+	RevokeRefreshToken(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAdminRefreshTokens(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAccessToken(context.Context, string, time.Time) error
+	IsAccessTokenRevoked(context.Context, string) (bool, error)
+}
+
+type loginAttemptStorage interface {
+	GetLoginAttempts(context.Context, string, string) ([]models.LoginAttempt, error)
+	RegisterFailedLogin(context.Context, string, string, time.Time, time.Time) (int, error)
+	LockLogin(context.Context, string, string, time.Time) error
+	ResetLoginAttempts(context.Context, string, string) error
+}
+
+type logStorage interface {
+	CreateLog(context.Context, []models.Log) error
+}
+
+type txManager interface {
+	RunSerializable(context.Context, func(context.Context, pgx.Tx) error) error
+	RunRepeatableRead(context.Context, func(context.Context, pgx.Tx) error) error
+	RunReadCommitted(context.Context, func(context.Context, pgx.Tx) error) error
+}
+
+// Service is a structure for auth service, it issues, rotates and revokes tokens
+type Service struct {
+	admins     adminStorage
+	tokens     tokenStorage
+	attempts   loginAttemptStorage
+	logs       logStorage
+	passwords  passwordChanger
+	policy     *password.Policy
+	txManager  txManager
+	secret     []byte
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+	logger     *zap.Logger
+}
+
+// NewService creates instance of an auth Service, access tokens are signed with secret,
+// lockouts are written straight to audit logs storage, expired passwords are changed with passwords
+func NewService(logger *zap.Logger, admins adminStorage, tokens tokenStorage, attempts loginAttemptStorage,
+	logs logStorage, passwords passwordChanger, policy *password.Policy, txManager txManager, secret string,
+	accessTTL time.Duration, refreshTTL time.Duration) *Service {
+	return &Service{
+		admins:     admins,
+		tokens:     tokens,
+		attempts:   attempts,
+		logs:       logs,
+		passwords:  passwords,
+		policy:     policy,
+		txManager:  txManager,
+		secret:     []byte(secret),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+		logger:     logger,
+	}
+}
+
+// issueTokens issues access token and saves a new refresh token of an admin
+func (s *Service) issueTokens(ctx context.Context, tx pgx.Tx, admin models.Admin) (models.TokenPair, error) {
+	jti := make([]byte, jtiBytes)
+	if _, err := rand.Read(jti); err != nil {
+		return models.TokenPair{}, err
+	}
+
+	now := time.Now()
+	claims := jwt.Claims{
+		ID:        hex.EncodeToString(jti),
+		Subject:   admin.Username,
+		AdminID:   admin.ID,
+		Role:      int(admin.Role),
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(s.accessTTL).Unix(),
+	}
+
+	accessToken, err := jwt.Sign(claims, s.secret)
+	if err != nil {
+		return models.TokenPair{}, err
+	}
+
+	refreshToken, stored, err := models.NewRefreshToken(admin, s.refreshTTL)
+	if err != nil {
+		return models.TokenPair{}, err
+	}
+
+	if err = s.tokens.CreateRefreshToken(ctx, tx, *stored); err != nil {
+		return models.TokenPair{}, err
+	}
+
+	return models.TokenPair{
+		AccessToken:      accessToken,
+		AccessExpiresAt:  claims.Expiry(),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: stored.ExpiresAt,
+	}, nil
+}
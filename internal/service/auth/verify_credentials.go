@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// dummyAdmin is checked against passwords of unknown usernames, so they take as long as wrong passwords
+var dummyAdmin = sync.OnceValue(func() models.Admin {
+	admin, err := models.NewAdmin(0, "", "dummy password")
+	if err != nil {
+		return models.Admin{}
+	}
+
+	return *admin
+})
+
+// VerifyCredentials checks admin credentials passed from ip. Failed attempts are counted
+// per username and per ip, both get locked for exponentially growing time after too many
+// failures in a row. The same error is returned for unknown username and wrong password
+// to not reveal existing admins, deactivated admins are rejected the same way.
+// If password is expired admin is returned with ErrPasswordExpired,
+// password hashed with outdated cost is rehashed
+func (s *Service) VerifyCredentials(ctx context.Context, username string, password string,
+	ip string) (models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.VerifyCredentials")
+	defer span.Finish()
+
+	now := time.Now()
+	attempts, err := s.attempts.GetLoginAttempts(ctx, username, ip)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Admin{}, err
+	}
+
+	var failedBefore bool
+	for _, attempt := range attempts {
+		if attempt.IsLocked(now) {
+			s.logger.Error(ErrLoginLocked.Error(),
+				zap.String("username", username),
+				zap.String("ip", ip),
+				zap.String("locked", attempt.Kind),
+				zap.Time("locked_until", *attempt.LockedUntil),
+			)
+			span.SetTag("error", ErrLoginLocked)
+
+			return models.Admin{}, ErrLoginLocked
+		}
+		if attempt.Kind == models.UsernameLoginAttempt {
+			failedBefore = true
+		}
+	}
+
+	ok, err := s.admins.ContainsUsername(ctx, username)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Admin{}, err
+	}
+
+	admin := dummyAdmin()
+	if ok {
+		admin, err = s.admins.GetAdminByUsername(ctx, username)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return models.Admin{}, err
+		}
+	}
+
+	if !admin.CheckPassword(password) || !ok || !admin.IsActive() {
+		s.logger.Error(ErrInvalidCredentials.Error(),
+			zap.String("username", username),
+			zap.String("ip", ip),
+			zap.Error(ErrInvalidCredentials),
+		)
+		span.SetTag("error", ErrInvalidCredentials)
+
+		if err = s.registerFailedLogin(ctx, now, admin.ID, username, ip); err != nil {
+			return models.Admin{}, err
+		}
+
+		return models.Admin{}, ErrInvalidCredentials
+	}
+
+	if failedBefore {
+		if err = s.attempts.ResetLoginAttempts(ctx, models.UsernameLoginAttempt, username); err != nil {
+			span.SetTag("error", err)
+
+			return models.Admin{}, err
+		}
+	}
+
+	if admin.NeedsRehash() {
+		s.rehash(ctx, admin, password)
+	}
+
+	if s.policy.IsExpired(admin.PasswordChangedAt, now) {
+		s.logger.Error(ErrPasswordExpired.Error(),
+			zap.String("username", username),
+			zap.Time("password_changed_at", admin.PasswordChangedAt),
+			zap.Error(ErrPasswordExpired),
+		)
+		span.SetTag("error", ErrPasswordExpired)
+
+		return admin, ErrPasswordExpired
+	}
+
+	return admin, nil
+}
+
+// rehash updates hash of a password to current bcrypt cost, login isn't failed if it can't be done
+func (s *Service) rehash(ctx context.Context, admin models.Admin, password string) {
+	if err := admin.Rehash(password); err != nil {
+		s.logger.Error("failed to rehash password",
+			zap.String("username", admin.Username),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	if err := s.admins.UpdateAdmin(ctx, admin.ID, admin); err != nil {
+		s.logger.Error("failed to save rehashed password",
+			zap.String("username", admin.Username),
+			zap.Error(err),
+		)
+
+		return
+	}
+
+	s.logger.Info("password rehashed with current cost",
+		zap.String("username", admin.Username),
+	)
+}
@@ -0,0 +1,52 @@
+package client
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// CreateClient registers client and returns its id
+func (s *Service) CreateClient(ctx context.Context, client models.Client) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateClient")
+	defer span.Finish()
+
+	ok, err := s.Storage.ContainsPhone(ctx, client.Phone)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return 0, err
+	}
+	if ok {
+		s.logger.Error(ErrPhoneUsed.Error(),
+			zap.String("phone", client.Phone),
+			zap.Error(ErrPhoneUsed),
+		)
+		span.SetTag("error", ErrPhoneUsed)
+
+		return 0, ErrPhoneUsed
+	}
+
+	if client.ID != 0 {
+		ok, err = s.Storage.ContainsClientID(ctx, nil, client.ID)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return 0, err
+		}
+		if ok {
+			s.logger.Error(ErrIDUsed.Error(),
+				zap.Int("id", client.ID),
+				zap.Error(ErrIDUsed),
+			)
+			span.SetTag("error", ErrIDUsed)
+
+			return 0, ErrIDUsed
+		}
+	}
+
+	return s.Storage.CreateClient(ctx, client)
+}
@@ -0,0 +1,89 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_CreateClient(t *testing.T) {
+	t.Parallel()
+	errStorage := errors.New("storage error")
+
+	tests := []struct {
+		name          string
+		client        models.Client
+		mockSetup     func(*MockclientStorage)
+		expectedID    int
+		expectedError error
+	}{
+		{
+			name:   "Generated id",
+			client: models.Client{Name: "Ivan", Phone: "+79991234567"},
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(false, nil)
+				storage.EXPECT().CreateClient(gomock.Any(), models.Client{Name: "Ivan", Phone: "+79991234567"}).
+					Return(10, nil)
+			},
+			expectedID: 10,
+		},
+		{
+			name:   "Explicit id",
+			client: models.Client{ID: 42, Name: "Ivan", Phone: "+79991234567"},
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(false, nil)
+				storage.EXPECT().ContainsClientID(gomock.Any(), nil, 42).Return(false, nil)
+				storage.EXPECT().CreateClient(gomock.Any(), gomock.Any()).Return(42, nil)
+			},
+			expectedID: 42,
+		},
+		{
+			name:   "Phone used",
+			client: models.Client{ID: 42, Name: "Ivan", Phone: "+79991234567"},
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(true, nil)
+			},
+			expectedError: ErrPhoneUsed,
+		},
+		{
+			name:   "Id used",
+			client: models.Client{ID: 42, Name: "Ivan", Phone: "+79991234567"},
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(false, nil)
+				storage.EXPECT().ContainsClientID(gomock.Any(), nil, 42).Return(true, nil)
+			},
+			expectedError: ErrIDUsed,
+		},
+		{
+			name:   "Storage error",
+			client: models.Client{Name: "Ivan", Phone: "+79991234567"},
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(false, nil)
+				storage.EXPECT().CreateClient(gomock.Any(), gomock.Any()).Return(0, errStorage)
+			},
+			expectedError: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			storage := NewMockclientStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			id, err := service.CreateClient(t.Context(), tt.client)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package client
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// GetClientByPhone finds client by phone number
+func (s *Service) GetClientByPhone(ctx context.Context, phone string) (models.Client, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetClientByPhone")
+	defer span.Finish()
+
+	normalizedPhone, err := models.NormalizePhone(phone)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Client{}, err
+	}
+
+	ok, err := s.Storage.ContainsPhone(ctx, normalizedPhone)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.Client{}, err
+	}
+	if !ok {
+		s.logger.Error(ErrClientNotFound.Error(),
+			zap.String("phone", normalizedPhone),
+			zap.Error(ErrClientNotFound),
+		)
+		span.SetTag("error", ErrClientNotFound)
+
+		return models.Client{}, ErrClientNotFound
+	}
+
+	return s.Storage.GetClientByPhone(ctx, normalizedPhone)
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_GetClientByPhone(t *testing.T) {
+	t.Parallel()
+	client := models.Client{ID: 1, Name: "Ivan", Phone: "+79991234567"}
+
+	tests := []struct {
+		name           string
+		phone          string
+		mockSetup      func(*MockclientStorage)
+		expectedClient models.Client
+		expectedError  error
+	}{
+		{
+			name:  "Normalized phone",
+			phone: "8 (999) 123-45-67",
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(true, nil)
+				storage.EXPECT().GetClientByPhone(gomock.Any(), "+79991234567").Return(client, nil)
+			},
+			expectedClient: client,
+		},
+		{
+			name:          "Wrong phone",
+			phone:         "phone",
+			mockSetup:     func(_ *MockclientStorage) {},
+			expectedError: models.ErrWrongPhone,
+		},
+		{
+			name:  "Not found",
+			phone: "+79991234567",
+			mockSetup: func(storage *MockclientStorage) {
+				storage.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(false, nil)
+			},
+			expectedError: ErrClientNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			storage := NewMockclientStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			found, err := service.GetClientByPhone(t.Context(), tt.phone)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expectedClient, found)
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_client_test.go -package=client
+//
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockclientStorage is a mock of clientStorage interface.
+type MockclientStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockclientStorageMockRecorder is the mock recorder for MockclientStorage.
+type MockclientStorageMockRecorder struct {
+	mock *MockclientStorage
+}
+
+// NewMockclientStorage creates a new mock instance.
+func NewMockclientStorage(ctrl *gomock.Controller) *MockclientStorage {
+	mock := &MockclientStorage{ctrl: ctrl}
+	mock.recorder = &MockclientStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientStorage) EXPECT() *MockclientStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsClientID mocks base method.
+func (m *MockclientStorage) ContainsClientID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsClientID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsClientID indicates an expected call of ContainsClientID.
+func (mr *MockclientStorageMockRecorder) ContainsClientID(arg0, arg1, arg2 any) *MockclientStorageContainsClientIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsClientID", reflect.TypeOf((*MockclientStorage)(nil).ContainsClientID), arg0, arg1, arg2)
+	return &MockclientStorageContainsClientIDCall{Call: call}
+}
+
+// MockclientStorageContainsClientIDCall wrap *gomock.Call
+type MockclientStorageContainsClientIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsClientIDCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsClientIDCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsClientIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainsPhone mocks base method.
+func (m *MockclientStorage) ContainsPhone(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsPhone", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsPhone indicates an expected call of ContainsPhone.
+func (mr *MockclientStorageMockRecorder) ContainsPhone(arg0, arg1 any) *MockclientStorageContainsPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsPhone", reflect.TypeOf((*MockclientStorage)(nil).ContainsPhone), arg0, arg1)
+	return &MockclientStorageContainsPhoneCall{Call: call}
+}
+
+// MockclientStorageContainsPhoneCall wrap *gomock.Call
+type MockclientStorageContainsPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsPhoneCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsPhoneCall) Do(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsPhoneCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateClient mocks base method.
+func (m *MockclientStorage) CreateClient(arg0 context.Context, arg1 models.Client) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockclientStorageMockRecorder) CreateClient(arg0, arg1 any) *MockclientStorageCreateClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockclientStorage)(nil).CreateClient), arg0, arg1)
+	return &MockclientStorageCreateClientCall{Call: call}
+}
+
+// MockclientStorageCreateClientCall wrap *gomock.Call
+type MockclientStorageCreateClientCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageCreateClientCall) Return(arg0 int, arg1 error) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageCreateClientCall) Do(f func(context.Context, models.Client) (int, error)) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageCreateClientCall) DoAndReturn(f func(context.Context, models.Client) (int, error)) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByID mocks base method.
+func (m *MockclientStorage) GetClientByID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByID indicates an expected call of GetClientByID.
+func (mr *MockclientStorageMockRecorder) GetClientByID(arg0, arg1, arg2 any) *MockclientStorageGetClientByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByID", reflect.TypeOf((*MockclientStorage)(nil).GetClientByID), arg0, arg1, arg2)
+	return &MockclientStorageGetClientByIDCall{Call: call}
+}
+
+// MockclientStorageGetClientByIDCall wrap *gomock.Call
+type MockclientStorageGetClientByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageGetClientByIDCall) Return(arg0 models.Client, arg1 error) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageGetClientByIDCall) Do(f func(context.Context, pgx.Tx, int) (models.Client, error)) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageGetClientByIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.Client, error)) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByPhone mocks base method.
+func (m *MockclientStorage) GetClientByPhone(arg0 context.Context, arg1 string) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByPhone", arg0, arg1)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByPhone indicates an expected call of GetClientByPhone.
+func (mr *MockclientStorageMockRecorder) GetClientByPhone(arg0, arg1 any) *MockclientStorageGetClientByPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByPhone", reflect.TypeOf((*MockclientStorage)(nil).GetClientByPhone), arg0, arg1)
+	return &MockclientStorageGetClientByPhoneCall{Call: call}
+}
+
+// MockclientStorageGetClientByPhoneCall wrap *gomock.Call
+type MockclientStorageGetClientByPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageGetClientByPhoneCall) Return(arg0 models.Client, arg1 error) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageGetClientByPhoneCall) Do(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageGetClientByPhoneCall) DoAndReturn(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,46 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_client_test.go -package=client
+
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+type clientStorage interface {
+	CreateClient(context.Context, models.Client) (int, error)
+	GetClientByPhone(context.Context, string) (models.Client, error)
+	GetClientByID(context.Context, pgx.Tx, int) (models.Client, error)
+	ContainsPhone(context.Context, string) (bool, error)
+	ContainsClientID(context.Context, pgx.Tx, int) (bool, error)
+}
+
+// Service is a struct for client service
+type Service struct {
+	Storage clientStorage
+	logger  *zap.Logger
+}
+
+var (
+	// ErrPhoneUsed happens when phone is already registered
+	ErrPhoneUsed = errors.New("phone is already registered")
+
+	// ErrIDUsed happens when id is used
+	ErrIDUsed = errors.New("id is already used")
+
+	// ErrClientNotFound happens when client doesn't exist
+	ErrClientNotFound = errors.New("client not found")
+)
+
+// NewService creates instance of client Service
+func NewService(logger *zap.Logger, storage clientStorage) *Service {
+	return &Service{
+		Storage: storage,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,69 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+const (
+	adminIDField   = "admin_id"
+	orderIDField   = "order_id"
+	methodField    = "method"
+	statusField    = "status"
+	dateField      = "date"
+	jobStatusField = "job_status"
+)
+
+// ListLogs gets a page of audit logs that satisfy filter, newest first
+func (s *Service) ListLogs(ctx context.Context, filter models.LogFilter, count int, page int) ([]models.Log, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ListLogs")
+	defer span.Finish()
+
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.To.Before(filter.From) {
+		s.logger.Error(ErrWrongDateRange.Error(),
+			zap.Time("from", filter.From),
+			zap.Time("to", filter.To),
+			zap.Error(ErrWrongDateRange),
+		)
+		span.SetTag("error", ErrWrongDateRange)
+
+		return nil, ErrWrongDateRange
+	}
+
+	conds := make([]query.Cond, 0, 7)
+	if filter.AdminID != 0 {
+		conds = append(conds, query.Equal(adminIDField, filter.AdminID))
+	}
+	if filter.OrderID != 0 {
+		conds = append(conds, query.Equal(orderIDField, filter.OrderID))
+	}
+	if filter.Method != "" {
+		conds = append(conds, query.Equal(methodField, filter.Method))
+	}
+	if filter.Status != 0 {
+		conds = append(conds, query.Equal(statusField, filter.Status))
+	}
+	if !filter.From.IsZero() {
+		conds = append(conds, query.GreaterEqual(dateField, filter.From))
+	}
+	if !filter.To.IsZero() {
+		conds = append(conds, query.LessEqual(dateField, filter.To))
+	}
+	if filter.JobStatus != 0 {
+		conds = append(conds, query.Equal(jobStatusField, filter.JobStatus))
+	}
+
+	logs, err := s.storage.ListLogs(ctx, conds, count, page)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+
+	return logs, nil
+}
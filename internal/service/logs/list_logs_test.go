@@ -0,0 +1,72 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+func TestService_ListLogs(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 4, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		filter        models.LogFilter
+		mockSetup     func(*MocklogStorage)
+		expectedError error
+	}{
+		{
+			name:   "All filters",
+			filter: models.LogFilter{AdminID: 1, OrderID: 2, Method: "GRPC", Status: 404, From: from, To: to, JobStatus: 3},
+			mockSetup: func(storage *MocklogStorage) {
+				storage.EXPECT().ListLogs(gomock.Any(), []query.Cond{
+					query.Equal("admin_id", 1),
+					query.Equal("order_id", 2),
+					query.Equal("method", "GRPC"),
+					query.Equal("status", 404),
+					query.GreaterEqual("date", from),
+					query.LessEqual("date", to),
+					query.Equal("job_status", 3),
+				}, 10, 1).Return([]models.Log{{ID: 1}}, nil).Times(1)
+			},
+		},
+		{
+			name: "No filters",
+			mockSetup: func(storage *MocklogStorage) {
+				storage.EXPECT().ListLogs(gomock.Any(), []query.Cond{}, 10, 1).Return([]models.Log{}, nil).Times(1)
+			},
+		},
+		{
+			name:          "Wrong date range",
+			filter:        models.LogFilter{From: to, To: from},
+			mockSetup:     func(_ *MocklogStorage) {},
+			expectedError: ErrWrongDateRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMocklogStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			_, err := service.ListLogs(t.Context(), tt.filter, 10, 1)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+		})
+	}
+}
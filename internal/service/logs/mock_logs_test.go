@@ -0,0 +1,160 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_logs_test.go -package=logs
+//
+
+// Package logs is a generated GoMock package.
+package logs
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	query "gitlab.ozon.dev/alexplay1224/homework/internal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocklogStorage is a mock of logStorage interface.
+type MocklogStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocklogStorageMockRecorder is the mock recorder for MocklogStorage.
+type MocklogStorageMockRecorder struct {
+	mock *MocklogStorage
+}
+
+// NewMocklogStorage creates a new mock instance.
+func NewMocklogStorage(ctrl *gomock.Controller) *MocklogStorage {
+	mock := &MocklogStorage{ctrl: ctrl}
+	mock.recorder = &MocklogStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogStorage) EXPECT() *MocklogStorageMockRecorder {
+	return m.recorder
+}
+
+// GetLogsAfter mocks base method.
+func (m *MocklogStorage) GetLogsAfter(arg0 context.Context, arg1, arg2 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogsAfter indicates an expected call of GetLogsAfter.
+func (mr *MocklogStorageMockRecorder) GetLogsAfter(arg0, arg1, arg2 any) *MocklogStorageGetLogsAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsAfter", reflect.TypeOf((*MocklogStorage)(nil).GetLogsAfter), arg0, arg1, arg2)
+	return &MocklogStorageGetLogsAfterCall{Call: call}
+}
+
+// MocklogStorageGetLogsAfterCall wrap *gomock.Call
+type MocklogStorageGetLogsAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageGetLogsAfterCall) Return(arg0 []models.Log, arg1 error) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageGetLogsAfterCall) Do(f func(context.Context, int, int) ([]models.Log, error)) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageGetLogsAfterCall) DoAndReturn(f func(context.Context, int, int) ([]models.Log, error)) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLogs mocks base method.
+func (m *MocklogStorage) ListLogs(arg0 context.Context, arg1 []query.Cond, arg2, arg3 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLogs indicates an expected call of ListLogs.
+func (mr *MocklogStorageMockRecorder) ListLogs(arg0, arg1, arg2, arg3 any) *MocklogStorageListLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogs", reflect.TypeOf((*MocklogStorage)(nil).ListLogs), arg0, arg1, arg2, arg3)
+	return &MocklogStorageListLogsCall{Call: call}
+}
+
+// MocklogStorageListLogsCall wrap *gomock.Call
+type MocklogStorageListLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageListLogsCall) Return(arg0 []models.Log, arg1 error) *MocklogStorageListLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageListLogsCall) Do(f func(context.Context, []query.Cond, int, int) ([]models.Log, error)) *MocklogStorageListLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageListLogsCall) DoAndReturn(f func(context.Context, []query.Cond, int, int) ([]models.Log, error)) *MocklogStorageListLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequeueDeadLogs mocks base method.
+func (m *MocklogStorage) RequeueDeadLogs(arg0 context.Context, arg1 []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLogs", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLogs indicates an expected call of RequeueDeadLogs.
+func (mr *MocklogStorageMockRecorder) RequeueDeadLogs(arg0, arg1 any) *MocklogStorageRequeueDeadLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLogs", reflect.TypeOf((*MocklogStorage)(nil).RequeueDeadLogs), arg0, arg1)
+	return &MocklogStorageRequeueDeadLogsCall{Call: call}
+}
+
+// MocklogStorageRequeueDeadLogsCall wrap *gomock.Call
+type MocklogStorageRequeueDeadLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageRequeueDeadLogsCall) Return(arg0 int, arg1 error) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageRequeueDeadLogsCall) Do(f func(context.Context, []int) (int, error)) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageRequeueDeadLogsCall) DoAndReturn(f func(context.Context, []int) (int, error)) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
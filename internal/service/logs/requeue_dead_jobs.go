@@ -0,0 +1,45 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// RequeueDeadJobs makes jobs without attempts left to be sent to Kafka again with all attempts,
+// all dead jobs are requeued if ids are empty, returns number of requeued jobs
+func (s *Service) RequeueDeadJobs(ctx context.Context, ids []int) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RequeueDeadJobs")
+	defer span.Finish()
+
+	for _, id := range ids {
+		if id <= 0 {
+			s.logger.Error(ErrWrongLogID.Error(),
+				zap.Int("id", id),
+				zap.Error(ErrWrongLogID),
+			)
+			span.SetTag("error", ErrWrongLogID)
+
+			return 0, ErrWrongLogID
+		}
+	}
+
+	requeued, err := s.storage.RequeueDeadLogs(ctx, ids)
+	if err != nil {
+		s.logger.Error("failed to requeue dead jobs",
+			zap.Ints("ids", ids),
+			zap.Error(err),
+		)
+		span.SetTag("error", err)
+
+		return 0, err
+	}
+
+	s.logger.Info("dead jobs requeued",
+		zap.Ints("ids", ids),
+		zap.Int("requeued", requeued),
+	)
+
+	return requeued, nil
+}
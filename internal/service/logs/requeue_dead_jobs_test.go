@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestService_RequeueDeadJobs(t *testing.T) {
+	t.Parallel()
+
+	errStorage := errors.New("storage error")
+
+	tests := []struct {
+		name             string
+		ids              []int
+		mockSetup        func(*MocklogStorage)
+		expectedRequeued int
+		expectedError    error
+	}{
+		{
+			name: "All dead jobs",
+			mockSetup: func(storage *MocklogStorage) {
+				storage.EXPECT().RequeueDeadLogs(gomock.Any(), []int(nil)).Return(3, nil).Times(1)
+			},
+			expectedRequeued: 3,
+		},
+		{
+			name: "Selected jobs",
+			ids:  []int{1, 2},
+			mockSetup: func(storage *MocklogStorage) {
+				storage.EXPECT().RequeueDeadLogs(gomock.Any(), []int{1, 2}).Return(1, nil).Times(1)
+			},
+			expectedRequeued: 1,
+		},
+		{
+			name:          "Wrong id",
+			ids:           []int{1, 0},
+			mockSetup:     func(_ *MocklogStorage) {},
+			expectedError: ErrWrongLogID,
+		},
+		{
+			name: "Storage error",
+			mockSetup: func(storage *MocklogStorage) {
+				storage.EXPECT().RequeueDeadLogs(gomock.Any(), []int(nil)).Return(0, errStorage).Times(1)
+			},
+			expectedError: errStorage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMocklogStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			requeued, err := service.RequeueDeadJobs(t.Context(), tt.ids)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			assert.Equal(t, tt.expectedRequeued, requeued)
+		})
+	}
+}
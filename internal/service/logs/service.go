@@ -0,0 +1,41 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_logs_test.go -package=logs
+
+package logs
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+var (
+	// ErrWrongDateRange happens when date range ends before it starts
+	ErrWrongDateRange = errors.New("date range ends before it starts")
+
+	// ErrWrongLogID happens when log id is not positive
+	ErrWrongLogID = errors.New("log id must be positive")
+)
+
+type logStorage interface {
+	ListLogs(context.Context, []query.Cond, int, int) ([]models.Log, error)
+	GetLogsAfter(context.Context, int, int) ([]models.Log, error)
+	RequeueDeadLogs(context.Context, []int) (int, error)
+}
+
+// Service is a structure for log service, it reads audit logs and requeues dead jobs
+type Service struct {
+	storage logStorage
+	logger  *zap.Logger
+}
+
+// NewService creates instance of a log Service
+func NewService(logger *zap.Logger, storage logStorage) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,71 @@
+package logs
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+const (
+	verifyBatchSize = 1000
+
+	reasonPrevHashMismatch = "previous hash doesn't match, previous log was deleted or changed"
+	reasonHashMismatch     = "hash doesn't match content, log was changed"
+)
+
+// VerifyAuditChain walks audit logs in order they were written and reports the first broken link,
+// logs written before chaining was introduced have no hash and are skipped
+func (s *Service) VerifyAuditChain(ctx context.Context) (models.AuditChainReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.VerifyAuditChain")
+	defer span.Finish()
+
+	report := models.AuditChainReport{Valid: true}
+	var prevHash string
+	chained := false
+	lastID := 0
+	for {
+		batch, err := s.storage.GetLogsAfter(ctx, lastID, verifyBatchSize)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return models.AuditChainReport{}, err
+		}
+
+		for _, log := range batch {
+			lastID = log.ID
+			if !chained && log.Hash == "" {
+				continue
+			}
+			chained = true
+			report.Checked++
+
+			reason := ""
+			if log.PrevHash != prevHash {
+				reason = reasonPrevHashMismatch
+			} else if log.ChainHash(log.PrevHash) != log.Hash {
+				reason = reasonHashMismatch
+			}
+			if reason != "" {
+				s.logger.Error("audit chain is broken",
+					zap.Int("log_id", log.ID),
+					zap.String("reason", reason),
+				)
+
+				report.Valid = false
+				report.BrokenLogID = log.ID
+				report.Reason = reason
+
+				return report, nil
+			}
+
+			prevHash = log.Hash
+		}
+
+		if len(batch) < verifyBatchSize {
+			return report, nil
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package logs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func chain(logs ...models.Log) []models.Log {
+	prevHash := ""
+	for i := range logs {
+		logs[i].ID = i + 1
+		logs[i].Chain(prevHash)
+		prevHash = logs[i].Hash
+	}
+
+	return logs
+}
+
+func TestService_VerifyAuditChain(t *testing.T) {
+	t.Parallel()
+
+	newLogs := func() []models.Log {
+		return chain(
+			*models.NewLog(1, 1, "success", "/orders", "POST", 200),
+			*models.NewLog(1, 2, "success", "/orders/process", "POST", 200),
+			*models.NewLog(-1, 2, "OK", "/admin.proto.AdminService/CreateAdmin", "GRPC", 200),
+		)
+	}
+
+	tests := []struct {
+		name           string
+		logs           func() []models.Log
+		expectedReport models.AuditChainReport
+	}{
+		{
+			name:           "Valid chain",
+			logs:           newLogs,
+			expectedReport: models.AuditChainReport{Checked: 3, Valid: true},
+		},
+		{
+			name: "Logs before chaining are skipped",
+			logs: func() []models.Log {
+				legacy := models.Log{ID: 1, Message: "old", Date: time.Now()}
+				logs := newLogs()
+				for i := range logs {
+					logs[i].ID++
+				}
+
+				return append([]models.Log{legacy}, logs...)
+			},
+			expectedReport: models.AuditChainReport{Checked: 3, Valid: true},
+		},
+		{
+			name: "Changed log",
+			logs: func() []models.Log {
+				logs := newLogs()
+				logs[1].AdminID = 1
+
+				return logs
+			},
+			expectedReport: models.AuditChainReport{Checked: 2, BrokenLogID: 2, Reason: reasonHashMismatch},
+		},
+		{
+			name: "Deleted log",
+			logs: func() []models.Log {
+				logs := newLogs()
+
+				return append(logs[:1], logs[2:]...)
+			},
+			expectedReport: models.AuditChainReport{Checked: 2, BrokenLogID: 3, Reason: reasonPrevHashMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			storage := NewMocklogStorage(ctrl)
+			storage.EXPECT().GetLogsAfter(gomock.Any(), 0, verifyBatchSize).Return(tt.logs(), nil).Times(1)
+
+			service := NewService(zap.NewNop(), storage)
+
+			report, err := service.VerifyAuditChain(t.Context())
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/retry"
+)
+
+// Dispatch sends a batch of notifications from the outbox, failed ones are retried with exponential backoff.
+// Notification is considered sent only if every channel delivered it, so channels may get it more than once
+func (s *Service) Dispatch(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Dispatch")
+	defer span.Finish()
+
+	notifications, err := s.storage.GetAndMarkNotifications(ctx, s.batchSize)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	for _, notification := range notifications {
+		status := models.DoneStatus
+		attemptsLeft := notification.AttemptsLeft
+		nextAttemptAt := notification.NextAttemptAt
+
+		if err = s.send(ctx, notification); err != nil {
+			attemptsLeft--
+			if attemptsLeft <= 0 {
+				status = models.NoAttemptsLeftStatus
+			} else {
+				status = models.FailedStatus
+				nextAttemptAt = time.Now().Add(retry.Backoff(baseRetryDelay, maxRetryDelay,
+					maxAttempts-attemptsLeft))
+			}
+		}
+
+		err = s.storage.UpdateNotification(ctx, notification.ID, status, attemptsLeft, nextAttemptAt)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) send(ctx context.Context, notification models.Notification) error {
+	for _, channel := range s.channels {
+		if err := channel.Send(ctx, notification); err != nil {
+			s.logger.Error("failed to send notification",
+				zap.Int("id", notification.ID),
+				zap.String("channel", channel.Name()),
+				zap.Int("attempts_left", notification.AttemptsLeft-1),
+				zap.Error(err),
+			)
+
+			return err
+		}
+	}
+
+	return nil
+}
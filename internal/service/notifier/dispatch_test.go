@@ -0,0 +1,99 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_Dispatch(t *testing.T) {
+	t.Parallel()
+	errSend := errors.New("channel is down")
+	tests := []struct {
+		name                 string
+		attemptsLeft         int
+		sendErr              error
+		expectedStatus       int
+		expectedAttemptsLeft int
+		expectedDelay        time.Duration
+	}{
+		{
+			name:                 "Sent",
+			attemptsLeft:         maxAttempts,
+			expectedStatus:       models.DoneStatus,
+			expectedAttemptsLeft: maxAttempts,
+		},
+		{
+			name:                 "First failure",
+			attemptsLeft:         maxAttempts,
+			sendErr:              errSend,
+			expectedStatus:       models.FailedStatus,
+			expectedAttemptsLeft: maxAttempts - 1,
+			expectedDelay:        baseRetryDelay,
+		},
+		{
+			name:                 "Third failure",
+			attemptsLeft:         maxAttempts - 2,
+			sendErr:              errSend,
+			expectedStatus:       models.FailedStatus,
+			expectedAttemptsLeft: maxAttempts - 3,
+			expectedDelay:        4 * baseRetryDelay,
+		},
+		{
+			name:                 "No attempts left",
+			attemptsLeft:         1,
+			sendErr:              errSend,
+			expectedStatus:       models.NoAttemptsLeftStatus,
+			expectedAttemptsLeft: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMocknotificationStorage(ctrl)
+			channel := NewMockChannel(ctrl)
+
+			notification := models.Notification{
+				ID:            1,
+				OrderID:       123,
+				ClientID:      456,
+				Kind:          models.OrderArrivedNotification,
+				AttemptsLeft:  tt.attemptsLeft,
+				NextAttemptAt: time.Now(),
+			}
+
+			storage.EXPECT().GetAndMarkNotifications(gomock.Any(), 5).
+				Return([]models.Notification{notification}, nil).Times(1)
+			channel.EXPECT().Send(gomock.Any(), notification).Return(tt.sendErr).Times(1)
+			channel.EXPECT().Name().Return("mock").AnyTimes()
+
+			before := time.Now()
+			storage.EXPECT().UpdateNotification(gomock.Any(), 1, tt.expectedStatus, tt.expectedAttemptsLeft,
+				gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ int, _ int, _ int, nextAttemptAt time.Time) error {
+					if tt.expectedStatus == models.FailedStatus {
+						assert.WithinDuration(t, before.Add(tt.expectedDelay), nextAttemptAt, time.Second)
+					} else {
+						assert.Equal(t, notification.NextAttemptAt, nextAttemptAt)
+					}
+
+					return nil
+				}).Times(1)
+
+			service := NewService(zap.NewNop(), storage, 5, channel)
+
+			assert.NoError(t, service.Dispatch(t.Context()))
+		})
+	}
+}
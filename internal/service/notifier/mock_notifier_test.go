@@ -0,0 +1,298 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_notifier_test.go -package=notifier
+//
+
+// Package notifier is a generated GoMock package.
+package notifier
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocknotificationStorage is a mock of notificationStorage interface.
+type MocknotificationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocknotificationStorageMockRecorder is the mock recorder for MocknotificationStorage.
+type MocknotificationStorageMockRecorder struct {
+	mock *MocknotificationStorage
+}
+
+// NewMocknotificationStorage creates a new mock instance.
+func NewMocknotificationStorage(ctrl *gomock.Controller) *MocknotificationStorage {
+	mock := &MocknotificationStorage{ctrl: ctrl}
+	mock.recorder = &MocknotificationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationStorage) EXPECT() *MocknotificationStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MocknotificationStorage) CreateNotification(arg0 context.Context, arg1 pgx.Tx, arg2 models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MocknotificationStorageMockRecorder) CreateNotification(arg0, arg1, arg2 any) *MocknotificationStorageCreateNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MocknotificationStorage)(nil).CreateNotification), arg0, arg1, arg2)
+	return &MocknotificationStorageCreateNotificationCall{Call: call}
+}
+
+// MocknotificationStorageCreateNotificationCall wrap *gomock.Call
+type MocknotificationStorageCreateNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageCreateNotificationCall) Return(arg0 error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageCreateNotificationCall) Do(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageCreateNotificationCall) DoAndReturn(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAndMarkNotifications mocks base method.
+func (m *MocknotificationStorage) GetAndMarkNotifications(arg0 context.Context, arg1 int) ([]models.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndMarkNotifications", arg0, arg1)
+	ret0, _ := ret[0].([]models.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndMarkNotifications indicates an expected call of GetAndMarkNotifications.
+func (mr *MocknotificationStorageMockRecorder) GetAndMarkNotifications(arg0, arg1 any) *MocknotificationStorageGetAndMarkNotificationsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndMarkNotifications", reflect.TypeOf((*MocknotificationStorage)(nil).GetAndMarkNotifications), arg0, arg1)
+	return &MocknotificationStorageGetAndMarkNotificationsCall{Call: call}
+}
+
+// MocknotificationStorageGetAndMarkNotificationsCall wrap *gomock.Call
+type MocknotificationStorageGetAndMarkNotificationsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageGetAndMarkNotificationsCall) Return(arg0 []models.Notification, arg1 error) *MocknotificationStorageGetAndMarkNotificationsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageGetAndMarkNotificationsCall) Do(f func(context.Context, int) ([]models.Notification, error)) *MocknotificationStorageGetAndMarkNotificationsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageGetAndMarkNotificationsCall) DoAndReturn(f func(context.Context, int) ([]models.Notification, error)) *MocknotificationStorageGetAndMarkNotificationsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrdersToRemind mocks base method.
+func (m *MocknotificationStorage) GetOrdersToRemind(arg0 context.Context, arg1 models.NotificationKind, arg2, arg3 time.Time, arg4 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrdersToRemind", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrdersToRemind indicates an expected call of GetOrdersToRemind.
+func (mr *MocknotificationStorageMockRecorder) GetOrdersToRemind(arg0, arg1, arg2, arg3, arg4 any) *MocknotificationStorageGetOrdersToRemindCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrdersToRemind", reflect.TypeOf((*MocknotificationStorage)(nil).GetOrdersToRemind), arg0, arg1, arg2, arg3, arg4)
+	return &MocknotificationStorageGetOrdersToRemindCall{Call: call}
+}
+
+// MocknotificationStorageGetOrdersToRemindCall wrap *gomock.Call
+type MocknotificationStorageGetOrdersToRemindCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageGetOrdersToRemindCall) Return(arg0 []models.Order, arg1 error) *MocknotificationStorageGetOrdersToRemindCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageGetOrdersToRemindCall) Do(f func(context.Context, models.NotificationKind, time.Time, time.Time, int) ([]models.Order, error)) *MocknotificationStorageGetOrdersToRemindCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageGetOrdersToRemindCall) DoAndReturn(f func(context.Context, models.NotificationKind, time.Time, time.Time, int) ([]models.Order, error)) *MocknotificationStorageGetOrdersToRemindCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateNotification mocks base method.
+func (m *MocknotificationStorage) UpdateNotification(arg0 context.Context, arg1, arg2, arg3 int, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotification", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotification indicates an expected call of UpdateNotification.
+func (mr *MocknotificationStorageMockRecorder) UpdateNotification(arg0, arg1, arg2, arg3, arg4 any) *MocknotificationStorageUpdateNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotification", reflect.TypeOf((*MocknotificationStorage)(nil).UpdateNotification), arg0, arg1, arg2, arg3, arg4)
+	return &MocknotificationStorageUpdateNotificationCall{Call: call}
+}
+
+// MocknotificationStorageUpdateNotificationCall wrap *gomock.Call
+type MocknotificationStorageUpdateNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageUpdateNotificationCall) Return(arg0 error) *MocknotificationStorageUpdateNotificationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageUpdateNotificationCall) Do(f func(context.Context, int, int, int, time.Time) error) *MocknotificationStorageUpdateNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageUpdateNotificationCall) DoAndReturn(f func(context.Context, int, int, int, time.Time) error) *MocknotificationStorageUpdateNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockChannel is a mock of Channel interface.
+type MockChannel struct {
+	ctrl     *gomock.Controller
+	recorder *MockChannelMockRecorder
+	isgomock struct{}
+}
+
+// MockChannelMockRecorder is the mock recorder for MockChannel.
+type MockChannelMockRecorder struct {
+	mock *MockChannel
+}
+
+// NewMockChannel creates a new mock instance.
+func NewMockChannel(ctrl *gomock.Controller) *MockChannel {
+	mock := &MockChannel{ctrl: ctrl}
+	mock.recorder = &MockChannelMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChannel) EXPECT() *MockChannelMockRecorder {
+	return m.recorder
+}
+
+// Name mocks base method.
+func (m *MockChannel) Name() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Name")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Name indicates an expected call of Name.
+func (mr *MockChannelMockRecorder) Name() *MockChannelNameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Name", reflect.TypeOf((*MockChannel)(nil).Name))
+	return &MockChannelNameCall{Call: call}
+}
+
+// MockChannelNameCall wrap *gomock.Call
+type MockChannelNameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelNameCall) Return(arg0 string) *MockChannelNameCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelNameCall) Do(f func() string) *MockChannelNameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelNameCall) DoAndReturn(f func() string) *MockChannelNameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Send mocks base method.
+func (m *MockChannel) Send(arg0 context.Context, arg1 models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockChannelMockRecorder) Send(arg0, arg1 any) *MockChannelSendCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockChannel)(nil).Send), arg0, arg1)
+	return &MockChannelSendCall{Call: call}
+}
+
+// MockChannelSendCall wrap *gomock.Call
+type MockChannelSendCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockChannelSendCall) Return(arg0 error) *MockChannelSendCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockChannelSendCall) Do(f func(context.Context, models.Notification) error) *MockChannelSendCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockChannelSendCall) DoAndReturn(f func(context.Context, models.Notification) error) *MockChannelSendCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,57 @@
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// ScheduleReminders puts reminders about stored orders expiring in the next 24 hours
+// and orders expired in the last 24 hours into the outbox
+func (s *Service) ScheduleReminders(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.ScheduleReminders")
+	defer span.Finish()
+
+	now := time.Now()
+
+	err := s.remind(ctx, models.ExpiringSoonNotification, now, now.Add(remindBefore))
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	err = s.remind(ctx, models.OrderExpiredNotification, now.Add(-remindBefore), now)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	return nil
+}
+
+// remind pages through orders in the window until every order got a reminder, orders that got it
+// don't match anymore, so every page starts from the beginning of the window
+func (s *Service) remind(ctx context.Context, kind models.NotificationKind, from time.Time, to time.Time) error {
+	for {
+		orders, err := s.storage.GetOrdersToRemind(ctx, kind, from, to, s.batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, order := range orders {
+			err = s.storage.CreateNotification(ctx, nil, *models.NewNotification(order, kind))
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(orders) == 0 || len(orders) < s.batchSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_ScheduleReminders(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMocknotificationStorage(ctrl)
+
+	now := time.Now()
+	expiring := models.Order{ID: 1, UserID: 10, ExpiryDate: now.Add(3 * time.Hour)}
+	expired := models.Order{ID: 2, UserID: 20, ExpiryDate: now.Add(-time.Hour)}
+
+	storage.EXPECT().GetOrdersToRemind(gomock.Any(), models.ExpiringSoonNotification, gomock.Any(),
+		gomock.Any(), 5).
+		DoAndReturn(func(_ context.Context, _ models.NotificationKind, from time.Time, to time.Time,
+			_ int) ([]models.Order, error) {
+			assert.WithinDuration(t, now, from, time.Second)
+			assert.WithinDuration(t, now.Add(remindBefore), to, time.Second)
+
+			return []models.Order{expiring}, nil
+		}).Times(1)
+	storage.EXPECT().GetOrdersToRemind(gomock.Any(), models.OrderExpiredNotification, gomock.Any(),
+		gomock.Any(), 5).
+		Return([]models.Order{expired}, nil).Times(1)
+
+	storage.EXPECT().CreateNotification(gomock.Any(), gomock.Nil(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ pgx.Tx, notification models.Notification) error {
+			assert.Equal(t, expiring.ID, notification.OrderID)
+			assert.Equal(t, expiring.UserID, notification.ClientID)
+			assert.Equal(t, models.ExpiringSoonNotification, notification.Kind)
+
+			return nil
+		}).Times(1)
+	storage.EXPECT().CreateNotification(gomock.Any(), gomock.Nil(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ pgx.Tx, notification models.Notification) error {
+			assert.Equal(t, expired.ID, notification.OrderID)
+			assert.Equal(t, models.OrderExpiredNotification, notification.Kind)
+
+			return nil
+		}).Times(1)
+
+	service := NewService(zap.NewNop(), storage, 5)
+
+	assert.NoError(t, service.ScheduleReminders(t.Context()))
+}
+
+func TestService_ScheduleReminders_MoreThanBatch(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMocknotificationStorage(ctrl)
+
+	const batchSize = 5
+	orders := make([]models.Order, 0, 2*batchSize+2)
+	for id := 1; id <= 2*batchSize+2; id++ {
+		orders = append(orders, models.Order{ID: id, UserID: 10, ExpiryDate: time.Now().Add(time.Hour)})
+	}
+	reminded := make(map[int]bool)
+
+	storage.EXPECT().GetOrdersToRemind(gomock.Any(), models.ExpiringSoonNotification, gomock.Any(),
+		gomock.Any(), batchSize).
+		DoAndReturn(func(_ context.Context, _ models.NotificationKind, _ time.Time, _ time.Time,
+			count int) ([]models.Order, error) {
+			page := make([]models.Order, 0, count)
+			for _, order := range orders {
+				if !reminded[order.ID] && len(page) < count {
+					page = append(page, order)
+				}
+			}
+
+			return page, nil
+		}).Times(3)
+	storage.EXPECT().GetOrdersToRemind(gomock.Any(), models.OrderExpiredNotification, gomock.Any(),
+		gomock.Any(), batchSize).
+		Return(nil, nil).Times(1)
+
+	storage.EXPECT().CreateNotification(gomock.Any(), gomock.Nil(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ pgx.Tx, notification models.Notification) error {
+			reminded[notification.OrderID] = true
+
+			return nil
+		}).Times(len(orders))
+
+	service := NewService(zap.NewNop(), storage, batchSize)
+
+	assert.NoError(t, service.ScheduleReminders(t.Context()))
+	assert.Len(t, reminded, len(orders))
+}
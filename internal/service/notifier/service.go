@@ -0,0 +1,74 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_notifier_test.go -package=notifier
+
+package notifier
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+const (
+	remindBefore   = 24 * time.Hour
+	baseRetryDelay = 30 * time.Second
+	maxRetryDelay  = time.Hour
+	maxAttempts    = 5
+)
+
+type notificationStorage interface {
+	CreateNotification(context.Context, pgx.Tx, models.Notification) error
+	GetAndMarkNotifications(context.Context, int) ([]models.Notification, error)
+	UpdateNotification(context.Context, int, int, int, time.Time) error
+	GetOrdersToRemind(context.Context, models.NotificationKind, time.Time, time.Time, int) ([]models.Order, error)
+}
+
+// Channel is a way to deliver notification to a client
+type Channel interface {
+	Name() string
+	Send(context.Context, models.Notification) error
+}
+
+// Service is a structure for notification dispatcher, it sends notifications from the outbox
+// through every channel and schedules expiry reminders
+type Service struct {
+	storage   notificationStorage
+	channels  []Channel
+	batchSize int
+	logger    *zap.Logger
+}
+
+// NewService creates instance of a notification Service
+func NewService(logger *zap.Logger, storage notificationStorage, batchSize int, channels ...Channel) *Service {
+	return &Service{
+		storage:   storage,
+		channels:  channels,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Start starts dispatching notifications every interval and scheduling reminders every remindInterval
+func (s *Service) Start(ctx context.Context, interval time.Duration, remindInterval time.Duration) {
+	go s.run(ctx, interval, s.Dispatch)
+	go s.run(ctx, remindInterval, s.ScheduleReminders)
+}
+
+func (s *Service) run(ctx context.Context, interval time.Duration, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				s.logger.Error("notification job failed", zap.Error(err))
+			}
+		}
+	}
+}
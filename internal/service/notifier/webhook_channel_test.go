@@ -0,0 +1,88 @@
+package notifier
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestWebhookChannel_Send(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		status        int
+		expectedError bool
+	}{
+		{
+			name:   "Delivered",
+			status: http.StatusOK,
+		},
+		{
+			name:   "Accepted",
+			status: http.StatusAccepted,
+		},
+		{
+			name:          "Server error",
+			status:        http.StatusInternalServerError,
+			expectedError: true,
+		},
+		{
+			name:          "Redirect",
+			status:        http.StatusFound,
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			notification := models.Notification{
+				ID:       1,
+				OrderID:  123,
+				ClientID: 456,
+				Kind:     models.OrderArrivedNotification,
+				Message:  "Order 123 has arrived",
+			}
+
+			var received models.Notification
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			err := NewWebhookChannel(server.URL, time.Second).Send(t.Context(), notification)
+
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, notification.OrderID, received.OrderID)
+			assert.Equal(t, notification.ClientID, received.ClientID)
+			assert.Equal(t, notification.Kind, received.Kind)
+			assert.Equal(t, notification.Message, received.Message)
+		})
+	}
+}
+
+func TestWebhookChannel_SendUnreachable(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	err := NewWebhookChannel(url, time.Second).Send(t.Context(), models.Notification{})
+
+	assert.Error(t, err)
+}
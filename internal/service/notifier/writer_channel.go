@@ -0,0 +1,55 @@
+package notifier
+
+import (
+	"context"
+	"io"
+	"os"
+	"sync"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// WriterChannel is a channel that writes notifications as text, e.g. to stdout or a file
+type WriterChannel struct {
+	name   string
+	writer io.Writer
+	mu     sync.Mutex
+}
+
+// NewWriterChannel creates a channel that writes notifications to writer
+func NewWriterChannel(name string, writer io.Writer) *WriterChannel {
+	return &WriterChannel{
+		name:   name,
+		writer: writer,
+	}
+}
+
+// NewStdoutChannel creates a channel that prints notifications to stdout
+func NewStdoutChannel() *WriterChannel {
+	return NewWriterChannel("stdout", os.Stdout)
+}
+
+// NewFileChannel creates a channel that appends notifications to a file
+func NewFileChannel(path string) (*WriterChannel, *os.File, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return NewWriterChannel("file", file), file, nil
+}
+
+// Name returns name of a channel
+func (c *WriterChannel) Name() string {
+	return c.name
+}
+
+// Send writes notification
+func (c *WriterChannel) Send(_ context.Context, notification models.Notification) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err := io.WriteString(c.writer, notification.String())
+
+	return err
+}
@@ -2,13 +2,16 @@ package order
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/jackc/pgx/v4"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
 
 	"github.com/Rhymond/go-money"
 )
@@ -31,7 +34,25 @@ func (s *Service) pack(order *models.Order, packaging models.Packaging) error {
 		order.ExtraPackaging = packaging.GetType()
 	}
 
-	tmp, err := order.Price.Add(packaging.GetCost())
+	cost := packaging.GetCost()
+	if cost.IsZero() {
+		return nil
+	}
+
+	if !order.Price.SameCurrency(cost) {
+		s.logger.Error(ErrCurrencyMismatch.Error(),
+			zap.Int("order_id", order.ID),
+			zap.String("currency", order.Price.Currency().Code),
+			zap.String("packaging_currency", cost.Currency().Code),
+			zap.Int("packaging", int(packaging.GetType())),
+			zap.Error(ErrCurrencyMismatch),
+		)
+
+		return fmt.Errorf("%w: order is in %s, %s costs %s", ErrCurrencyMismatch,
+			order.Price.Currency().Code, packaging.String(), cost.Display())
+	}
+
+	tmp, err := order.Price.Add(cost)
 	if err != nil {
 		s.logger.Error("error adding price",
 			zap.Int("packaging", int(packaging.GetType())),
@@ -83,7 +104,17 @@ func (s *Service) validateOrder(order models.Order) error {
 		return ErrWrongWeight
 	}
 
-	if ok, err := order.Price.GreaterThan(money.New(0, money.RUB)); err != nil || !ok {
+	if _, err := currency.Validate(order.Price.Currency().Code); err != nil {
+		s.logger.Error(ErrUnknownCurrency.Error(),
+			zap.Int("order_id", order.ID),
+			zap.String("currency", order.Price.Currency().Code),
+			zap.Error(ErrUnknownCurrency),
+		)
+
+		return ErrUnknownCurrency
+	}
+
+	if ok, err := order.Price.GreaterThan(money.New(0, order.Price.Currency().Code)); err != nil || !ok {
 		s.logger.Error("negative price",
 			zap.Int("order_id", order.ID),
 			zap.Int64("price", order.Price.Amount()),
@@ -96,9 +127,9 @@ func (s *Service) validateOrder(order models.Order) error {
 	return nil
 }
 
-// AcceptOrder accept order
+// AcceptOrder accept order and returns one-time pickup code for the client
 func (s *Service) AcceptOrder(ctx context.Context, orderID int, userID int, weight float64, price money.Money,
-	expiryDate time.Time, packagings []models.Packaging) error {
+	expiryDate time.Time, packagings []models.Packaging) (string, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.AcceptOrder")
 	defer span.Finish()
 
@@ -106,7 +137,7 @@ func (s *Service) AcceptOrder(ctx context.Context, orderID int, userID int, weig
 	if err != nil {
 		span.SetTag("error", err)
 
-		return err
+		return "", err
 	}
 
 	currentTime := time.Now()
@@ -118,7 +149,7 @@ func (s *Service) AcceptOrder(ctx context.Context, orderID int, userID int, weig
 	if err != nil {
 		span.SetTag("error", err)
 
-		return err
+		return "", err
 	}
 
 	for _, somePackaging := range packagings {
@@ -126,11 +157,18 @@ func (s *Service) AcceptOrder(ctx context.Context, orderID int, userID int, weig
 		if err != nil {
 			span.SetTag("error", err)
 
-			return err
+			return "", err
 		}
 	}
 
-	return s.txManager.RunRepeatableRead(ctx, func(ctx context.Context, tx pgx.Tx) error {
+	pickupCode, code, err := models.NewPickupCode(orderID)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return "", err
+	}
+
+	err = s.txManager.RunRepeatableRead(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		if ok, err := s.Storage.Contains(ctx, tx, currentOrder.ID); ok {
 			s.logger.Error(ErrOrderAlreadyExists.Error(),
 				zap.Int("order_id", orderID),
@@ -145,6 +183,46 @@ func (s *Service) AcceptOrder(ctx context.Context, orderID int, userID int, weig
 			return err
 		}
 
-		return s.Storage.AddOrder(ctx, tx, currentOrder)
+		if ok, err := s.clients.ContainsClientID(ctx, tx, currentOrder.UserID); err != nil {
+			span.SetTag("error", err)
+
+			return err
+		} else if !ok {
+			s.logger.Error(ErrClientNotFound.Error(),
+				zap.Int("order_id", orderID),
+				zap.Int("user_id", userID),
+				zap.Error(ErrClientNotFound),
+			)
+			span.SetTag("error", ErrClientNotFound)
+
+			return ErrClientNotFound
+		}
+
+		if err := s.Storage.AddOrder(ctx, tx, currentOrder); err != nil {
+			return err
+		}
+
+		if err := s.codes.SetPickupCode(ctx, tx, *pickupCode); err != nil {
+			return err
+		}
+
+		err := s.outbox.CreateNotification(ctx, tx,
+			*models.NewNotification(currentOrder, models.OrderArrivedNotification))
+		if err != nil {
+			return err
+		}
+
+		if err := s.publishEvent(ctx, tx, currentOrder, models.OrderAccepted); err != nil {
+			return err
+		}
+
+		return s.enqueueEvent(ctx, tx, currentOrder, models.OrderAcceptedEvent)
 	})
+	if err != nil {
+		return "", err
+	}
+
+	auditlogger.RecordDiff(ctx, models.NewOrderDiff(nil, &currentOrder))
+
+	return code, nil
 }
@@ -0,0 +1,135 @@
+package order
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+type serviceMocks struct {
+	orders    *MockorderStorage
+	clients   *MockclientStorage
+	codes     *MockpickupCodeStorage
+	outbox    *MocknotificationStorage
+	webhooks  *MockwebhookStorage
+	events    *MockorderEventStorage
+	txManager *MocktxManager
+}
+
+func newServiceMocks(ctrl *gomock.Controller) serviceMocks {
+	return serviceMocks{
+		orders:    NewMockorderStorage(ctrl),
+		clients:   NewMockclientStorage(ctrl),
+		codes:     NewMockpickupCodeStorage(ctrl),
+		outbox:    NewMocknotificationStorage(ctrl),
+		webhooks:  NewMockwebhookStorage(ctrl),
+		events:    NewMockorderEventStorage(ctrl),
+		txManager: NewMocktxManager(ctrl),
+	}
+}
+
+func (m serviceMocks) service() *Service {
+	return NewService(zap.NewNop(), m.orders, m.clients, m.codes, m.outbox, m.webhooks, m.events, m.txManager, nil)
+}
+
+func runInTx(ctx context.Context, f func(context.Context, pgx.Tx) error) error {
+	return f(ctx, nil)
+}
+
+func TestService_AcceptOrder(t *testing.T) {
+	t.Parallel()
+	expiryDate := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		price         *money.Money
+		packagings    []string
+		mockSetup     func(serviceMocks)
+		expectedPrice *money.Money
+		expectedError error
+	}{
+		{
+			name:       "Packaging cost added to price",
+			price:      money.New(10000, money.RUB),
+			packagings: []string{models.BagName, models.WrapName},
+			mockSetup: func(m serviceMocks) {
+				m.txManager.EXPECT().RunRepeatableRead(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+				m.orders.EXPECT().Contains(gomock.Any(), gomock.Any(), 1).Return(false, nil)
+				m.clients.EXPECT().ContainsClientID(gomock.Any(), gomock.Any(), 2).Return(true, nil)
+				m.codes.EXPECT().SetPickupCode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.outbox.EXPECT().CreateNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), models.OrderAcceptedEvent, 1,
+					gomock.Any()).Return(nil)
+			},
+			expectedPrice: money.New(10600, money.RUB),
+		},
+		{
+			name:       "Free packaging in another currency",
+			price:      money.New(10000, money.USD),
+			packagings: []string{models.NoPackagingName, models.NoPackagingName},
+			mockSetup: func(m serviceMocks) {
+				m.txManager.EXPECT().RunRepeatableRead(gomock.Any(), gomock.Any()).DoAndReturn(runInTx)
+				m.orders.EXPECT().Contains(gomock.Any(), gomock.Any(), 1).Return(false, nil)
+				m.clients.EXPECT().ContainsClientID(gomock.Any(), gomock.Any(), 2).Return(true, nil)
+				m.codes.EXPECT().SetPickupCode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.outbox.EXPECT().CreateNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				m.webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), models.OrderAcceptedEvent, 1,
+					gomock.Any()).Return(nil)
+			},
+			expectedPrice: money.New(10000, money.USD),
+		},
+		{
+			name:          "Packaging cost in another currency",
+			price:         money.New(10000, money.USD),
+			packagings:    []string{models.BagName, models.NoPackagingName},
+			mockSetup:     func(_ serviceMocks) {},
+			expectedError: ErrCurrencyMismatch,
+		},
+		{
+			name:          "Box in another currency",
+			price:         money.New(10000, money.EUR),
+			packagings:    []string{models.BoxName, models.WrapName},
+			mockSetup:     func(_ serviceMocks) {},
+			expectedError: ErrCurrencyMismatch,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mocks := newServiceMocks(ctrl)
+			tt.mockSetup(mocks)
+			if tt.expectedPrice != nil {
+				mocks.orders.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ pgx.Tx, order models.Order) error {
+						assert.Equal(t, *tt.expectedPrice, order.Price)
+
+						return nil
+					})
+			}
+
+			packagings := []models.Packaging{
+				models.GetPackaging(tt.packagings[0]),
+				models.GetPackaging(tt.packagings[1]),
+			}
+			code, err := mocks.service().AcceptOrder(t.Context(), 1, 2, 30, *tt.price, expiryDate, packagings)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.NotEmpty(t, code)
+			}
+		})
+	}
+}
@@ -2,17 +2,73 @@ package order
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
 
+const (
+	phoneField  = "phone"
+	userIDField = "user_id"
+)
+
 // GetOrders gets orders that satisfy conditions
 func (s *Service) GetOrders(ctx context.Context, conds []query.Cond, count int, page int) ([]models.Order, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetOrders")
 	defer span.Finish()
 
+	conds, found, err := s.resolvePhoneConds(ctx, conds)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+	if !found {
+		return []models.Order{}, nil
+	}
+
 	return s.Storage.GetOrders(ctx, nil, conds, count, page)
 }
+
+// resolvePhoneConds replaces phone conditions with user_id of the client who owns the phone,
+// found is false when there is no client with such phone, so no orders can match
+func (s *Service) resolvePhoneConds(ctx context.Context, conds []query.Cond) ([]query.Cond, bool, error) {
+	resolved := make([]query.Cond, 0, len(conds))
+	for _, cond := range conds {
+		if cond.Field != phoneField {
+			resolved = append(resolved, cond)
+
+			continue
+		}
+
+		phone, err := models.NormalizePhone(fmt.Sprint(cond.Value))
+		if err != nil {
+			return nil, false, err
+		}
+
+		ok, err := s.clients.ContainsPhone(ctx, phone)
+		if err != nil {
+			return nil, false, err
+		}
+		if !ok {
+			s.logger.Info("no client with such phone",
+				zap.String("phone", phone),
+			)
+
+			return nil, false, nil
+		}
+
+		client, err := s.clients.GetClientByPhone(ctx, phone)
+		if err != nil {
+			return nil, false, err
+		}
+
+		resolved = append(resolved, query.Equal(userIDField, client.ID))
+	}
+
+	return resolved, true, nil
+}
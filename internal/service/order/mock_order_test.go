@@ -0,0 +1,1035 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_order_test.go -package=order
+//
+
+// Package order is a generated GoMock package.
+package order
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	query "gitlab.ozon.dev/alexplay1224/homework/internal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockorderStorage is a mock of orderStorage interface.
+type MockorderStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockorderStorageMockRecorder is the mock recorder for MockorderStorage.
+type MockorderStorageMockRecorder struct {
+	mock *MockorderStorage
+}
+
+// NewMockorderStorage creates a new mock instance.
+func NewMockorderStorage(ctrl *gomock.Controller) *MockorderStorage {
+	mock := &MockorderStorage{ctrl: ctrl}
+	mock.recorder = &MockorderStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderStorage) EXPECT() *MockorderStorageMockRecorder {
+	return m.recorder
+}
+
+// AddOrder mocks base method.
+func (m *MockorderStorage) AddOrder(arg0 context.Context, arg1 pgx.Tx, arg2 models.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrder indicates an expected call of AddOrder.
+func (mr *MockorderStorageMockRecorder) AddOrder(arg0, arg1, arg2 any) *MockorderStorageAddOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockorderStorage)(nil).AddOrder), arg0, arg1, arg2)
+	return &MockorderStorageAddOrderCall{Call: call}
+}
+
+// MockorderStorageAddOrderCall wrap *gomock.Call
+type MockorderStorageAddOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageAddOrderCall) Return(arg0 error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageAddOrderCall) Do(f func(context.Context, pgx.Tx, models.Order) error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageAddOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, models.Order) error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Contains mocks base method.
+func (m *MockorderStorage) Contains(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockorderStorageMockRecorder) Contains(arg0, arg1, arg2 any) *MockorderStorageContainsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockorderStorage)(nil).Contains), arg0, arg1, arg2)
+	return &MockorderStorageContainsCall{Call: call}
+}
+
+// MockorderStorageContainsCall wrap *gomock.Call
+type MockorderStorageContainsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageContainsCall) Return(arg0 bool, arg1 error) *MockorderStorageContainsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageContainsCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockorderStorageContainsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageContainsCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockorderStorageContainsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockorderStorage) GetByID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockorderStorageMockRecorder) GetByID(arg0, arg1, arg2 any) *MockorderStorageGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockorderStorage)(nil).GetByID), arg0, arg1, arg2)
+	return &MockorderStorageGetByIDCall{Call: call}
+}
+
+// MockorderStorageGetByIDCall wrap *gomock.Call
+type MockorderStorageGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetByIDCall) Return(arg0 models.Order, arg1 error) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetByIDCall) Do(f func(context.Context, pgx.Tx, int) (models.Order, error)) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetByIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.Order, error)) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByUserID mocks base method.
+func (m *MockorderStorage) GetByUserID(arg0 context.Context, arg1 pgx.Tx, arg2, arg3 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockorderStorageMockRecorder) GetByUserID(arg0, arg1, arg2, arg3 any) *MockorderStorageGetByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockorderStorage)(nil).GetByUserID), arg0, arg1, arg2, arg3)
+	return &MockorderStorageGetByUserIDCall{Call: call}
+}
+
+// MockorderStorageGetByUserIDCall wrap *gomock.Call
+type MockorderStorageGetByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetByUserIDCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetByUserIDCall) Do(f func(context.Context, pgx.Tx, int, int) ([]models.Order, error)) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetByUserIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int, int) ([]models.Order, error)) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrders mocks base method.
+func (m *MockorderStorage) GetOrders(arg0 context.Context, arg1 pgx.Tx, arg2 []query.Cond, arg3, arg4 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockorderStorageMockRecorder) GetOrders(arg0, arg1, arg2, arg3, arg4 any) *MockorderStorageGetOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockorderStorage)(nil).GetOrders), arg0, arg1, arg2, arg3, arg4)
+	return &MockorderStorageGetOrdersCall{Call: call}
+}
+
+// MockorderStorageGetOrdersCall wrap *gomock.Call
+type MockorderStorageGetOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetOrdersCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetOrdersCall) Do(f func(context.Context, pgx.Tx, []query.Cond, int, int) ([]models.Order, error)) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetOrdersCall) DoAndReturn(f func(context.Context, pgx.Tx, []query.Cond, int, int) ([]models.Order, error)) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReturns mocks base method.
+func (m *MockorderStorage) GetReturns(arg0 context.Context, arg1 pgx.Tx) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturns", arg0, arg1)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturns indicates an expected call of GetReturns.
+func (mr *MockorderStorageMockRecorder) GetReturns(arg0, arg1 any) *MockorderStorageGetReturnsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturns", reflect.TypeOf((*MockorderStorage)(nil).GetReturns), arg0, arg1)
+	return &MockorderStorageGetReturnsCall{Call: call}
+}
+
+// MockorderStorageGetReturnsCall wrap *gomock.Call
+type MockorderStorageGetReturnsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetReturnsCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetReturnsCall) Do(f func(context.Context, pgx.Tx) ([]models.Order, error)) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetReturnsCall) DoAndReturn(f func(context.Context, pgx.Tx) ([]models.Order, error)) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveOrder mocks base method.
+func (m *MockorderStorage) RemoveOrder(arg0 context.Context, arg1 pgx.Tx, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrder indicates an expected call of RemoveOrder.
+func (mr *MockorderStorageMockRecorder) RemoveOrder(arg0, arg1, arg2 any) *MockorderStorageRemoveOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrder", reflect.TypeOf((*MockorderStorage)(nil).RemoveOrder), arg0, arg1, arg2)
+	return &MockorderStorageRemoveOrderCall{Call: call}
+}
+
+// MockorderStorageRemoveOrderCall wrap *gomock.Call
+type MockorderStorageRemoveOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageRemoveOrderCall) Return(arg0 error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageRemoveOrderCall) Do(f func(context.Context, pgx.Tx, int) error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageRemoveOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, int) error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateOrder mocks base method.
+func (m *MockorderStorage) UpdateOrder(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 models.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrder", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrder indicates an expected call of UpdateOrder.
+func (mr *MockorderStorageMockRecorder) UpdateOrder(arg0, arg1, arg2, arg3 any) *MockorderStorageUpdateOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockorderStorage)(nil).UpdateOrder), arg0, arg1, arg2, arg3)
+	return &MockorderStorageUpdateOrderCall{Call: call}
+}
+
+// MockorderStorageUpdateOrderCall wrap *gomock.Call
+type MockorderStorageUpdateOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageUpdateOrderCall) Return(arg0 error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageUpdateOrderCall) Do(f func(context.Context, pgx.Tx, int, models.Order) error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageUpdateOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, int, models.Order) error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockclientStorage is a mock of clientStorage interface.
+type MockclientStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockclientStorageMockRecorder is the mock recorder for MockclientStorage.
+type MockclientStorageMockRecorder struct {
+	mock *MockclientStorage
+}
+
+// NewMockclientStorage creates a new mock instance.
+func NewMockclientStorage(ctrl *gomock.Controller) *MockclientStorage {
+	mock := &MockclientStorage{ctrl: ctrl}
+	mock.recorder = &MockclientStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientStorage) EXPECT() *MockclientStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsClientID mocks base method.
+func (m *MockclientStorage) ContainsClientID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsClientID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsClientID indicates an expected call of ContainsClientID.
+func (mr *MockclientStorageMockRecorder) ContainsClientID(arg0, arg1, arg2 any) *MockclientStorageContainsClientIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsClientID", reflect.TypeOf((*MockclientStorage)(nil).ContainsClientID), arg0, arg1, arg2)
+	return &MockclientStorageContainsClientIDCall{Call: call}
+}
+
+// MockclientStorageContainsClientIDCall wrap *gomock.Call
+type MockclientStorageContainsClientIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsClientIDCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsClientIDCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsClientIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainsPhone mocks base method.
+func (m *MockclientStorage) ContainsPhone(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsPhone", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsPhone indicates an expected call of ContainsPhone.
+func (mr *MockclientStorageMockRecorder) ContainsPhone(arg0, arg1 any) *MockclientStorageContainsPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsPhone", reflect.TypeOf((*MockclientStorage)(nil).ContainsPhone), arg0, arg1)
+	return &MockclientStorageContainsPhoneCall{Call: call}
+}
+
+// MockclientStorageContainsPhoneCall wrap *gomock.Call
+type MockclientStorageContainsPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsPhoneCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsPhoneCall) Do(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsPhoneCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByPhone mocks base method.
+func (m *MockclientStorage) GetClientByPhone(arg0 context.Context, arg1 string) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByPhone", arg0, arg1)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByPhone indicates an expected call of GetClientByPhone.
+func (mr *MockclientStorageMockRecorder) GetClientByPhone(arg0, arg1 any) *MockclientStorageGetClientByPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByPhone", reflect.TypeOf((*MockclientStorage)(nil).GetClientByPhone), arg0, arg1)
+	return &MockclientStorageGetClientByPhoneCall{Call: call}
+}
+
+// MockclientStorageGetClientByPhoneCall wrap *gomock.Call
+type MockclientStorageGetClientByPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageGetClientByPhoneCall) Return(arg0 models.Client, arg1 error) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageGetClientByPhoneCall) Do(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageGetClientByPhoneCall) DoAndReturn(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpickupCodeStorage is a mock of pickupCodeStorage interface.
+type MockpickupCodeStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockpickupCodeStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockpickupCodeStorageMockRecorder is the mock recorder for MockpickupCodeStorage.
+type MockpickupCodeStorageMockRecorder struct {
+	mock *MockpickupCodeStorage
+}
+
+// NewMockpickupCodeStorage creates a new mock instance.
+func NewMockpickupCodeStorage(ctrl *gomock.Controller) *MockpickupCodeStorage {
+	mock := &MockpickupCodeStorage{ctrl: ctrl}
+	mock.recorder = &MockpickupCodeStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpickupCodeStorage) EXPECT() *MockpickupCodeStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsPickupCode mocks base method.
+func (m *MockpickupCodeStorage) ContainsPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsPickupCode indicates an expected call of ContainsPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) ContainsPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageContainsPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).ContainsPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageContainsPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageContainsPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageContainsPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageContainsPickupCodeCall) Return(arg0 bool, arg1 error) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageContainsPickupCodeCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageContainsPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePickupCode mocks base method.
+func (m *MockpickupCodeStorage) DeletePickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupCode indicates an expected call of DeletePickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) DeletePickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageDeletePickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).DeletePickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageDeletePickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageDeletePickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageDeletePickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageDeletePickupCodeCall) Return(arg0 error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageDeletePickupCodeCall) Do(f func(context.Context, pgx.Tx, int) error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageDeletePickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPickupCode mocks base method.
+func (m *MockpickupCodeStorage) GetPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.PickupCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.PickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupCode indicates an expected call of GetPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) GetPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageGetPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).GetPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageGetPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageGetPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageGetPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageGetPickupCodeCall) Return(arg0 models.PickupCode, arg1 error) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageGetPickupCodeCall) Do(f func(context.Context, pgx.Tx, int) (models.PickupCode, error)) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageGetPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.PickupCode, error)) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegisterFailedAttempt mocks base method.
+func (m *MockpickupCodeStorage) RegisterFailedAttempt(arg0 context.Context, arg1 pgx.Tx, arg2, arg3 int, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailedAttempt", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterFailedAttempt indicates an expected call of RegisterFailedAttempt.
+func (mr *MockpickupCodeStorageMockRecorder) RegisterFailedAttempt(arg0, arg1, arg2, arg3, arg4 any) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailedAttempt", reflect.TypeOf((*MockpickupCodeStorage)(nil).RegisterFailedAttempt), arg0, arg1, arg2, arg3, arg4)
+	return &MockpickupCodeStorageRegisterFailedAttemptCall{Call: call}
+}
+
+// MockpickupCodeStorageRegisterFailedAttemptCall wrap *gomock.Call
+type MockpickupCodeStorageRegisterFailedAttemptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) Return(arg0 error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) Do(f func(context.Context, pgx.Tx, int, int, time.Time) error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) DoAndReturn(f func(context.Context, pgx.Tx, int, int, time.Time) error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetPickupCode mocks base method.
+func (m *MockpickupCodeStorage) SetPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 models.PickupCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPickupCode indicates an expected call of SetPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) SetPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageSetPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).SetPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageSetPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageSetPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageSetPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageSetPickupCodeCall) Return(arg0 error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageSetPickupCodeCall) Do(f func(context.Context, pgx.Tx, models.PickupCode) error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageSetPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, models.PickupCode) error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocknotificationStorage is a mock of notificationStorage interface.
+type MocknotificationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocknotificationStorageMockRecorder is the mock recorder for MocknotificationStorage.
+type MocknotificationStorageMockRecorder struct {
+	mock *MocknotificationStorage
+}
+
+// NewMocknotificationStorage creates a new mock instance.
+func NewMocknotificationStorage(ctrl *gomock.Controller) *MocknotificationStorage {
+	mock := &MocknotificationStorage{ctrl: ctrl}
+	mock.recorder = &MocknotificationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationStorage) EXPECT() *MocknotificationStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MocknotificationStorage) CreateNotification(arg0 context.Context, arg1 pgx.Tx, arg2 models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MocknotificationStorageMockRecorder) CreateNotification(arg0, arg1, arg2 any) *MocknotificationStorageCreateNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MocknotificationStorage)(nil).CreateNotification), arg0, arg1, arg2)
+	return &MocknotificationStorageCreateNotificationCall{Call: call}
+}
+
+// MocknotificationStorageCreateNotificationCall wrap *gomock.Call
+type MocknotificationStorageCreateNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageCreateNotificationCall) Return(arg0 error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageCreateNotificationCall) Do(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageCreateNotificationCall) DoAndReturn(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockwebhookStorage is a mock of webhookStorage interface.
+type MockwebhookStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookStorageMockRecorder is the mock recorder for MockwebhookStorage.
+type MockwebhookStorageMockRecorder struct {
+	mock *MockwebhookStorage
+}
+
+// NewMockwebhookStorage creates a new mock instance.
+func NewMockwebhookStorage(ctrl *gomock.Controller) *MockwebhookStorage {
+	mock := &MockwebhookStorage{ctrl: ctrl}
+	mock.recorder = &MockwebhookStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookStorage) EXPECT() *MockwebhookStorageMockRecorder {
+	return m.recorder
+}
+
+// EnqueueEvent mocks base method.
+func (m *MockwebhookStorage) EnqueueEvent(arg0 context.Context, arg1 pgx.Tx, arg2 models.WebhookEvent, arg3 int, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEvent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEvent indicates an expected call of EnqueueEvent.
+func (mr *MockwebhookStorageMockRecorder) EnqueueEvent(arg0, arg1, arg2, arg3, arg4 any) *MockwebhookStorageEnqueueEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEvent", reflect.TypeOf((*MockwebhookStorage)(nil).EnqueueEvent), arg0, arg1, arg2, arg3, arg4)
+	return &MockwebhookStorageEnqueueEventCall{Call: call}
+}
+
+// MockwebhookStorageEnqueueEventCall wrap *gomock.Call
+type MockwebhookStorageEnqueueEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageEnqueueEventCall) Return(arg0 error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageEnqueueEventCall) Do(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageEnqueueEventCall) DoAndReturn(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockorderEventStorage is a mock of orderEventStorage interface.
+type MockorderEventStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderEventStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockorderEventStorageMockRecorder is the mock recorder for MockorderEventStorage.
+type MockorderEventStorageMockRecorder struct {
+	mock *MockorderEventStorage
+}
+
+// NewMockorderEventStorage creates a new mock instance.
+func NewMockorderEventStorage(ctrl *gomock.Controller) *MockorderEventStorage {
+	mock := &MockorderEventStorage{ctrl: ctrl}
+	mock.recorder = &MockorderEventStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderEventStorage) EXPECT() *MockorderEventStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateOrderEvent mocks base method.
+func (m *MockorderEventStorage) CreateOrderEvent(arg0 context.Context, arg1 pgx.Tx, arg2 models.OrderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderEvent indicates an expected call of CreateOrderEvent.
+func (mr *MockorderEventStorageMockRecorder) CreateOrderEvent(arg0, arg1, arg2 any) *MockorderEventStorageCreateOrderEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderEvent", reflect.TypeOf((*MockorderEventStorage)(nil).CreateOrderEvent), arg0, arg1, arg2)
+	return &MockorderEventStorageCreateOrderEventCall{Call: call}
+}
+
+// MockorderEventStorageCreateOrderEventCall wrap *gomock.Call
+type MockorderEventStorageCreateOrderEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderEventStorageCreateOrderEventCall) Return(arg0 error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderEventStorageCreateOrderEventCall) Do(f func(context.Context, pgx.Tx, models.OrderEvent) error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderEventStorageCreateOrderEventCall) DoAndReturn(f func(context.Context, pgx.Tx, models.OrderEvent) error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunReadCommitted mocks base method.
+func (m *MocktxManager) RunReadCommitted(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunReadCommitted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunReadCommitted indicates an expected call of RunReadCommitted.
+func (mr *MocktxManagerMockRecorder) RunReadCommitted(arg0, arg1 any) *MocktxManagerRunReadCommittedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReadCommitted", reflect.TypeOf((*MocktxManager)(nil).RunReadCommitted), arg0, arg1)
+	return &MocktxManagerRunReadCommittedCall{Call: call}
+}
+
+// MocktxManagerRunReadCommittedCall wrap *gomock.Call
+type MocktxManagerRunReadCommittedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunReadCommittedCall) Return(arg0 error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunReadCommittedCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunReadCommittedCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunRepeatableRead mocks base method.
+func (m *MocktxManager) RunRepeatableRead(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRepeatableRead", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunRepeatableRead indicates an expected call of RunRepeatableRead.
+func (mr *MocktxManagerMockRecorder) RunRepeatableRead(arg0, arg1 any) *MocktxManagerRunRepeatableReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRepeatableRead", reflect.TypeOf((*MocktxManager)(nil).RunRepeatableRead), arg0, arg1)
+	return &MocktxManagerRunRepeatableReadCall{Call: call}
+}
+
+// MocktxManagerRunRepeatableReadCall wrap *gomock.Call
+type MocktxManagerRunRepeatableReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunRepeatableReadCall) Return(arg0 error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunRepeatableReadCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunRepeatableReadCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunSerializable mocks base method.
+func (m *MocktxManager) RunSerializable(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSerializable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunSerializable indicates an expected call of RunSerializable.
+func (mr *MocktxManagerMockRecorder) RunSerializable(arg0, arg1 any) *MocktxManagerRunSerializableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSerializable", reflect.TypeOf((*MocktxManager)(nil).RunSerializable), arg0, arg1)
+	return &MocktxManagerRunSerializableCall{Call: call}
+}
+
+// MocktxManagerRunSerializableCall wrap *gomock.Call
+type MocktxManagerRunSerializableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunSerializableCall) Return(arg0 error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunSerializableCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunSerializableCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
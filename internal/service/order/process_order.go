@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/jackc/pgx/v4"
@@ -9,6 +10,7 @@ import (
 	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
 )
 
 func isBeforeDeadline(someOrder models.Order, action string) bool {
@@ -35,9 +37,52 @@ func isOrderEligible(order models.Order, userID int, action string) bool {
 	return order.Status == models.StoredOrder
 }
 
-// ProcessOrder gives/returns order
-func (s *Service) ProcessOrder(ctx context.Context, userID int, orderID int, action string) error {
-	return s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
+func (s *Service) checkPickupCode(ctx context.Context, tx pgx.Tx, orderID int, code string) error {
+	ok, err := s.codes.ContainsPickupCode(ctx, tx, orderID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		s.logger.Error(ErrNoPickupCode.Error(),
+			zap.Int("id", orderID),
+			zap.Error(ErrNoPickupCode),
+		)
+
+		return ErrNoPickupCode
+	}
+
+	pickupCode, err := s.codes.GetPickupCode(ctx, tx, orderID)
+	if err != nil {
+		return err
+	}
+
+	if pickupCode.IsLocked(time.Now()) {
+		s.logger.Error(ErrPickupCodeLocked.Error(),
+			zap.Int("id", orderID),
+			zap.Time("locked_until", pickupCode.LockedUntil),
+			zap.Error(ErrPickupCodeLocked),
+		)
+
+		return ErrPickupCodeLocked
+	}
+
+	if !pickupCode.CheckCode(code) {
+		s.logger.Error(ErrWrongPickupCode.Error(),
+			zap.Int("id", orderID),
+			zap.Int("failed_attempts", pickupCode.FailedAttempts+1),
+			zap.Error(ErrWrongPickupCode),
+		)
+
+		return ErrWrongPickupCode
+	}
+
+	return s.codes.DeletePickupCode(ctx, tx, orderID)
+}
+
+// ProcessOrder gives/returns order, giving requires the one-time pickup code issued at acceptance
+func (s *Service) ProcessOrder(ctx context.Context, userID int, orderID int, action string, code string) error {
+	var diff models.Diff
+	err := s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		span, ctx := opentracing.StartSpanFromContext(ctx, "service.ProcessOrder")
 		defer span.Finish()
 
@@ -72,11 +117,26 @@ func (s *Service) ProcessOrder(ctx context.Context, userID int, orderID int, act
 			return ErrOrderNotEligible
 		}
 
+		before := someOrder
+		var kind models.NotificationKind
+		var event models.WebhookEvent
+		var eventType models.OrderEventType
 		switch action {
 		case giveOrder:
+			if err = s.checkPickupCode(ctx, tx, orderID, code); err != nil {
+				span.SetTag("error", err)
+
+				return err
+			}
 			someOrder.Status = models.GivenOrder
+			kind = models.OrderGivenNotification
+			event = models.OrderGivenEvent
+			eventType = models.OrderGiven
 		case returnOrder:
 			someOrder.Status = models.ReturnedOrder
+			kind = models.OrderReturnedNotification
+			event = models.OrderReturnedEvent
+			eventType = models.OrderReturned
 		default:
 			s.logger.Error(ErrUndefinedAction.Error(),
 				zap.String("action", action),
@@ -89,6 +149,32 @@ func (s *Service) ProcessOrder(ctx context.Context, userID int, orderID int, act
 
 		someOrder.LastChange = time.Now()
 
-		return s.Storage.UpdateOrder(ctx, tx, orderID, someOrder)
+		if err = s.Storage.UpdateOrder(ctx, tx, orderID, someOrder); err != nil {
+			return err
+		}
+		diff = models.NewOrderDiff(&before, &someOrder)
+
+		if err = s.outbox.CreateNotification(ctx, tx, *models.NewNotification(someOrder, kind)); err != nil {
+			return err
+		}
+
+		if err = s.publishEvent(ctx, tx, someOrder, eventType); err != nil {
+			return err
+		}
+
+		return s.enqueueEvent(ctx, tx, someOrder, event)
 	})
+
+	// failed attempt is saved outside the transaction, since the transaction is rolled back on error
+	if errors.Is(err, ErrWrongPickupCode) {
+		if attemptErr := s.codes.RegisterFailedAttempt(ctx, nil, orderID, maxPickupAttempts,
+			time.Now().Add(pickupLockout)); attemptErr != nil {
+			return attemptErr
+		}
+	}
+	if err == nil {
+		auditlogger.RecordDiff(ctx, diff)
+	}
+
+	return err
 }
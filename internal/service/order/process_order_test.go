@@ -0,0 +1,197 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Rhymond/go-money"
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+var errNoPickupCode = errors.New("no pickup code")
+
+// memoryPickupCodes keeps pickup codes like the postgres repo does, failed attempts start over
+// once the code is locked
+type memoryPickupCodes struct {
+	mu    sync.Mutex
+	codes map[int]models.PickupCode
+}
+
+func (m *memoryPickupCodes) SetPickupCode(_ context.Context, _ pgx.Tx, code models.PickupCode) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.codes[code.OrderID] = code
+
+	return nil
+}
+
+func (m *memoryPickupCodes) GetPickupCode(_ context.Context, _ pgx.Tx, orderID int) (models.PickupCode, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	code, ok := m.codes[orderID]
+	if !ok {
+		return models.PickupCode{}, errNoPickupCode
+	}
+
+	return code, nil
+}
+
+func (m *memoryPickupCodes) ContainsPickupCode(_ context.Context, _ pgx.Tx, orderID int) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	_, ok := m.codes[orderID]
+
+	return ok, nil
+}
+
+func (m *memoryPickupCodes) RegisterFailedAttempt(_ context.Context, _ pgx.Tx, orderID int, maxAttempts int,
+	lockedUntil time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	code, ok := m.codes[orderID]
+	if !ok {
+		return errNoPickupCode
+	}
+
+	code.FailedAttempts++
+	if code.FailedAttempts >= maxAttempts {
+		code.FailedAttempts = 0
+		code.LockedUntil = lockedUntil
+	}
+	m.codes[orderID] = code
+
+	return nil
+}
+
+func (m *memoryPickupCodes) DeletePickupCode(_ context.Context, _ pgx.Tx, orderID int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.codes, orderID)
+
+	return nil
+}
+
+func TestService_ProcessOrder_PickupCode(t *testing.T) {
+	t.Parallel()
+	const wrongCode = "wrong"
+	order := *models.NewOrder(1, 2, 30, *money.New(10000, money.RUB), models.StoredOrder,
+		time.Now(), time.Now().Add(24*time.Hour), time.Now())
+
+	tests := []struct {
+		name           string
+		issued         bool
+		attempts       []string
+		expectedErrors []error
+		// expectedFailed is a number of failed attempts left in the code, -1 if the code is deleted
+		expectedFailed int
+		expectedLocked bool
+	}{
+		{
+			name:           "Correct code deletes it",
+			issued:         true,
+			attempts:       []string{""},
+			expectedErrors: []error{nil},
+			expectedFailed: -1,
+		},
+		{
+			name:           "Wrong codes are counted",
+			issued:         true,
+			attempts:       []string{wrongCode, wrongCode},
+			expectedErrors: []error{ErrWrongPickupCode, ErrWrongPickupCode},
+			expectedFailed: 2,
+		},
+		{
+			name:           "Correct code after wrong ones",
+			issued:         true,
+			attempts:       []string{wrongCode, wrongCode, ""},
+			expectedErrors: []error{ErrWrongPickupCode, ErrWrongPickupCode, nil},
+			expectedFailed: -1,
+		},
+		{
+			name:     "Locked after max attempts",
+			issued:   true,
+			attempts: []string{wrongCode, wrongCode, wrongCode, wrongCode, wrongCode, ""},
+			expectedErrors: []error{ErrWrongPickupCode, ErrWrongPickupCode, ErrWrongPickupCode,
+				ErrWrongPickupCode, ErrWrongPickupCode, ErrPickupCodeLocked},
+			expectedFailed: 0,
+			expectedLocked: true,
+		},
+		{
+			name:           "Code isn't issued",
+			attempts:       []string{""},
+			expectedErrors: []error{ErrNoPickupCode},
+			expectedFailed: -1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			mocks := newServiceMocks(ctrl)
+			mocks.txManager.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).DoAndReturn(runInTx).AnyTimes()
+			mocks.orders.EXPECT().Contains(gomock.Any(), gomock.Any(), order.ID).Return(true, nil).AnyTimes()
+			mocks.orders.EXPECT().GetByID(gomock.Any(), gomock.Any(), order.ID).Return(order, nil).AnyTimes()
+			mocks.orders.EXPECT().UpdateOrder(gomock.Any(), gomock.Any(), order.ID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, _ int, updated models.Order) error {
+					assert.Equal(t, models.GivenOrder, updated.Status)
+
+					return nil
+				}).AnyTimes()
+			mocks.outbox.EXPECT().CreateNotification(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).AnyTimes()
+			mocks.events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mocks.webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), models.OrderGivenEvent, order.ID,
+				gomock.Any()).Return(nil).AnyTimes()
+
+			codes := &memoryPickupCodes{codes: make(map[int]models.PickupCode)}
+			var code string
+			if tt.issued {
+				pickupCode, issuedCode, err := models.NewPickupCode(order.ID)
+				require.NoError(t, err)
+				require.NoError(t, codes.SetPickupCode(t.Context(), nil, *pickupCode))
+				code = issuedCode
+			}
+
+			service := NewService(zap.NewNop(), mocks.orders, mocks.clients, codes, mocks.outbox, mocks.webhooks,
+				mocks.events, mocks.txManager, nil)
+
+			for i, attempt := range tt.attempts {
+				if attempt == "" {
+					attempt = code
+				}
+
+				err := service.ProcessOrder(t.Context(), order.UserID, order.ID, giveOrder, attempt)
+				assert.ErrorIs(t, err, tt.expectedErrors[i], "attempt %d", i+1)
+			}
+
+			stored, ok := codes.codes[order.ID]
+			if tt.expectedFailed < 0 {
+				assert.False(t, ok)
+
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tt.expectedFailed, stored.FailedAttempts)
+			assert.Equal(t, tt.expectedLocked, stored.IsLocked(time.Now()))
+			if tt.expectedLocked {
+				assert.WithinDuration(t, time.Now().Add(pickupLockout), stored.LockedUntil, time.Minute)
+			}
+		})
+	}
+}
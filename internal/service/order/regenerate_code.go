@@ -0,0 +1,65 @@
+package order
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// RegenerateCode issues new pickup code for a stored order, the previous code and failed attempts are discarded
+func (s *Service) RegenerateCode(ctx context.Context, orderID int) (string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.RegenerateCode")
+	defer span.Finish()
+
+	pickupCode, code, err := models.NewPickupCode(orderID)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return "", err
+	}
+
+	err = s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if ok, err := s.Storage.Contains(ctx, tx, orderID); err != nil || !ok {
+			s.logger.Error(ErrOrderNotFound.Error(),
+				zap.Int("id", orderID),
+				zap.Error(ErrOrderNotFound),
+			)
+			span.SetTag("error", ErrOrderNotFound)
+
+			return ErrOrderNotFound
+		}
+
+		someOrder, err := s.Storage.GetByID(ctx, tx, orderID)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		if someOrder.Status != models.StoredOrder {
+			s.logger.Error(ErrOrderNotEligible.Error(),
+				zap.Int("id", orderID),
+				zap.Int("status", int(someOrder.Status)),
+				zap.Error(ErrOrderNotEligible),
+			)
+			span.SetTag("error", ErrOrderNotEligible)
+
+			return ErrOrderNotEligible
+		}
+
+		return s.codes.SetPickupCode(ctx, tx, *pickupCode)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	s.logger.Info("pickup code regenerated",
+		zap.Int("id", orderID),
+	)
+
+	return code, nil
+}
@@ -9,11 +9,13 @@ import (
 	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
 )
 
 // ReturnOrder returns order by id
 func (s *Service) ReturnOrder(ctx context.Context, orderID int) error {
-	return s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
+	var deleted models.Order
+	err := s.txManager.RunSerializable(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		span, ctx := opentracing.StartSpanFromContext(ctx, "service.ReturnOrder")
 		defer span.Finish()
 
@@ -54,6 +56,28 @@ func (s *Service) ReturnOrder(ctx context.Context, orderID int) error {
 			return ErrOrderIsNotExpired
 		}
 
-		return s.Storage.RemoveOrder(ctx, tx, orderID)
+		if err = s.publishEvent(ctx, tx, someOrder, models.OrderReturnedToCourier); err != nil {
+			return err
+		}
+
+		if err = s.Storage.RemoveOrder(ctx, tx, orderID); err != nil {
+			return err
+		}
+
+		deleted = someOrder
+		someOrder.Status = models.DeletedOrder
+
+		if err = s.publishEvent(ctx, tx, someOrder, models.OrderDeleted); err != nil {
+			return err
+		}
+
+		return s.enqueueEvent(ctx, tx, someOrder, models.OrderDeletedEvent)
 	})
+	if err != nil {
+		return err
+	}
+
+	auditlogger.RecordDiff(ctx, models.NewOrderDiff(&deleted, nil))
+
+	return nil
 }
@@ -1,12 +1,16 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_order_test.go -package=order
+
 package order
 
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
@@ -14,6 +18,9 @@ import (
 const (
 	giveOrder   = "give"
 	returnOrder = "return"
+
+	maxPickupAttempts = 5
+	pickupLockout     = 15 * time.Minute
 )
 
 var (
@@ -49,6 +56,24 @@ var (
 
 	// ErrWrongPackaging happens when packaging is wrong
 	ErrWrongPackaging = errors.New("wrong packaging")
+
+	// ErrUnknownCurrency happens when order price has unknown currency
+	ErrUnknownCurrency = errors.New("unknown currency")
+
+	// ErrCurrencyMismatch happens when packaging cost and order price have different currencies
+	ErrCurrencyMismatch = errors.New("packaging cost currency doesn't match order currency")
+
+	// ErrClientNotFound happens when order recipient is not registered
+	ErrClientNotFound = errors.New("client not found")
+
+	// ErrWrongPickupCode happens when pickup code doesn't match
+	ErrWrongPickupCode = errors.New("wrong pickup code")
+
+	// ErrPickupCodeLocked happens when there were too many failed attempts to enter pickup code
+	ErrPickupCodeLocked = errors.New("too many failed attempts, pickup code is locked")
+
+	// ErrNoPickupCode happens when pickup code wasn't issued for an order
+	ErrNoPickupCode = errors.New("pickup code wasn't issued, regenerate it")
 )
 
 type orderStorage interface {
@@ -62,6 +87,32 @@ type orderStorage interface {
 	Contains(context.Context, pgx.Tx, int) (bool, error)
 }
 
+type clientStorage interface {
+	GetClientByPhone(context.Context, string) (models.Client, error)
+	ContainsPhone(context.Context, string) (bool, error)
+	ContainsClientID(context.Context, pgx.Tx, int) (bool, error)
+}
+
+type pickupCodeStorage interface {
+	SetPickupCode(context.Context, pgx.Tx, models.PickupCode) error
+	GetPickupCode(context.Context, pgx.Tx, int) (models.PickupCode, error)
+	ContainsPickupCode(context.Context, pgx.Tx, int) (bool, error)
+	RegisterFailedAttempt(context.Context, pgx.Tx, int, int, time.Time) error
+	DeletePickupCode(context.Context, pgx.Tx, int) error
+}
+
+type notificationStorage interface {
+	CreateNotification(context.Context, pgx.Tx, models.Notification) error
+}
+
+type webhookStorage interface {
+	EnqueueEvent(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error
+}
+
+type orderEventStorage interface {
+	CreateOrderEvent(context.Context, pgx.Tx, models.OrderEvent) error
+}
+
 type txManager interface {
 	RunSerializable(context.Context, func(context.Context, pgx.Tx) error) error
 	RunRepeatableRead(context.Context, func(context.Context, pgx.Tx) error) error
@@ -71,15 +122,50 @@ type txManager interface {
 // Service is a structure for order service
 type Service struct {
 	Storage   orderStorage
+	clients   clientStorage
+	codes     pickupCodeStorage
+	outbox    notificationStorage
+	webhooks  webhookStorage
+	events    orderEventStorage
 	txManager txManager
+	converter *currency.Converter
 	logger    *zap.Logger
 }
 
 // NewService creates instance of an order Service
-func NewService(logger *zap.Logger, storage orderStorage, txManager txManager) *Service {
+func NewService(logger *zap.Logger, storage orderStorage, clients clientStorage, codes pickupCodeStorage,
+	outbox notificationStorage, webhooks webhookStorage, events orderEventStorage, txManager txManager,
+	converter *currency.Converter) *Service {
 	return &Service{
 		Storage:   storage,
+		clients:   clients,
+		codes:     codes,
+		outbox:    outbox,
+		webhooks:  webhooks,
+		events:    events,
 		txManager: txManager,
+		converter: converter,
 		logger:    logger,
 	}
 }
+
+// enqueueEvent enqueues order event for webhook subscribers in the same transaction as the change
+func (s *Service) enqueueEvent(ctx context.Context, tx pgx.Tx, order models.Order, event models.WebhookEvent) error {
+	payload, err := models.NewWebhookPayload(order, event)
+	if err != nil {
+		return err
+	}
+
+	return s.webhooks.EnqueueEvent(ctx, tx, event, order.ID, payload)
+}
+
+// publishEvent saves order domain event to the outbox in the same transaction as the change
+func (s *Service) publishEvent(ctx context.Context, tx pgx.Tx, order models.Order,
+	eventType models.OrderEventType) error {
+	event, err := models.NewOrderEvent(order, eventType)
+	if err != nil {
+		return err
+	}
+
+	return s.events.CreateOrderEvent(ctx, tx, *event)
+}
@@ -0,0 +1,28 @@
+package order
+
+import (
+	"github.com/Rhymond/go-money"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// TotalPrice sums up prices of orders in base currency
+func (s *Service) TotalPrice(orders []models.Order) (*money.Money, error) {
+	prices := make([]*money.Money, 0, len(orders))
+	for i := range orders {
+		prices = append(prices, &orders[i].Price)
+	}
+
+	total, err := s.converter.Total(prices...)
+	if err != nil {
+		s.logger.Error("failed to count total price",
+			zap.String("base_currency", s.converter.Base()),
+			zap.Error(err),
+		)
+
+		return nil, err
+	}
+
+	return total, nil
+}
@@ -0,0 +1,86 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/url"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// CreateSubscription creates webhook subscription, secret is generated if it's empty.
+// Returned subscription contains the secret, it's the only time it is shown
+func (s *Service) CreateSubscription(ctx context.Context, rawURL string, secret string,
+	events []string) (models.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.CreateSubscription")
+	defer span.Finish()
+
+	parsedURL, err := url.ParseRequestURI(rawURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		s.logger.Error(ErrWrongURL.Error(),
+			zap.String("url", rawURL),
+			zap.Error(ErrWrongURL),
+		)
+		span.SetTag("error", ErrWrongURL)
+
+		return models.WebhookSubscription{}, ErrWrongURL
+	}
+
+	if len(events) == 0 {
+		span.SetTag("error", ErrNoEvents)
+
+		return models.WebhookSubscription{}, ErrNoEvents
+	}
+
+	subscription := models.WebhookSubscription{
+		URL:       rawURL,
+		Secret:    secret,
+		Events:    make([]models.WebhookEvent, 0, len(events)),
+		CreatedAt: time.Now(),
+	}
+	for _, event := range events {
+		parsedEvent, err := models.ParseWebhookEvent(event)
+		if err != nil {
+			s.logger.Error(err.Error(),
+				zap.String("event", event),
+				zap.Error(err),
+			)
+			span.SetTag("error", err)
+
+			return models.WebhookSubscription{}, err
+		}
+		subscription.Events = append(subscription.Events, parsedEvent)
+	}
+
+	if subscription.Secret == "" {
+		subscription.Secret, err = generateSecret()
+		if err != nil {
+			span.SetTag("error", err)
+
+			return models.WebhookSubscription{}, err
+		}
+	}
+
+	subscription.ID, err = s.storage.CreateSubscription(ctx, subscription)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return models.WebhookSubscription{}, err
+	}
+
+	return subscription, nil
+}
+
+func generateSecret() (string, error) {
+	secret := make([]byte, secretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,102 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_CreateSubscription(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		url           string
+		secret        string
+		events        []string
+		mockSetup     func(*MockwebhookStorage)
+		expectedError error
+	}{
+		{
+			name:   "Valid subscription",
+			url:    "https://partner.example.com/hooks",
+			secret: "secret",
+			events: []string{"order.accepted", "order.expired"},
+			mockSetup: func(storage *MockwebhookStorage) {
+				storage.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, subscription models.WebhookSubscription) (int, error) {
+						assert.Equal(t, "secret", subscription.Secret)
+						assert.Equal(t, []models.WebhookEvent{models.OrderAcceptedEvent, models.OrderExpiredEvent},
+							subscription.Events)
+
+						return 1, nil
+					}).Times(1)
+			},
+		},
+		{
+			name:   "Generated secret",
+			url:    "http://localhost:8080",
+			events: []string{"order.given"},
+			mockSetup: func(storage *MockwebhookStorage) {
+				storage.EXPECT().CreateSubscription(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, subscription models.WebhookSubscription) (int, error) {
+						assert.Len(t, subscription.Secret, 2*secretBytes)
+
+						return 1, nil
+					}).Times(1)
+			},
+		},
+		{
+			name:          "Wrong scheme",
+			url:           "ftp://partner.example.com",
+			events:        []string{"order.given"},
+			mockSetup:     func(_ *MockwebhookStorage) {},
+			expectedError: ErrWrongURL,
+		},
+		{
+			name:          "Relative url",
+			url:           "/hooks",
+			events:        []string{"order.given"},
+			mockSetup:     func(_ *MockwebhookStorage) {},
+			expectedError: ErrWrongURL,
+		},
+		{
+			name:          "No events",
+			url:           "https://partner.example.com/hooks",
+			mockSetup:     func(_ *MockwebhookStorage) {},
+			expectedError: ErrNoEvents,
+		},
+		{
+			name:          "Unknown event",
+			url:           "https://partner.example.com/hooks",
+			events:        []string{"order.lost"},
+			mockSetup:     func(_ *MockwebhookStorage) {},
+			expectedError: models.ErrUnknownWebhookEvent,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMockwebhookStorage(ctrl)
+			tt.mockSetup(storage)
+
+			service := NewService(zap.NewNop(), storage)
+
+			subscription, err := service.CreateSubscription(t.Context(), tt.url, tt.secret, tt.events)
+
+			assert.ErrorIs(t, err, tt.expectedError)
+			if tt.expectedError == nil {
+				assert.Equal(t, 1, subscription.ID)
+				assert.NotEmpty(t, subscription.Secret)
+			}
+		})
+	}
+}
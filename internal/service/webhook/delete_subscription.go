@@ -0,0 +1,32 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+)
+
+// DeleteSubscription deletes webhook subscription and its delivery log
+func (s *Service) DeleteSubscription(ctx context.Context, id int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.DeleteSubscription")
+	defer span.Finish()
+
+	ok, err := s.storage.ContainsSubscription(ctx, id)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if !ok {
+		s.logger.Error(ErrSubscriptionNotFound.Error(),
+			zap.Int("id", id),
+			zap.Error(ErrSubscriptionNotFound),
+		)
+		span.SetTag("error", ErrSubscriptionNotFound)
+
+		return ErrSubscriptionNotFound
+	}
+
+	return s.storage.DeleteSubscription(ctx, id)
+}
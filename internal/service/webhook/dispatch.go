@@ -0,0 +1,122 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/retry"
+)
+
+const (
+	// EventHeader is a header with event type
+	EventHeader = "X-Webhook-Event"
+
+	// DeliveryHeader is a header with delivery id, it is the same for every attempt
+	DeliveryHeader = "X-Webhook-Delivery"
+
+	// SignatureHeader is a header with HMAC-SHA256 signature of a body
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+// Dispatch delivers a batch of events, failed deliveries are retried with exponential backoff
+// and are dead-lettered when no attempts are left
+func (d *Dispatcher) Dispatch(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.Dispatch")
+	defer span.Finish()
+
+	deliveries, err := d.storage.GetAndMarkDeliveries(ctx, d.batchSize)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	subscriptions, err := d.storage.GetSubscriptions(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	subscriptionByID := make(map[int]models.WebhookSubscription, len(subscriptions))
+	for _, subscription := range subscriptions {
+		subscriptionByID[subscription.ID] = subscription
+	}
+
+	for _, delivery := range deliveries {
+		subscription, ok := subscriptionByID[delivery.SubscriptionID]
+		if !ok {
+			// subscription was deleted along with its deliveries after they were fetched
+			continue
+		}
+
+		delivery.ResponseStatus, err = d.send(ctx, subscription, delivery)
+		if err != nil {
+			d.logger.Error("failed to deliver webhook",
+				zap.Int("id", delivery.ID),
+				zap.Int("subscription_id", delivery.SubscriptionID),
+				zap.String("event", string(delivery.Event)),
+				zap.Int("attempts_left", delivery.AttemptsLeft-1),
+				zap.Error(err),
+			)
+
+			delivery.LastError = err.Error()
+			delivery.AttemptsLeft--
+			if delivery.AttemptsLeft <= 0 {
+				delivery.JobStatus = models.NoAttemptsLeftStatus
+			} else {
+				delivery.JobStatus = models.FailedStatus
+				delivery.NextAttemptAt = time.Now().Add(retry.Backoff(baseRetryDelay, maxRetryDelay,
+					maxAttempts-delivery.AttemptsLeft))
+			}
+		} else {
+			delivery.LastError = ""
+			delivery.JobStatus = models.DoneStatus
+		}
+
+		if err = d.storage.UpdateDelivery(ctx, delivery); err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) send(ctx context.Context, subscription models.WebhookSubscription,
+	delivery models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, string(delivery.Event))
+	req.Header.Set(DeliveryHeader, strconv.Itoa(delivery.ID))
+	req.Header.Set(SignatureHeader, models.SignWebhookPayload(subscription.Secret, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+
+	return resp.StatusCode, nil
+}
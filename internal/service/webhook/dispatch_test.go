@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestService_Dispatch(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name                 string
+		responseStatus       int
+		attemptsLeft         int
+		expectedStatus       int
+		expectedAttemptsLeft int
+		expectedDelay        time.Duration
+	}{
+		{
+			name:                 "Delivered",
+			responseStatus:       http.StatusNoContent,
+			attemptsLeft:         maxAttempts,
+			expectedStatus:       models.DoneStatus,
+			expectedAttemptsLeft: maxAttempts,
+		},
+		{
+			name:                 "Retried with backoff",
+			responseStatus:       http.StatusServiceUnavailable,
+			attemptsLeft:         maxAttempts - 1,
+			expectedStatus:       models.FailedStatus,
+			expectedAttemptsLeft: maxAttempts - 2,
+			expectedDelay:        2 * baseRetryDelay,
+		},
+		{
+			name:                 "Dead-lettered",
+			responseStatus:       http.StatusBadRequest,
+			attemptsLeft:         1,
+			expectedStatus:       models.NoAttemptsLeftStatus,
+			expectedAttemptsLeft: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			payload := []byte(`{"event":"order.accepted","order_id":123}`)
+			secret := "secret"
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.Equal(t, payload, body)
+				assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+				assert.Equal(t, string(models.OrderAcceptedEvent), r.Header.Get(EventHeader))
+				assert.Equal(t, "7", r.Header.Get(DeliveryHeader))
+				assert.Equal(t, models.SignWebhookPayload(secret, body), r.Header.Get(SignatureHeader))
+
+				w.WriteHeader(tt.responseStatus)
+			}))
+			defer server.Close()
+
+			storage := NewMockwebhookStorage(ctrl)
+			delivery := models.WebhookDelivery{
+				ID:             7,
+				SubscriptionID: 1,
+				Event:          models.OrderAcceptedEvent,
+				OrderID:        123,
+				Payload:        payload,
+				AttemptsLeft:   tt.attemptsLeft,
+				NextAttemptAt:  time.Now(),
+			}
+
+			storage.EXPECT().GetAndMarkDeliveries(gomock.Any(), 5).
+				Return([]models.WebhookDelivery{delivery}, nil).Times(1)
+			storage.EXPECT().GetSubscriptions(gomock.Any()).Return([]models.WebhookSubscription{{
+				ID:     1,
+				URL:    server.URL,
+				Secret: secret,
+				Events: []models.WebhookEvent{models.OrderAcceptedEvent},
+			}}, nil).Times(1)
+
+			before := time.Now()
+			storage.EXPECT().UpdateDelivery(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, updated models.WebhookDelivery) error {
+					assert.Equal(t, tt.expectedStatus, updated.JobStatus)
+					assert.Equal(t, tt.expectedAttemptsLeft, updated.AttemptsLeft)
+					assert.Equal(t, tt.responseStatus, updated.ResponseStatus)
+
+					if tt.expectedStatus == models.DoneStatus {
+						assert.Empty(t, updated.LastError)
+					} else {
+						assert.NotEmpty(t, updated.LastError)
+					}
+
+					if tt.expectedStatus == models.FailedStatus {
+						assert.WithinDuration(t, before.Add(tt.expectedDelay), updated.NextAttemptAt, time.Second)
+					}
+
+					return nil
+				}).Times(1)
+
+			dispatcher := NewDispatcher(zap.NewNop(), storage, 5, time.Second)
+
+			assert.NoError(t, dispatcher.Dispatch(t.Context()))
+		})
+	}
+}
+
+func TestService_DispatchDeletedSubscription(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storage := NewMockwebhookStorage(ctrl)
+	storage.EXPECT().GetAndMarkDeliveries(gomock.Any(), 5).
+		Return([]models.WebhookDelivery{{ID: 1, SubscriptionID: 2}}, nil).Times(1)
+	storage.EXPECT().GetSubscriptions(gomock.Any()).Return(nil, nil).Times(1)
+
+	dispatcher := NewDispatcher(zap.NewNop(), storage, 5, time.Second)
+
+	assert.NoError(t, dispatcher.Dispatch(t.Context()))
+}
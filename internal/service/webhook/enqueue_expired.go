@@ -0,0 +1,54 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// EnqueueExpired enqueues expiry events for stored orders expired in the last 24 hours, it pages through
+// expired orders until every order is enqueued, enqueued orders don't match anymore, so every page
+// starts from the beginning of the window
+func (d *Dispatcher) EnqueueExpired(ctx context.Context) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.EnqueueExpired")
+	defer span.Finish()
+
+	now := time.Now()
+	for {
+		orders, err := d.storage.GetExpiredOrders(ctx, now.Add(-expiredLookback), now, d.batchSize)
+		if err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		if err = d.enqueueExpired(ctx, orders); err != nil {
+			span.SetTag("error", err)
+
+			return err
+		}
+
+		if len(orders) == 0 || len(orders) < d.batchSize {
+			return nil
+		}
+	}
+}
+
+func (d *Dispatcher) enqueueExpired(ctx context.Context, orders []models.Order) error {
+	for _, order := range orders {
+		payload, err := models.NewWebhookPayload(order, models.OrderExpiredEvent)
+		if err != nil {
+			return err
+		}
+
+		err = d.storage.EnqueueEvent(ctx, nil, models.OrderExpiredEvent, order.ID, payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+func TestDispatcher_EnqueueExpired(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		orders        int
+		expectedPages int
+	}{
+		{
+			name:          "No expired orders",
+			expectedPages: 1,
+		},
+		{
+			name:          "Less than batch",
+			orders:        3,
+			expectedPages: 1,
+		},
+		{
+			name:          "Exactly batch",
+			orders:        5,
+			expectedPages: 2,
+		},
+		{
+			name:          "More than batch",
+			orders:        12,
+			expectedPages: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storage := NewMockwebhookStorage(ctrl)
+
+			orders := make([]models.Order, 0, tt.orders)
+			for id := 1; id <= tt.orders; id++ {
+				orders = append(orders, models.Order{ID: id, ExpiryDate: time.Now().Add(-time.Hour)})
+			}
+			enqueued := make(map[int]bool)
+
+			storage.EXPECT().GetExpiredOrders(gomock.Any(), gomock.Any(), gomock.Any(), 5).
+				DoAndReturn(func(_ context.Context, _ time.Time, _ time.Time, count int) ([]models.Order, error) {
+					page := make([]models.Order, 0, count)
+					for _, order := range orders {
+						if !enqueued[order.ID] && len(page) < count {
+							page = append(page, order)
+						}
+					}
+
+					return page, nil
+				}).Times(tt.expectedPages)
+			storage.EXPECT().EnqueueEvent(gomock.Any(), gomock.Nil(), models.OrderExpiredEvent, gomock.Any(),
+				gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ pgx.Tx, _ models.WebhookEvent, orderID int, _ []byte) error {
+					enqueued[orderID] = true
+
+					return nil
+				}).Times(tt.orders)
+
+			dispatcher := NewDispatcher(zap.NewNop(), storage, 5, time.Second)
+
+			assert.NoError(t, dispatcher.EnqueueExpired(t.Context()))
+			assert.Len(t, enqueued, tt.orders)
+		})
+	}
+}
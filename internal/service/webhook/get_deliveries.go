@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+// GetDeliveries gets delivery log, subscriptionID and jobStatus are ignored if they're zero
+func (s *Service) GetDeliveries(ctx context.Context, subscriptionID int, jobStatus int, count int,
+	page int) ([]models.WebhookDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetDeliveries")
+	defer span.Finish()
+
+	conds := make([]query.Cond, 0, 2)
+	if subscriptionID != 0 {
+		conds = append(conds, query.Equal("subscription_id", subscriptionID))
+	}
+	if jobStatus != 0 {
+		conds = append(conds, query.Equal("job_status", jobStatus))
+	}
+
+	deliveries, err := s.storage.GetDeliveries(ctx, conds, count, page)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+
+	return deliveries, nil
+}
@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// GetSubscriptions gets all webhook subscriptions
+func (s *Service) GetSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "service.GetSubscriptions")
+	defer span.Finish()
+
+	subscriptions, err := s.storage.GetSubscriptions(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
@@ -0,0 +1,393 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=service.go -destination=mock_webhook_test.go -package=webhook
+//
+
+// Package webhook is a generated GoMock package.
+package webhook
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	query "gitlab.ozon.dev/alexplay1224/homework/internal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockwebhookStorage is a mock of webhookStorage interface.
+type MockwebhookStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookStorageMockRecorder is the mock recorder for MockwebhookStorage.
+type MockwebhookStorageMockRecorder struct {
+	mock *MockwebhookStorage
+}
+
+// NewMockwebhookStorage creates a new mock instance.
+func NewMockwebhookStorage(ctrl *gomock.Controller) *MockwebhookStorage {
+	mock := &MockwebhookStorage{ctrl: ctrl}
+	mock.recorder = &MockwebhookStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookStorage) EXPECT() *MockwebhookStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsSubscription mocks base method.
+func (m *MockwebhookStorage) ContainsSubscription(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsSubscription", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsSubscription indicates an expected call of ContainsSubscription.
+func (mr *MockwebhookStorageMockRecorder) ContainsSubscription(arg0, arg1 any) *MockwebhookStorageContainsSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).ContainsSubscription), arg0, arg1)
+	return &MockwebhookStorageContainsSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageContainsSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageContainsSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageContainsSubscriptionCall) Return(arg0 bool, arg1 error) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageContainsSubscriptionCall) Do(f func(context.Context, int) (bool, error)) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageContainsSubscriptionCall) DoAndReturn(f func(context.Context, int) (bool, error)) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSubscription mocks base method.
+func (m *MockwebhookStorage) CreateSubscription(arg0 context.Context, arg1 models.WebhookSubscription) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockwebhookStorageMockRecorder) CreateSubscription(arg0, arg1 any) *MockwebhookStorageCreateSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).CreateSubscription), arg0, arg1)
+	return &MockwebhookStorageCreateSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageCreateSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageCreateSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageCreateSubscriptionCall) Return(arg0 int, arg1 error) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageCreateSubscriptionCall) Do(f func(context.Context, models.WebhookSubscription) (int, error)) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageCreateSubscriptionCall) DoAndReturn(f func(context.Context, models.WebhookSubscription) (int, error)) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockwebhookStorage) DeleteSubscription(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockwebhookStorageMockRecorder) DeleteSubscription(arg0, arg1 any) *MockwebhookStorageDeleteSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).DeleteSubscription), arg0, arg1)
+	return &MockwebhookStorageDeleteSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageDeleteSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageDeleteSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageDeleteSubscriptionCall) Return(arg0 error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageDeleteSubscriptionCall) Do(f func(context.Context, int) error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageDeleteSubscriptionCall) DoAndReturn(f func(context.Context, int) error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnqueueEvent mocks base method.
+func (m *MockwebhookStorage) EnqueueEvent(arg0 context.Context, arg1 pgx.Tx, arg2 models.WebhookEvent, arg3 int, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEvent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEvent indicates an expected call of EnqueueEvent.
+func (mr *MockwebhookStorageMockRecorder) EnqueueEvent(arg0, arg1, arg2, arg3, arg4 any) *MockwebhookStorageEnqueueEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEvent", reflect.TypeOf((*MockwebhookStorage)(nil).EnqueueEvent), arg0, arg1, arg2, arg3, arg4)
+	return &MockwebhookStorageEnqueueEventCall{Call: call}
+}
+
+// MockwebhookStorageEnqueueEventCall wrap *gomock.Call
+type MockwebhookStorageEnqueueEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageEnqueueEventCall) Return(arg0 error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageEnqueueEventCall) Do(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageEnqueueEventCall) DoAndReturn(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAndMarkDeliveries mocks base method.
+func (m *MockwebhookStorage) GetAndMarkDeliveries(arg0 context.Context, arg1 int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndMarkDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndMarkDeliveries indicates an expected call of GetAndMarkDeliveries.
+func (mr *MockwebhookStorageMockRecorder) GetAndMarkDeliveries(arg0, arg1 any) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndMarkDeliveries", reflect.TypeOf((*MockwebhookStorage)(nil).GetAndMarkDeliveries), arg0, arg1)
+	return &MockwebhookStorageGetAndMarkDeliveriesCall{Call: call}
+}
+
+// MockwebhookStorageGetAndMarkDeliveriesCall wrap *gomock.Call
+type MockwebhookStorageGetAndMarkDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) Return(arg0 []models.WebhookDelivery, arg1 error) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) Do(f func(context.Context, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) DoAndReturn(f func(context.Context, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDeliveries mocks base method.
+func (m *MockwebhookStorage) GetDeliveries(arg0 context.Context, arg1 []query.Cond, arg2, arg3 int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockwebhookStorageMockRecorder) GetDeliveries(arg0, arg1, arg2, arg3 any) *MockwebhookStorageGetDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockwebhookStorage)(nil).GetDeliveries), arg0, arg1, arg2, arg3)
+	return &MockwebhookStorageGetDeliveriesCall{Call: call}
+}
+
+// MockwebhookStorageGetDeliveriesCall wrap *gomock.Call
+type MockwebhookStorageGetDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetDeliveriesCall) Return(arg0 []models.WebhookDelivery, arg1 error) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetDeliveriesCall) Do(f func(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetDeliveriesCall) DoAndReturn(f func(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExpiredOrders mocks base method.
+func (m *MockwebhookStorage) GetExpiredOrders(arg0 context.Context, arg1, arg2 time.Time, arg3 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOrders", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOrders indicates an expected call of GetExpiredOrders.
+func (mr *MockwebhookStorageMockRecorder) GetExpiredOrders(arg0, arg1, arg2, arg3 any) *MockwebhookStorageGetExpiredOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrders", reflect.TypeOf((*MockwebhookStorage)(nil).GetExpiredOrders), arg0, arg1, arg2, arg3)
+	return &MockwebhookStorageGetExpiredOrdersCall{Call: call}
+}
+
+// MockwebhookStorageGetExpiredOrdersCall wrap *gomock.Call
+type MockwebhookStorageGetExpiredOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetExpiredOrdersCall) Return(arg0 []models.Order, arg1 error) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetExpiredOrdersCall) Do(f func(context.Context, time.Time, time.Time, int) ([]models.Order, error)) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetExpiredOrdersCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]models.Order, error)) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSubscriptions mocks base method.
+func (m *MockwebhookStorage) GetSubscriptions(arg0 context.Context) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptions", arg0)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptions indicates an expected call of GetSubscriptions.
+func (mr *MockwebhookStorageMockRecorder) GetSubscriptions(arg0 any) *MockwebhookStorageGetSubscriptionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockwebhookStorage)(nil).GetSubscriptions), arg0)
+	return &MockwebhookStorageGetSubscriptionsCall{Call: call}
+}
+
+// MockwebhookStorageGetSubscriptionsCall wrap *gomock.Call
+type MockwebhookStorageGetSubscriptionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetSubscriptionsCall) Return(arg0 []models.WebhookSubscription, arg1 error) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetSubscriptionsCall) Do(f func(context.Context) ([]models.WebhookSubscription, error)) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetSubscriptionsCall) DoAndReturn(f func(context.Context) ([]models.WebhookSubscription, error)) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockwebhookStorage) UpdateDelivery(arg0 context.Context, arg1 models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockwebhookStorageMockRecorder) UpdateDelivery(arg0, arg1 any) *MockwebhookStorageUpdateDeliveryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockwebhookStorage)(nil).UpdateDelivery), arg0, arg1)
+	return &MockwebhookStorageUpdateDeliveryCall{Call: call}
+}
+
+// MockwebhookStorageUpdateDeliveryCall wrap *gomock.Call
+type MockwebhookStorageUpdateDeliveryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageUpdateDeliveryCall) Return(arg0 error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageUpdateDeliveryCall) Do(f func(context.Context, models.WebhookDelivery) error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageUpdateDeliveryCall) DoAndReturn(f func(context.Context, models.WebhookDelivery) error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,101 @@
+//go:generate mockgen -typed -source=service.go -destination=mock_webhook_test.go -package=webhook
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+const (
+	expiredLookback = 24 * time.Hour
+	baseRetryDelay  = 30 * time.Second
+	maxRetryDelay   = time.Hour
+	maxAttempts     = 5
+	secretBytes     = 32
+)
+
+var (
+	// ErrSubscriptionNotFound happens when webhook subscription is not found
+	ErrSubscriptionNotFound = errors.New("webhook subscription not found")
+
+	// ErrWrongURL happens when webhook url is not an absolute http(s) url
+	ErrWrongURL = errors.New("webhook url must be an absolute http or https url")
+
+	// ErrNoEvents happens when subscription has no event types
+	ErrNoEvents = errors.New("webhook subscription must have at least one event type")
+)
+
+type webhookStorage interface {
+	CreateSubscription(context.Context, models.WebhookSubscription) (int, error)
+	GetSubscriptions(context.Context) ([]models.WebhookSubscription, error)
+	ContainsSubscription(context.Context, int) (bool, error)
+	DeleteSubscription(context.Context, int) error
+	GetAndMarkDeliveries(context.Context, int) ([]models.WebhookDelivery, error)
+	UpdateDelivery(context.Context, models.WebhookDelivery) error
+	GetDeliveries(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)
+	GetExpiredOrders(context.Context, time.Time, time.Time, int) ([]models.Order, error)
+	EnqueueEvent(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error
+}
+
+// Service is a structure for webhook service, it manages subscriptions and their delivery log
+type Service struct {
+	storage webhookStorage
+	logger  *zap.Logger
+}
+
+// NewService creates instance of a webhook Service
+func NewService(logger *zap.Logger, storage webhookStorage) *Service {
+	return &Service{
+		storage: storage,
+		logger:  logger,
+	}
+}
+
+// Dispatcher is a structure for webhook dispatcher, it delivers enqueued order events to subscriptions
+type Dispatcher struct {
+	storage   webhookStorage
+	client    *http.Client
+	batchSize int
+	logger    *zap.Logger
+}
+
+// NewDispatcher creates instance of a webhook Dispatcher
+func NewDispatcher(logger *zap.Logger, storage webhookStorage, batchSize int, timeout time.Duration) *Dispatcher {
+	return &Dispatcher{
+		storage:   storage,
+		client:    &http.Client{Timeout: timeout},
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Start starts delivering events every interval and enqueueing expiry events every expiredInterval
+func (d *Dispatcher) Start(ctx context.Context, interval time.Duration, expiredInterval time.Duration) {
+	go d.run(ctx, interval, d.Dispatch)
+	go d.run(ctx, expiredInterval, d.EnqueueExpired)
+}
+
+func (d *Dispatcher) run(ctx context.Context, interval time.Duration, job func(context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				d.logger.Error("webhook job failed", zap.Error(err))
+			}
+		}
+	}
+}
@@ -2,11 +2,13 @@ package facade
 
 import (
 	"context"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/cache/lru"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
 
 type adminStorage interface {
@@ -16,6 +18,10 @@ type adminStorage interface {
 	DeleteAdmin(context.Context, string) error
 	ContainsUsername(context.Context, string) (bool, error)
 	ContainsID(context.Context, int) (bool, error)
+	GetPasswordHistory(context.Context, int, int) ([]string, error)
+	AddPasswordHistory(context.Context, int, string, time.Time) error
+	ListAdmins(context.Context, []query.Cond, int, int) ([]models.Admin, error)
+	SetAdminDeactivatedAt(context.Context, string, *time.Time) error
 }
 
 // AdminFacade is a structure for admin facade
@@ -82,7 +88,8 @@ func (f *AdminFacade) UpdateAdmin(ctx context.Context, id int, admin models.Admi
 		return err
 	}
 
-	f.cache.Put(admin.Username, admin)
+	// cached admin is dropped instead of replaced, since update doesn't carry id and role
+	f.cache.Remove(admin.Username)
 
 	return nil
 }
@@ -136,3 +143,47 @@ func (f *AdminFacade) ContainsID(ctx context.Context, id int) (bool, error) {
 
 	return f.adminStorage.ContainsID(ctx, id)
 }
+
+// GetPasswordHistory gets hashes of previous passwords of an admin
+func (f *AdminFacade) GetPasswordHistory(ctx context.Context, adminID int, limit int) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "adminFacade.GetPasswordHistory")
+	defer span.Finish()
+
+	return f.adminStorage.GetPasswordHistory(ctx, adminID, limit)
+}
+
+// AddPasswordHistory saves hash of a replaced password of an admin
+func (f *AdminFacade) AddPasswordHistory(ctx context.Context, adminID int, password string,
+	changedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "adminFacade.AddPasswordHistory")
+	defer span.Finish()
+
+	return f.adminStorage.AddPasswordHistory(ctx, adminID, password, changedAt)
+}
+
+// ListAdmins gets admins that satisfy conditions, lists aren't cached
+func (f *AdminFacade) ListAdmins(ctx context.Context, params []query.Cond, count int,
+	page int) ([]models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "adminFacade.ListAdmins")
+	defer span.Finish()
+
+	return f.adminStorage.ListAdmins(ctx, params, count, page)
+}
+
+// SetAdminDeactivatedAt deactivates or reactivates admin, cached admin is dropped
+func (f *AdminFacade) SetAdminDeactivatedAt(ctx context.Context, username string,
+	deactivatedAt *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "adminFacade.SetAdminDeactivatedAt")
+	defer span.Finish()
+
+	err := f.adminStorage.SetAdminDeactivatedAt(ctx, username, deactivatedAt)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return err
+	}
+
+	f.cache.Remove(username)
+
+	return nil
+}
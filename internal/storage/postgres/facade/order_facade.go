@@ -159,6 +159,8 @@ func (f *OrderFacade) getOrderValue(field string, order models.Order) (interface
 		return order.Weight, nil
 	case "price":
 		return order.Price, nil
+	case "currency":
+		return order.Price.Currency().Code, nil
 	case "status":
 		return order.Status, nil
 	case "arrival_date":
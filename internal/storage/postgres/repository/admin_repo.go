@@ -3,11 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
 
 // AdminsRepo is a structure for admins repo
@@ -30,6 +32,10 @@ var (
 	errDeleteAdminFailed        = errors.New("failed to delete admin")
 	errGetAdminByUsernameFailed = errors.New("failed to get admin by username")
 	errFindingAdmin             = errors.New("could not find admin")
+	errGetPasswordHistoryFailed = errors.New("failed to get password history")
+	errAddPasswordHistoryFailed = errors.New("failed to add password to history")
+	errListAdminsFailed         = errors.New("failed to list admins")
+	errSetAdminActivityFailed   = errors.New("failed to change admin activity")
 )
 
 // CreateAdmin creates admin
@@ -38,9 +44,10 @@ func (r *AdminsRepo) CreateAdmin(ctx context.Context, admin models.Admin) error
 	defer span.Finish()
 
 	_, err := r.db.Exec(ctx, `
-							INSERT INTO admins(id, username, password, created_at)
-							VALUES ($1, $2, $3, $4)
-							`, admin.ID, admin.Username, admin.Password, admin.CreatedAt)
+							INSERT INTO admins(id, username, password, created_at, role, password_changed_at)
+							VALUES ($1, $2, $3, $4, $5, $6)
+							`, admin.ID, admin.Username, admin.Password, admin.CreatedAt, admin.Role,
+		admin.PasswordChangedAt)
 	if err != nil {
 		r.logger.Error("failed to insert admin",
 			zap.Int("id", admin.ID),
@@ -86,9 +93,9 @@ func (r *AdminsRepo) UpdateAdmin(ctx context.Context, id int, admin models.Admin
 
 	_, err := r.db.Exec(ctx, `
 							UPDATE admins
-							SET username = $1, password = $2
-							WHERE id = $3
-							`, admin.Username, admin.Password, id)
+							SET username = $1, password = $2, password_changed_at = $3
+							WHERE id = $4
+							`, admin.Username, admin.Password, admin.PasswordChangedAt, id)
 	if err != nil {
 		r.logger.Error("failed to update admin",
 			zap.Int("id", id),
@@ -125,6 +132,58 @@ func (r *AdminsRepo) DeleteAdmin(ctx context.Context, username string) error {
 	return nil
 }
 
+// ListAdmins gets admins that satisfy conditions ordered by id
+func (r *AdminsRepo) ListAdmins(ctx context.Context, params []query.Cond, count int,
+	page int) ([]models.Admin, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ListAdmins")
+	defer span.Finish()
+
+	selectQuery, args := query.BuildSelectQuery("admins",
+		query.Where(params...),
+		query.OrderBy("id"),
+		query.Limit(count),
+		query.Offset(page*count),
+	)
+
+	admins := make([]models.Admin, 0)
+	err := r.db.Select(ctx, &admins, selectQuery, args...)
+	if err != nil {
+		r.logger.Error("failed to list admins",
+			zap.String("query", selectQuery),
+			zap.Any("params", args),
+			zap.Error(err),
+		)
+		span.SetTag("error", errListAdminsFailed)
+
+		return nil, errListAdminsFailed
+	}
+
+	return admins, nil
+}
+
+// SetAdminDeactivatedAt deactivates admin at passed time, nil time reactivates admin
+func (r *AdminsRepo) SetAdminDeactivatedAt(ctx context.Context, username string, deactivatedAt *time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.SetAdminDeactivatedAt")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE admins
+							SET deactivated_at = $1
+							WHERE username = $2
+							`, deactivatedAt, username)
+	if err != nil {
+		r.logger.Error("failed to change admin activity",
+			zap.String("username", username),
+			zap.Error(err),
+		)
+		span.SetTag("error", errSetAdminActivityFailed)
+
+		return errSetAdminActivityFailed
+	}
+
+	return nil
+}
+
 // ContainsUsername checks if admin by username is present
 func (r *AdminsRepo) ContainsUsername(ctx context.Context, username string) (bool, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsUsername")
@@ -164,3 +223,52 @@ func (r *AdminsRepo) ContainsID(ctx context.Context, id int) (bool, error) {
 
 	return exists, nil
 }
+
+// GetPasswordHistory gets hashes of last limit previous passwords of an admin
+func (r *AdminsRepo) GetPasswordHistory(ctx context.Context, adminID int, limit int) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetPasswordHistory")
+	defer span.Finish()
+
+	var passwords []string
+	err := r.db.Select(ctx, &passwords, `
+								SELECT password
+								FROM password_history
+								WHERE admin_id = $1
+								ORDER BY changed_at DESC
+								LIMIT $2
+								`, adminID, limit)
+	if err != nil {
+		r.logger.Error("failed to get password history",
+			zap.Int("admin_id", adminID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetPasswordHistoryFailed)
+
+		return nil, errGetPasswordHistoryFailed
+	}
+
+	return passwords, nil
+}
+
+// AddPasswordHistory saves hash of a replaced password of an admin
+func (r *AdminsRepo) AddPasswordHistory(ctx context.Context, adminID int, password string,
+	changedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.AddPasswordHistory")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							INSERT INTO password_history(admin_id, password, changed_at)
+							VALUES ($1, $2, $3)
+							`, adminID, password, changedAt)
+	if err != nil {
+		r.logger.Error("failed to add password to history",
+			zap.Int("admin_id", adminID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errAddPasswordHistoryFailed)
+
+		return errAddPasswordHistoryFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,221 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// APIKeysRepo is a repository for API keys of machine clients
+type APIKeysRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewAPIKeysRepo creates an instance of API keys repo
+func NewAPIKeysRepo(logger *zap.Logger, db database) *APIKeysRepo {
+	return &APIKeysRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errCreateAPIKeyFailed = errors.New("failed to create api key")
+	errGetAPIKeysFailed   = errors.New("failed to get api keys")
+	errGetAPIKeyFailed    = errors.New("failed to get api key")
+	errFindingAPIKey      = errors.New("failed to find api key")
+	errRevokeAPIKeyFailed = errors.New("failed to revoke api key")
+	errTouchAPIKeyFailed  = errors.New("failed to update api key last usage")
+)
+
+type apiKey struct {
+	ID         int        `db:"id"`
+	Name       string     `db:"name"`
+	Prefix     string     `db:"prefix"`
+	SecretHash string     `db:"secret_hash"`
+	Scopes     []string   `db:"scopes"`
+	CreatedBy  int        `db:"created_by"`
+	CreatedAt  time.Time  `db:"created_at"`
+	LastUsedAt *time.Time `db:"last_used_at"`
+	RevokedAt  *time.Time `db:"revoked_at"`
+
+	CreatorRole          models.Role `db:"creator_role"`
+	CreatorDeactivatedAt *time.Time  `db:"creator_deactivated_at"`
+}
+
+func (k *apiKey) toModel() models.APIKey {
+	scopes := make([]models.Permission, 0, len(k.Scopes))
+	for _, scope := range k.Scopes {
+		scopes = append(scopes, models.Permission(scope))
+	}
+
+	return models.APIKey{
+		ID:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		SecretHash: k.SecretHash,
+		Scopes:     scopes,
+		CreatedBy:  k.CreatedBy,
+		CreatedAt:  k.CreatedAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+
+		CreatorRole:          k.CreatorRole,
+		CreatorDeactivatedAt: k.CreatorDeactivatedAt,
+	}
+}
+
+// CreateAPIKey saves API key hash and returns its id
+func (r *APIKeysRepo) CreateAPIKey(ctx context.Context, key models.APIKey) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateAPIKey")
+	defer span.Finish()
+
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	var id int
+	err := r.db.ExecQueryRow(ctx, `
+								INSERT INTO api_keys(name, prefix, secret_hash, scopes, created_by, created_at)
+								VALUES ($1, $2, $3, $4, $5, $6)
+								RETURNING id
+								`, key.Name, key.Prefix, key.SecretHash, scopes, key.CreatedBy, key.CreatedAt).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to create api key",
+			zap.String("name", key.Name),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateAPIKeyFailed)
+
+		return 0, errCreateAPIKeyFailed
+	}
+
+	return id, nil
+}
+
+// GetAPIKeys gets all API keys, including revoked ones
+func (r *APIKeysRepo) GetAPIKeys(ctx context.Context) ([]models.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetAPIKeys")
+	defer span.Finish()
+
+	var tmp []apiKey
+	err := r.db.Select(ctx, &tmp, `
+								SELECT k.id, k.name, k.prefix, k.secret_hash, k.scopes, k.created_by, k.created_at,
+									k.last_used_at, k.revoked_at, a.role AS creator_role,
+									a.deactivated_at AS creator_deactivated_at
+								FROM api_keys k
+								JOIN admins a ON a.id = k.created_by
+								ORDER BY k.id
+								`)
+	if err != nil {
+		r.logger.Error("failed to get api keys", zap.Error(err))
+		span.SetTag("error", errGetAPIKeysFailed)
+
+		return nil, errGetAPIKeysFailed
+	}
+
+	keys := make([]models.APIKey, 0, len(tmp))
+	for x := range tmp {
+		keys = append(keys, tmp[x].toModel())
+	}
+
+	return keys, nil
+}
+
+// GetAPIKey gets API key by its prefix
+func (r *APIKeysRepo) GetAPIKey(ctx context.Context, prefix string) (models.APIKey, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetAPIKey")
+	defer span.Finish()
+
+	var tmp apiKey
+	// creator is loaded with the key, so a key of a deactivated or demoted admin is rejected at once
+	err := r.db.Get(ctx, &tmp, `
+							SELECT k.id, k.name, k.prefix, k.secret_hash, k.scopes, k.created_by, k.created_at,
+								k.last_used_at, k.revoked_at, a.role AS creator_role,
+								a.deactivated_at AS creator_deactivated_at
+							FROM api_keys k
+							JOIN admins a ON a.id = k.created_by
+							WHERE k.prefix = $1
+							`, prefix)
+	if err != nil {
+		r.logger.Error("failed to get api key",
+			zap.String("prefix", prefix),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetAPIKeyFailed)
+
+		return models.APIKey{}, errGetAPIKeyFailed
+	}
+
+	return tmp.toModel(), nil
+}
+
+// ContainsAPIKey checks if API key with such prefix exists
+func (r *APIKeysRepo) ContainsAPIKey(ctx context.Context, prefix string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsAPIKey")
+	defer span.Finish()
+
+	var exists bool
+	err := r.db.ExecQueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM api_keys WHERE prefix = $1)", prefix).
+		Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check if api key exists",
+			zap.String("prefix", prefix),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingAPIKey)
+
+		return false, errFindingAPIKey
+	}
+
+	return exists, nil
+}
+
+// RevokeAPIKey revokes API key by its prefix, already revoked keys keep their revocation time
+func (r *APIKeysRepo) RevokeAPIKey(ctx context.Context, prefix string, revokedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RevokeAPIKey")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE api_keys
+							SET revoked_at = $1
+							WHERE prefix = $2 AND revoked_at IS NULL
+							`, revokedAt, prefix)
+	if err != nil {
+		r.logger.Error("failed to revoke api key",
+			zap.String("prefix", prefix),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRevokeAPIKeyFailed)
+
+		return errRevokeAPIKeyFailed
+	}
+
+	return nil
+}
+
+// TouchAPIKey sets time API key was last used at
+func (r *APIKeysRepo) TouchAPIKey(ctx context.Context, id int, usedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.TouchAPIKey")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, "UPDATE api_keys SET last_used_at = $1 WHERE id = $2", usedAt, id)
+	if err != nil {
+		r.logger.Error("failed to update api key last usage",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errTouchAPIKeyFailed)
+
+		return errTouchAPIKeyFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// ClientsRepo is a structure for clients repo
+type ClientsRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewClientsRepo creates an instance of clients repo
+func NewClientsRepo(logger *zap.Logger, db database) *ClientsRepo {
+	return &ClientsRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errCreateClientFailed     = errors.New("failed to create client")
+	errGetClientByPhoneFailed = errors.New("failed to get client by phone")
+	errGetClientByIDFailed    = errors.New("failed to get client by id")
+	errFindingClient          = errors.New("could not find client")
+)
+
+const selectClient = `
+					SELECT id, name, COALESCE(phone, '') AS phone, email, created_at
+					FROM clients
+					`
+
+// CreateClient creates client and returns its id
+func (r *ClientsRepo) CreateClient(ctx context.Context, client models.Client) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateClient")
+	defer span.Finish()
+
+	var id int
+	var err error
+	if client.ID == 0 {
+		err = r.db.ExecQueryRow(ctx, `
+							INSERT INTO clients(name, phone, email, created_at)
+							VALUES ($1, $2, $3, $4)
+							RETURNING id
+							`, client.Name, client.Phone, client.Email, client.CreatedAt).Scan(&id)
+	} else {
+		// explicit id doesn't advance the sequence, so it's moved past the id,
+		// otherwise the next client without id would get an id that is already used
+		err = r.db.ExecQueryRow(ctx, `
+							WITH inserted AS (
+								INSERT INTO clients(id, name, phone, email, created_at)
+								VALUES ($1, $2, $3, $4, $5)
+								RETURNING id
+							),
+							synced AS (
+								SELECT setval(pg_get_serial_sequence('clients', 'id'),
+									GREATEST(id, nextval(pg_get_serial_sequence('clients', 'id'))))
+								FROM inserted
+							)
+							SELECT id FROM inserted, synced
+							`, client.ID, client.Name, client.Phone, client.Email, client.CreatedAt).Scan(&id)
+	}
+	if err != nil {
+		r.logger.Error("failed to insert client",
+			zap.Int("id", client.ID),
+			zap.String("phone", client.Phone),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateClientFailed)
+
+		return 0, errCreateClientFailed
+	}
+
+	return id, nil
+}
+
+// GetClientByPhone gets client by phone
+func (r *ClientsRepo) GetClientByPhone(ctx context.Context, phone string) (models.Client, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetClientByPhone")
+	defer span.Finish()
+
+	var client models.Client
+	err := r.db.Get(ctx, &client, selectClient+`WHERE phone = $1`, phone)
+	if err != nil {
+		r.logger.Error("failed to get client",
+			zap.String("phone", phone),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetClientByPhoneFailed)
+
+		return models.Client{}, errGetClientByPhoneFailed
+	}
+
+	return client, nil
+}
+
+// GetClientByID gets client by id
+func (r *ClientsRepo) GetClientByID(ctx context.Context, tx pgx.Tx, id int) (models.Client, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetClientByID")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var client models.Client
+	err := execQueryRow(ctx, selectClient+`WHERE id = $1`, id).Scan(
+		&client.ID,
+		&client.Name,
+		&client.Phone,
+		&client.Email,
+		&client.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to get client",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetClientByIDFailed)
+
+		return models.Client{}, errGetClientByIDFailed
+	}
+
+	return client, nil
+}
+
+// ContainsPhone checks if client with such phone is present
+func (r *ClientsRepo) ContainsPhone(ctx context.Context, phone string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsPhone")
+	defer span.Finish()
+
+	var exists bool
+	err := r.db.Get(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM clients WHERE phone = $1)", phone)
+	if err != nil {
+		r.logger.Error("failed to check if client exists",
+			zap.String("phone", phone),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingClient)
+
+		return false, errFindingClient
+	}
+
+	return exists, nil
+}
+
+// ContainsClientID checks if client by id is present
+func (r *ClientsRepo) ContainsClientID(ctx context.Context, tx pgx.Tx, id int) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsClientID")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var exists bool
+	err := execQueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM clients WHERE id = $1)", id).Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check if client exists",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingClient)
+
+		return false, errFindingClient
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// LoginAttemptsRepo is a repository for failed login attempts
+type LoginAttemptsRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewLoginAttemptsRepo creates an instance of login attempts repo
+func NewLoginAttemptsRepo(logger *zap.Logger, db database) *LoginAttemptsRepo {
+	return &LoginAttemptsRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errGetLoginAttemptsFailed     = errors.New("failed to get login attempts")
+	errRegisterLoginAttemptFailed = errors.New("failed to register failed login attempt")
+	errLockLoginFailed            = errors.New("failed to lock login")
+	errResetLoginAttemptsFailed   = errors.New("failed to reset login attempts")
+)
+
+// GetLoginAttempts gets failed login attempts of a username and an ip
+func (r *LoginAttemptsRepo) GetLoginAttempts(ctx context.Context, username string,
+	ip string) ([]models.LoginAttempt, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetLoginAttempts")
+	defer span.Finish()
+
+	var attempts []models.LoginAttempt
+	err := r.db.Select(ctx, &attempts, `
+								SELECT kind, key, failed_attempts, locked_until, updated_at
+								FROM login_attempts
+								WHERE (kind = $1 AND key = $2) OR (kind = $3 AND key = $4)
+								`, models.UsernameLoginAttempt, username, models.IPLoginAttempt, ip)
+	if err != nil {
+		r.logger.Error("failed to get login attempts",
+			zap.String("username", username),
+			zap.String("ip", ip),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetLoginAttemptsFailed)
+
+		return nil, errGetLoginAttemptsFailed
+	}
+
+	return attempts, nil
+}
+
+// RegisterFailedLogin increments failed attempts counter and returns its new value,
+// counter starts over if previous failure happened before resetBefore
+func (r *LoginAttemptsRepo) RegisterFailedLogin(ctx context.Context, kind string, key string, now time.Time,
+	resetBefore time.Time) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RegisterFailedLogin")
+	defer span.Finish()
+
+	var failedAttempts int
+	err := r.db.ExecQueryRow(ctx, `
+								INSERT INTO login_attempts(kind, key, failed_attempts, updated_at)
+								VALUES ($1, $2, 1, $3)
+								ON CONFLICT (kind, key) DO UPDATE
+								SET failed_attempts = CASE
+									WHEN login_attempts.updated_at < $4 THEN 1
+									ELSE login_attempts.failed_attempts + 1
+								END,
+								updated_at = $3
+								RETURNING failed_attempts
+								`, kind, key, now, resetBefore).Scan(&failedAttempts)
+	if err != nil {
+		r.logger.Error("failed to register failed login attempt",
+			zap.String("kind", kind),
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRegisterLoginAttemptFailed)
+
+		return 0, errRegisterLoginAttemptFailed
+	}
+
+	return failedAttempts, nil
+}
+
+// LockLogin locks logins of a username or an ip until given time
+func (r *LoginAttemptsRepo) LockLogin(ctx context.Context, kind string, key string, lockedUntil time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.LockLogin")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE login_attempts
+							SET locked_until = $1
+							WHERE kind = $2 AND key = $3
+							`, lockedUntil, kind, key)
+	if err != nil {
+		r.logger.Error("failed to lock login",
+			zap.String("kind", kind),
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		span.SetTag("error", errLockLoginFailed)
+
+		return errLockLoginFailed
+	}
+
+	return nil
+}
+
+// ResetLoginAttempts forgets failed attempts of a username or an ip and lifts its lock
+func (r *LoginAttemptsRepo) ResetLoginAttempts(ctx context.Context, kind string, key string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ResetLoginAttempts")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, "DELETE FROM login_attempts WHERE kind = $1 AND key = $2", kind, key)
+	if err != nil {
+		r.logger.Error("failed to reset login attempts",
+			zap.String("kind", kind),
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		span.SetTag("error", errResetLoginAttemptsFailed)
+
+		return errResetLoginAttemptsFailed
+	}
+
+	return nil
+}
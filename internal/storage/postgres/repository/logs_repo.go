@@ -4,10 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
 
 var (
@@ -15,45 +17,69 @@ var (
 	errCreateJob = errors.New("error creating job")
 )
 
+// logsChainLock is a key of advisory lock held while logs are chained
+const logsChainLock = 0x6c6f6773
+
+// logColumns are columns of logs table, logs without an admin are read with admin id 0
+const logColumns = `id, order_id, COALESCE(admin_id, 0) AS admin_id, api_key_id, message, date, url, method,
+	status, job_status, attempts_left, updated_at, next_attempt_at, diff, prev_hash, hash`
+
 // LogsRepo is a repository for logs table
 type LogsRepo struct {
-	db database
+	db          database
+	maxAttempts int
 }
 
-// NewLogsRepo creates instance of a LogsRepo
-func NewLogsRepo(db database) *LogsRepo {
+// NewLogsRepo creates instance of a LogsRepo, new logs are sent to Kafka at most maxAttempts times
+func NewLogsRepo(db database, maxAttempts int) *LogsRepo {
 	return &LogsRepo{
-		db: db,
+		db:          db,
+		maxAttempts: maxAttempts,
+	}
+}
+
+// adminIDValue makes logs without an admin, such as lockouts of unknown usernames, store NULL
+func adminIDValue(adminID int) interface{} {
+	if adminID == 0 {
+		return nil
+	}
+
+	return adminID
+}
+
+// diffValue makes logs without changes store NULL instead of JSON null
+func diffValue(diff models.Diff) interface{} {
+	if len(diff) == 0 {
+		return nil
 	}
+
+	return diff
 }
 
 // CreateLog creates log
 func (r *LogsRepo) CreateLog(ctx context.Context, logBatch []models.Log) error {
-	queryBatch := &pgx.Batch{}
-	for _, log := range logBatch {
+	err := r.createChained(ctx, logBatch, func(queryBatch *pgx.Batch, log models.Log) {
 		queryBatch.Queue(`
 								INSERT INTO logs(
 												 order_id,
 												 admin_id,
+												 api_key_id,
 												 message,
 												 date,
 												 url,
 												 method,
-												 status)
-								VALUES ($1, $2, $3, $4, $5, $6, $7)
+												 status,
+												 attempts_left,
+												 diff,
+												 prev_hash,
+												 hash)
+								VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
 								`,
-			log.OrderID, log.AdminID, log.Message, log.Date, log.URL, log.Method, log.Status)
-	}
-
-	br := r.db.SendBatch(ctx, queryBatch)
-	defer br.Close()
-
-	for i := 0; i < len(logBatch); i++ {
-		if _, err := br.Exec(); err != nil {
-			fmt.Println(err.Error())
-
-			return errCreateLog
-		}
+			log.OrderID, adminIDValue(log.AdminID), log.APIKeyID, log.Message, log.Date, log.URL, log.Method, log.Status,
+			r.maxAttempts, diffValue(log.Diff), log.PrevHash, log.Hash)
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCreateLog, err)
 	}
 
 	return nil
@@ -61,12 +87,12 @@ func (r *LogsRepo) CreateLog(ctx context.Context, logBatch []models.Log) error {
 
 // CreateJob creates job from logs in batches
 func (r *LogsRepo) CreateJob(ctx context.Context, logBatch []models.Log) error {
-	queryBatch := &pgx.Batch{}
-	for _, log := range logBatch {
+	err := r.createChained(ctx, logBatch, func(queryBatch *pgx.Batch, log models.Log) {
 		queryBatch.Queue(`
 								INSERT INTO logs(
 												 order_id,
 												 admin_id,
+												 api_key_id,
 												 message,
 												 date,
 												 url,
@@ -74,35 +100,74 @@ func (r *LogsRepo) CreateJob(ctx context.Context, logBatch []models.Log) error {
 												 status,
 								                 job_status,
 								                 attempts_left,
-								                 updated_at)
-								VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+								                 updated_at,
+								                 diff,
+								                 prev_hash,
+								                 hash)
+								VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
 								`,
-			log.OrderID, log.AdminID, log.Message, log.Date, log.URL, log.Method, log.Status,
-			log.JobStatus, log.AttemptsLeft, log.UpdatedAt)
+			log.OrderID, adminIDValue(log.AdminID), log.APIKeyID, log.Message, log.Date, log.URL, log.Method, log.Status,
+			log.JobStatus, log.AttemptsLeft, log.UpdatedAt, diffValue(log.Diff), log.PrevHash, log.Hash)
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCreateJob, err)
+	}
+
+	return nil
+}
+
+// createChained inserts logs chaining each of them to the previous one, inserts are serialized
+// with an advisory lock, so concurrent writers don't fork the chain
+func (r *LogsRepo) createChained(ctx context.Context, logBatch []models.Log,
+	queue func(*pgx.Batch, models.Log)) error {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.ReadCommitted, AccessMode: pgx.ReadWrite})
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	if _, err = tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", logsChainLock); err != nil {
+		return err
 	}
 
-	br := r.db.SendBatch(ctx, queryBatch)
-	defer br.Close()
+	var prevHash string
+	err = tx.QueryRow(ctx, "SELECT hash FROM logs ORDER BY id DESC LIMIT 1").Scan(&prevHash)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return err
+	}
+
+	queryBatch := &pgx.Batch{}
+	for _, log := range logBatch {
+		log.Chain(prevHash)
+		prevHash = log.Hash
+		queue(queryBatch, log)
+	}
 
+	br := tx.SendBatch(ctx, queryBatch)
 	for i := 0; i < len(logBatch); i++ {
-		if _, err := br.Exec(); err != nil {
-			fmt.Println(err.Error())
+		if _, err = br.Exec(); err != nil {
+			_ = br.Close()
 
-			return errCreateJob
+			return err
 		}
 	}
+	if err = br.Close(); err != nil {
+		return err
+	}
 
-	return nil
+	return tx.Commit(ctx)
 }
 
-// GetAndMarkLogs gets logs and marks them as being processed
+// GetAndMarkLogs gets logs which next attempt is due and marks them as being processed
 func (r *LogsRepo) GetAndMarkLogs(ctx context.Context, batchSize int) ([]models.Log, error) {
 	logs := make([]models.Log, 0)
 	err := r.db.Select(ctx, &logs, `
 									WITH cte AS (
 										SELECT id
 										FROM logs
-										WHERE job_status = 1 OR job_status = 3 OR
+										WHERE ((job_status = 1 OR job_status = 3) AND next_attempt_at <= now()) OR
 											(job_status = 2 AND updated_at < (now() - INTERVAL '5 minutes'))
 										ORDER BY date
 										LIMIT $1
@@ -114,7 +179,7 @@ func (r *LogsRepo) GetAndMarkLogs(ctx context.Context, batchSize int) ([]models.
 											WHERE id IN (SELECT id FROM cte)
 											RETURNING *
 									)
-									SELECT * FROM updated_logs ORDER BY date;
+									SELECT `+logColumns+` FROM updated_logs ORDER BY date;
 									`, batchSize)
 	if err != nil {
 		return nil, err
@@ -127,7 +192,7 @@ func (r *LogsRepo) GetAndMarkLogs(ctx context.Context, batchSize int) ([]models.
 func (r *LogsRepo) GetLogs(ctx context.Context) ([]models.Log, error) {
 	logs := make([]models.Log, 0)
 
-	err := r.db.Select(ctx, &logs, `SELECT * FROM logs;`)
+	err := r.db.Select(ctx, &logs, `SELECT `+logColumns+` FROM logs;`)
 	if err != nil {
 		return nil, err
 	}
@@ -135,10 +200,65 @@ func (r *LogsRepo) GetLogs(ctx context.Context) ([]models.Log, error) {
 	return logs, nil
 }
 
-// UpdateLog updates logs status and attempts left count
-func (r *LogsRepo) UpdateLog(ctx context.Context, id int, newStatus int, attemptsLeft int) error {
-	_, err := r.db.Exec(ctx, `UPDATE logs SET attempts_left = $1, job_status = $2 WHERE id = $3`,
-		attemptsLeft, newStatus, id)
+// ListLogs returns logs that satisfy conditions, newest first
+func (r *LogsRepo) ListLogs(ctx context.Context, params []query.Cond, count int, page int) ([]models.Log, error) {
+	selectQuery, args := query.BuildSelectQuery("(SELECT "+logColumns+" FROM logs) AS logs",
+		query.Where(params...),
+		query.OrderBy("id"),
+		query.Desc(true),
+		query.Limit(count),
+		query.Offset(page*count),
+	)
+
+	logs := make([]models.Log, 0)
+	err := r.db.Select(ctx, &logs, selectQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// GetLogsAfter returns logs with id greater than afterID in order they were written
+func (r *LogsRepo) GetLogsAfter(ctx context.Context, afterID int, limit int) ([]models.Log, error) {
+	logs := make([]models.Log, 0)
+	err := r.db.Select(ctx, &logs, `SELECT `+logColumns+` FROM logs WHERE id > $1 ORDER BY id LIMIT $2`, afterID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// UpdateLog updates logs status, attempts left count and time of the next attempt, logs that are done
+// aren't updated, so repeated updates have no effect
+func (r *LogsRepo) UpdateLog(ctx context.Context, id int, newStatus int, attemptsLeft int,
+	nextAttemptAt time.Time) error {
+	_, err := r.db.Exec(ctx, `
+							UPDATE logs
+							SET attempts_left = $1, job_status = $2, next_attempt_at = $3
+							WHERE id = $4 AND job_status <> $5
+							`, attemptsLeft, newStatus, nextAttemptAt, id, models.DoneStatus)
 
 	return err
 }
+
+// RequeueDeadLogs makes logs without attempts left ready to be sent again with all attempts,
+// all such logs are requeued if ids are empty, returns number of requeued logs
+func (r *LogsRepo) RequeueDeadLogs(ctx context.Context, ids []int) (int, error) {
+	if ids == nil {
+		// nil slice is sent as NULL array, cardinality of which isn't 0
+		ids = []int{}
+	}
+
+	tag, err := r.db.Exec(ctx, `
+							UPDATE logs
+							SET job_status = $1, attempts_left = $2, next_attempt_at = now(), updated_at = now()
+							WHERE job_status = $3 AND (cardinality($4::int[]) = 0 OR id = ANY($4))
+							`, models.CreatedStatus, r.maxAttempts, models.NoAttemptsLeftStatus, ids)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(tag.RowsAffected()), nil
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// NotificationsRepo is a repository for notifications outbox table
+type NotificationsRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewNotificationsRepo creates an instance of notifications repo
+func NewNotificationsRepo(logger *zap.Logger, db database) *NotificationsRepo {
+	return &NotificationsRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errCreateNotificationFailed = errors.New("failed to create notification")
+	errGetNotificationsFailed   = errors.New("failed to get notifications")
+	errUpdateNotificationFailed = errors.New("failed to update notification")
+	errGetOrdersToRemindFailed  = errors.New("failed to get orders to remind about")
+)
+
+// CreateNotification puts notification into the outbox, notification of the same kind
+// about the same order is created only once
+func (r *NotificationsRepo) CreateNotification(ctx context.Context, tx pgx.Tx,
+	notification models.Notification) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateNotification")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						INSERT INTO notifications(order_id, client_id, kind, message, created_at)
+						VALUES ($1, $2, $3, $4, $5)
+						ON CONFLICT (order_id, kind) DO NOTHING
+						`, notification.OrderID, notification.ClientID, string(notification.Kind),
+		notification.Message, notification.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to create notification",
+			zap.Int("order_id", notification.OrderID),
+			zap.String("kind", string(notification.Kind)),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateNotificationFailed)
+
+		return errCreateNotificationFailed
+	}
+
+	return nil
+}
+
+// GetAndMarkNotifications gets notifications ready to be sent and marks them as being processed
+func (r *NotificationsRepo) GetAndMarkNotifications(ctx context.Context,
+	batchSize int) ([]models.Notification, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetAndMarkNotifications")
+	defer span.Finish()
+
+	notifications := make([]models.Notification, 0)
+	err := r.db.Select(ctx, &notifications, `
+									WITH cte AS (
+										SELECT id
+										FROM notifications
+										WHERE ((job_status = 1 OR job_status = 3) AND next_attempt_at <= now()) OR
+											(job_status = 2 AND updated_at < (now() - INTERVAL '5 minutes'))
+										ORDER BY next_attempt_at
+										LIMIT $1
+										FOR UPDATE SKIP LOCKED
+									),
+									updated_notifications AS (
+										UPDATE notifications
+											SET job_status = 2,
+												updated_at = now()
+											WHERE id IN (SELECT id FROM cte)
+											RETURNING *
+									)
+									SELECT * FROM updated_notifications ORDER BY next_attempt_at;
+									`, batchSize)
+	if err != nil {
+		r.logger.Error("failed to get notifications",
+			zap.Int("batch_size", batchSize),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetNotificationsFailed)
+
+		return nil, errGetNotificationsFailed
+	}
+
+	return notifications, nil
+}
+
+// UpdateNotification updates notification job status, attempts left count and time of the next attempt
+func (r *NotificationsRepo) UpdateNotification(ctx context.Context, id int, newStatus int, attemptsLeft int,
+	nextAttemptAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.UpdateNotification")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE notifications
+							SET job_status = $1, attempts_left = $2, next_attempt_at = $3, updated_at = now()
+							WHERE id = $4
+							`, newStatus, attemptsLeft, nextAttemptAt, id)
+	if err != nil {
+		r.logger.Error("failed to update notification",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errUpdateNotificationFailed)
+
+		return errUpdateNotificationFailed
+	}
+
+	return nil
+}
+
+// GetOrdersToRemind gets stored orders expiring in [from, to) that don't have notification of a given kind yet
+func (r *NotificationsRepo) GetOrdersToRemind(ctx context.Context, kind models.NotificationKind, from time.Time,
+	to time.Time, count int) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetOrdersToRemind")
+	defer span.Finish()
+
+	var tmp []order
+	err := r.db.Select(ctx, &tmp, `
+								SELECT o.*
+								FROM orders o
+								WHERE o.status = $1
+								  AND o.expiry_date >= $2
+								  AND o.expiry_date < $3
+								  AND NOT EXISTS(SELECT 1
+												 FROM notifications n
+												 WHERE n.order_id = o.id
+												   AND n.kind = $4)
+								ORDER BY o.expiry_date
+								LIMIT $5
+								`, models.StoredOrder, from, to, string(kind), count)
+	if err != nil {
+		r.logger.Error("failed to get orders to remind about",
+			zap.String("kind", string(kind)),
+			zap.Time("from", from),
+			zap.Time("to", to),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetOrdersToRemindFailed)
+
+		return nil, errGetOrdersToRemindFailed
+	}
+
+	orders := make([]models.Order, 0, len(tmp))
+	for x := range tmp {
+		orders = append(orders, *convertToModel(&tmp[x]))
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// OrderEventsRepo is a repository for order events outbox table
+type OrderEventsRepo struct {
+	db          database
+	logger      *zap.Logger
+	maxAttempts int
+}
+
+// NewOrderEventsRepo creates an instance of order events repo, events are sent to Kafka at most maxAttempts times
+func NewOrderEventsRepo(logger *zap.Logger, db database, maxAttempts int) *OrderEventsRepo {
+	return &OrderEventsRepo{
+		db:          db,
+		logger:      logger,
+		maxAttempts: maxAttempts,
+	}
+}
+
+var (
+	errCreateOrderEventFailed = errors.New("failed to create order event")
+	errGetOrderEventsFailed   = errors.New("failed to get order events")
+	errUpdateOrderEventFailed = errors.New("failed to update order event")
+)
+
+// CreateOrderEvent puts order event into the outbox, it's meant to be called in the transaction
+// that changes the order, so event is published only if the change is committed
+func (r *OrderEventsRepo) CreateOrderEvent(ctx context.Context, tx pgx.Tx, event models.OrderEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateOrderEvent")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						INSERT INTO order_events(order_id, event_type, payload, attempts_left)
+						VALUES ($1, $2, $3, $4)
+						`, event.OrderID, string(event.Type), event.Payload, r.maxAttempts)
+	if err != nil {
+		r.logger.Error("failed to create order event",
+			zap.Int("order_id", event.OrderID),
+			zap.String("type", string(event.Type)),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateOrderEventFailed)
+
+		return errCreateOrderEventFailed
+	}
+
+	return nil
+}
+
+// GetAndMarkOrderEvents gets events ready to be sent and marks them as being processed.
+// Event isn't taken while an earlier event of the same order isn't sent yet, so events of an order
+// are published in order they happened, events without attempts left don't hold the later ones
+func (r *OrderEventsRepo) GetAndMarkOrderEvents(ctx context.Context, batchSize int) ([]models.OrderEvent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetAndMarkOrderEvents")
+	defer span.Finish()
+
+	events := make([]models.OrderEvent, 0)
+	err := r.db.Select(ctx, &events, `
+									WITH cte AS (
+										SELECT e.id
+										FROM order_events e
+										WHERE (((e.job_status = 1 OR e.job_status = 3) AND e.next_attempt_at <= now()) OR
+											(e.job_status = 2 AND e.updated_at < (now() - INTERVAL '5 minutes')))
+											AND NOT EXISTS (
+												SELECT 1
+												FROM order_events p
+												WHERE p.order_id = e.order_id AND p.id < e.id AND p.job_status IN (1, 2, 3)
+											)
+										ORDER BY e.id
+										LIMIT $1
+										FOR UPDATE SKIP LOCKED
+									),
+									updated_events AS (
+										UPDATE order_events
+											SET job_status = 2,
+												updated_at = now()
+											WHERE id IN (SELECT id FROM cte)
+											RETURNING *
+									)
+									SELECT * FROM updated_events ORDER BY id;
+									`, batchSize)
+	if err != nil {
+		r.logger.Error("failed to get order events",
+			zap.Int("batch_size", batchSize),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetOrderEventsFailed)
+
+		return nil, errGetOrderEventsFailed
+	}
+
+	return events, nil
+}
+
+// UpdateOrderEvent updates events status, attempts left count and time of the next attempt, events that are done
+// aren't updated, so repeated updates have no effect
+func (r *OrderEventsRepo) UpdateOrderEvent(ctx context.Context, id int, newStatus int, attemptsLeft int,
+	nextAttemptAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.UpdateOrderEvent")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE order_events
+							SET job_status = $1,
+								attempts_left = $2,
+								next_attempt_at = $3,
+								updated_at = now()
+							WHERE id = $4 AND job_status <> $5
+							`, newStatus, attemptsLeft, nextAttemptAt, id, models.DoneStatus)
+	if err != nil {
+		r.logger.Error("failed to update order event",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errUpdateOrderEventFailed)
+
+		return errUpdateOrderEventFailed
+	}
+
+	return nil
+}
@@ -46,7 +46,8 @@ func selectTx(ctx context.Context, tx pgx.Tx, orders []order, selectQuery string
 			&tmp.Status,
 			&tmp.ArrivalDate,
 			&tmp.ExpiryDate,
-			&tmp.LastChange)
+			&tmp.LastChange,
+			&tmp.Currency)
 		if err != nil {
 			return errGetOrdersFailed
 		}
@@ -95,17 +96,19 @@ func (r *OrdersRepo) AddOrder(ctx context.Context, tx pgx.Tx, order models.Order
 										   status,
 										   arrival_date,
 										   expiry_date,
-										   last_change)
-						VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
+										   last_change,
+										   currency)
+						VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
 						`,
 		tmp.ID, tmp.UserID, tmp.Weight, tmp.Price, tmp.Packaging, tmp.ExtraPackaging,
-		tmp.Status, tmp.ArrivalDate.Time, tmp.ExpiryDate.Time, tmp.LastChange.Time)
+		tmp.Status, tmp.ArrivalDate.Time, tmp.ExpiryDate.Time, tmp.LastChange.Time, tmp.Currency)
 	if err != nil {
 		r.logger.Error("failed to add order",
 			zap.Int("order_id", tmp.ID),
 			zap.Int("user_id", tmp.UserID),
 			zap.Float64("weight", tmp.Weight),
 			zap.Int64("price", tmp.Price),
+			zap.String("currency", tmp.Currency),
 			zap.Int("packaging", int(tmp.Packaging)),
 			zap.Int("extra_packaging", int(tmp.ExtraPackaging)),
 			zap.Int("status", int(tmp.Status)),
@@ -183,17 +186,19 @@ func (r *OrdersRepo) UpdateOrder(ctx context.Context, tx pgx.Tx, id int, order m
 							status          = $6,
 							arrival_date    = $7,
 							expiry_date     = $8,
-							last_change     = $9
-						WHERE id = $10
+							last_change     = $9,
+							currency        = $10
+						WHERE id = $11
 						`,
 		order.UserID, order.Weight, order.Price.Amount(), order.Packaging, order.ExtraPackaging,
-		order.Status, order.ArrivalDate, order.ExpiryDate, order.LastChange, id)
+		order.Status, order.ArrivalDate, order.ExpiryDate, order.LastChange, order.Price.Currency().Code, id)
 	if err != nil {
 		r.logger.Error("failed to update order",
 			zap.Int("order_id", order.ID),
 			zap.Int("user_id", order.UserID),
 			zap.Float64("weight", order.Weight),
 			zap.Int64("price", order.Price.Amount()),
+			zap.String("currency", order.Price.Currency().Code),
 			zap.Int("packaging", int(order.Packaging)),
 			zap.Int("extra_packaging", int(order.ExtraPackaging)),
 			zap.Int("status", int(order.Status)),
@@ -233,7 +238,8 @@ func (r *OrdersRepo) GetByID(ctx context.Context, tx pgx.Tx, id int) (models.Ord
 		&someOrder.Status,
 		&someOrder.ArrivalDate,
 		&someOrder.ExpiryDate,
-		&someOrder.LastChange)
+		&someOrder.LastChange,
+		&someOrder.Currency)
 	if err != nil {
 		r.logger.Error("failed to get order",
 			zap.Int("id", id),
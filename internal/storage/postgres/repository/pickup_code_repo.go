@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// PickupCodesRepo is a structure for pickup codes repo
+type PickupCodesRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewPickupCodesRepo creates an instance of pickup codes repo
+func NewPickupCodesRepo(logger *zap.Logger, db database) *PickupCodesRepo {
+	return &PickupCodesRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errSetPickupCodeFailed    = errors.New("failed to set pickup code")
+	errGetPickupCodeFailed    = errors.New("failed to get pickup code")
+	errDeletePickupCodeFailed = errors.New("failed to delete pickup code")
+	errRegisterAttemptFailed  = errors.New("failed to register pickup attempt")
+	errFindingPickupCode      = errors.New("failed to find pickup code")
+	errNoSuchPickupCode       = errors.New("no such pickup code")
+)
+
+// SetPickupCode saves pickup code for an order, replacing the previous one and its attempts
+func (r *PickupCodesRepo) SetPickupCode(ctx context.Context, tx pgx.Tx, code models.PickupCode) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.SetPickupCode")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						INSERT INTO pickup_codes(order_id, code_hash, failed_attempts, locked_until, created_at)
+						VALUES ($1, $2, 0, NULL, $3)
+						ON CONFLICT (order_id) DO UPDATE
+						SET code_hash = EXCLUDED.code_hash,
+							failed_attempts = 0,
+							locked_until = NULL,
+							created_at = EXCLUDED.created_at
+						`, code.OrderID, code.CodeHash, code.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to set pickup code",
+			zap.Int("order_id", code.OrderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errSetPickupCodeFailed)
+
+		return errSetPickupCodeFailed
+	}
+
+	return nil
+}
+
+// GetPickupCode gets pickup code of an order
+func (r *PickupCodesRepo) GetPickupCode(ctx context.Context, tx pgx.Tx, orderID int) (models.PickupCode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetPickupCode")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var code models.PickupCode
+	var lockedUntil sql.NullTime
+	err := execQueryRow(ctx, `
+							SELECT order_id, code_hash, failed_attempts, locked_until, created_at
+							FROM pickup_codes
+							WHERE order_id = $1
+							`, orderID).Scan(
+		&code.OrderID,
+		&code.CodeHash,
+		&code.FailedAttempts,
+		&lockedUntil,
+		&code.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to get pickup code",
+			zap.Int("order_id", orderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetPickupCodeFailed)
+
+		return models.PickupCode{}, errGetPickupCodeFailed
+	}
+	code.LockedUntil = lockedUntil.Time
+
+	return code, nil
+}
+
+// ContainsPickupCode checks if pickup code was issued for an order
+func (r *PickupCodesRepo) ContainsPickupCode(ctx context.Context, tx pgx.Tx, orderID int) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsPickupCode")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var exists bool
+	err := execQueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM pickup_codes WHERE order_id = $1)", orderID).
+		Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check if pickup code exists",
+			zap.Int("order_id", orderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingPickupCode)
+
+		return false, errFindingPickupCode
+	}
+
+	return exists, nil
+}
+
+// RegisterFailedAttempt increments failed attempts of an order pickup code,
+// when maxAttempts is reached the code is locked until lockedUntil and the counter starts over
+func (r *PickupCodesRepo) RegisterFailedAttempt(ctx context.Context, tx pgx.Tx, orderID int, maxAttempts int,
+	lockedUntil time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RegisterFailedAttempt")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	tag, err := exec(ctx, `
+						UPDATE pickup_codes
+						SET failed_attempts = CASE WHEN failed_attempts + 1 >= $2 THEN 0
+												   ELSE failed_attempts + 1 END,
+							locked_until    = CASE WHEN failed_attempts + 1 >= $2 THEN $3
+												   ELSE locked_until END
+						WHERE order_id = $1
+						`, orderID, maxAttempts, lockedUntil)
+	if err != nil {
+		r.logger.Error("failed to register pickup attempt",
+			zap.Int("order_id", orderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRegisterAttemptFailed)
+
+		return errRegisterAttemptFailed
+	}
+	if tag.RowsAffected() == 0 {
+		span.SetTag("error", errNoSuchPickupCode)
+
+		return errNoSuchPickupCode
+	}
+
+	return nil
+}
+
+// DeletePickupCode deletes pickup code of an order once it was used
+func (r *PickupCodesRepo) DeletePickupCode(ctx context.Context, tx pgx.Tx, orderID int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.DeletePickupCode")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, "DELETE FROM pickup_codes WHERE order_id = $1", orderID)
+	if err != nil {
+		r.logger.Error("failed to delete pickup code",
+			zap.Int("order_id", orderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errDeletePickupCodeFailed)
+
+		return errDeletePickupCodeFailed
+	}
+
+	return nil
+}
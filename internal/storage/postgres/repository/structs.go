@@ -17,6 +17,7 @@ type database interface {
 	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
 	ExecQueryRow(context.Context, string, ...interface{}) pgx.Row
 	SendBatch(context.Context, *pgx.Batch) pgx.BatchResults
+	BeginTx(context.Context, pgx.TxOptions) (pgx.Tx, error)
 }
 
 type order struct {
@@ -30,6 +31,7 @@ type order struct {
 	ArrivalDate    sql.NullTime         `db:"arrival_date"`
 	ExpiryDate     sql.NullTime         `db:"expiry_date"`
 	LastChange     sql.NullTime         `db:"last_change"`
+	Currency       string               `db:"currency"`
 }
 
 func convertToRepo(someOrder *models.Order) *order {
@@ -44,6 +46,7 @@ func convertToRepo(someOrder *models.Order) *order {
 		ArrivalDate:    sql.NullTime{Time: someOrder.ArrivalDate, Valid: true},
 		ExpiryDate:     sql.NullTime{Time: someOrder.ExpiryDate, Valid: true},
 		LastChange:     sql.NullTime{Time: someOrder.LastChange, Valid: true},
+		Currency:       someOrder.Price.Currency().Code,
 	}
 
 	return orderRepo
@@ -54,7 +57,7 @@ func convertToModel(someOrder *order) *models.Order {
 		ID:             someOrder.ID,
 		UserID:         someOrder.UserID,
 		Weight:         someOrder.Weight,
-		Price:          *money.New(someOrder.Price, money.RUB),
+		Price:          *money.New(someOrder.Price, someOrder.Currency),
 		Packaging:      someOrder.Packaging,
 		ExtraPackaging: someOrder.ExtraPackaging,
 		Status:         someOrder.Status,
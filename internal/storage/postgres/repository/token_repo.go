@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// TokensRepo is a repository for refresh tokens and revoked access tokens
+type TokensRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewTokensRepo creates an instance of tokens repo
+func NewTokensRepo(logger *zap.Logger, db database) *TokensRepo {
+	return &TokensRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errCreateRefreshTokenFailed = errors.New("failed to create refresh token")
+	errGetRefreshTokenFailed    = errors.New("failed to get refresh token")
+	errFindingRefreshToken      = errors.New("failed to find refresh token")
+	errRevokeRefreshTokenFailed = errors.New("failed to revoke refresh token")
+	errRevokeAccessTokenFailed  = errors.New("failed to revoke access token")
+	errFindingRevokedToken      = errors.New("failed to find revoked access token")
+)
+
+// CreateRefreshToken saves refresh token hash
+func (r *TokensRepo) CreateRefreshToken(ctx context.Context, tx pgx.Tx, token models.RefreshToken) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateRefreshToken")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						INSERT INTO refresh_tokens(admin_id, token_hash, expires_at, created_at)
+						VALUES ($1, $2, $3, $4)
+						`, token.AdminID, token.TokenHash, token.ExpiresAt, token.CreatedAt)
+	if err != nil {
+		r.logger.Error("failed to create refresh token",
+			zap.Int("admin_id", token.AdminID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateRefreshTokenFailed)
+
+		return errCreateRefreshTokenFailed
+	}
+
+	return nil
+}
+
+// GetRefreshToken gets refresh token by its hash along with username of its admin,
+// row is locked until the end of transaction so the token can't be rotated twice
+func (r *TokensRepo) GetRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash string) (models.RefreshToken, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetRefreshToken")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var token models.RefreshToken
+	var revokedAt sql.NullTime
+	err := execQueryRow(ctx, `
+							SELECT t.id, t.admin_id, a.username, t.token_hash, t.expires_at, t.created_at, t.revoked_at
+							FROM refresh_tokens t
+							JOIN admins a ON a.id = t.admin_id
+							WHERE t.token_hash = $1
+							FOR UPDATE OF t
+							`, tokenHash).Scan(
+		&token.ID,
+		&token.AdminID,
+		&token.Username,
+		&token.TokenHash,
+		&token.ExpiresAt,
+		&token.CreatedAt,
+		&revokedAt)
+	if err != nil {
+		r.logger.Error("failed to get refresh token", zap.Error(err))
+		span.SetTag("error", errGetRefreshTokenFailed)
+
+		return models.RefreshToken{}, errGetRefreshTokenFailed
+	}
+	if revokedAt.Valid {
+		token.RevokedAt = &revokedAt.Time
+	}
+
+	return token, nil
+}
+
+// ContainsRefreshToken checks if refresh token with such hash was issued
+func (r *TokensRepo) ContainsRefreshToken(ctx context.Context, tx pgx.Tx, tokenHash string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsRefreshToken")
+	defer span.Finish()
+
+	execQueryRow := r.db.ExecQueryRow
+	if tx != nil {
+		execQueryRow = tx.QueryRow
+	}
+
+	var exists bool
+	err := execQueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM refresh_tokens WHERE token_hash = $1)", tokenHash).
+		Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check if refresh token exists", zap.Error(err))
+		span.SetTag("error", errFindingRefreshToken)
+
+		return false, errFindingRefreshToken
+	}
+
+	return exists, nil
+}
+
+// RevokeRefreshToken marks refresh token as used
+func (r *TokensRepo) RevokeRefreshToken(ctx context.Context, tx pgx.Tx, id int, revokedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RevokeRefreshToken")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						UPDATE refresh_tokens
+						SET revoked_at = $1
+						WHERE id = $2 AND revoked_at IS NULL
+						`, revokedAt, id)
+	if err != nil {
+		r.logger.Error("failed to revoke refresh token",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRevokeRefreshTokenFailed)
+
+		return errRevokeRefreshTokenFailed
+	}
+
+	return nil
+}
+
+// RevokeAdminRefreshTokens revokes all active refresh tokens of an admin
+func (r *TokensRepo) RevokeAdminRefreshTokens(ctx context.Context, tx pgx.Tx, adminID int,
+	revokedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RevokeAdminRefreshTokens")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						UPDATE refresh_tokens
+						SET revoked_at = $1
+						WHERE admin_id = $2 AND revoked_at IS NULL
+						`, revokedAt, adminID)
+	if err != nil {
+		r.logger.Error("failed to revoke admin refresh tokens",
+			zap.Int("admin_id", adminID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRevokeRefreshTokenFailed)
+
+		return errRevokeRefreshTokenFailed
+	}
+
+	return nil
+}
+
+// RevokeAccessToken puts access token into revocation list until it expires
+func (r *TokensRepo) RevokeAccessToken(ctx context.Context, jti string, expiresAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.RevokeAccessToken")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							INSERT INTO revoked_tokens(jti, expires_at)
+							VALUES ($1, $2)
+							ON CONFLICT (jti) DO NOTHING
+							`, jti, expiresAt)
+	if err != nil {
+		r.logger.Error("failed to revoke access token",
+			zap.String("jti", jti),
+			zap.Error(err),
+		)
+		span.SetTag("error", errRevokeAccessTokenFailed)
+
+		return errRevokeAccessTokenFailed
+	}
+
+	return nil
+}
+
+// IsAccessTokenRevoked checks if access token is in revocation list
+func (r *TokensRepo) IsAccessTokenRevoked(ctx context.Context, jti string) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.IsAccessTokenRevoked")
+	defer span.Finish()
+
+	var exists bool
+	err := r.db.Get(ctx, &exists, "SELECT EXISTS(SELECT 1 FROM revoked_tokens WHERE jti = $1)", jti)
+	if err != nil {
+		r.logger.Error("failed to check if access token is revoked",
+			zap.String("jti", jti),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingRevokedToken)
+
+		return false, errFindingRevokedToken
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
+)
+
+// WebhooksRepo is a repository for webhook subscriptions and their deliveries
+type WebhooksRepo struct {
+	db     database
+	logger *zap.Logger
+}
+
+// NewWebhooksRepo creates an instance of webhooks repo
+func NewWebhooksRepo(logger *zap.Logger, db database) *WebhooksRepo {
+	return &WebhooksRepo{
+		db:     db,
+		logger: logger,
+	}
+}
+
+var (
+	errCreateSubscriptionFailed  = errors.New("failed to create webhook subscription")
+	errGetSubscriptionsFailed    = errors.New("failed to get webhook subscriptions")
+	errDeleteSubscriptionFailed  = errors.New("failed to delete webhook subscription")
+	errFindingSubscription       = errors.New("failed to find webhook subscription")
+	errEnqueueWebhookEventFailed = errors.New("failed to enqueue webhook event")
+	errGetDeliveriesFailed       = errors.New("failed to get webhook deliveries")
+	errUpdateDeliveryFailed      = errors.New("failed to update webhook delivery")
+	errGetExpiredOrdersFailed    = errors.New("failed to get expired orders")
+)
+
+type webhookSubscription struct {
+	ID         int       `db:"id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+func (s *webhookSubscription) toModel() models.WebhookSubscription {
+	events := make([]models.WebhookEvent, 0, len(s.EventTypes))
+	for _, event := range s.EventTypes {
+		events = append(events, models.WebhookEvent(event))
+	}
+
+	return models.WebhookSubscription{
+		ID:        s.ID,
+		URL:       s.URL,
+		Secret:    s.Secret,
+		Events:    events,
+		CreatedAt: s.CreatedAt,
+	}
+}
+
+// CreateSubscription creates webhook subscription and returns its id
+func (r *WebhooksRepo) CreateSubscription(ctx context.Context,
+	subscription models.WebhookSubscription) (int, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.CreateSubscription")
+	defer span.Finish()
+
+	events := make([]string, 0, len(subscription.Events))
+	for _, event := range subscription.Events {
+		events = append(events, string(event))
+	}
+
+	var id int
+	err := r.db.ExecQueryRow(ctx, `
+								INSERT INTO webhook_subscriptions(url, secret, event_types, created_at)
+								VALUES ($1, $2, $3, $4)
+								RETURNING id
+								`, subscription.URL, subscription.Secret, events, subscription.CreatedAt).Scan(&id)
+	if err != nil {
+		r.logger.Error("failed to create webhook subscription",
+			zap.String("url", subscription.URL),
+			zap.Error(err),
+		)
+		span.SetTag("error", errCreateSubscriptionFailed)
+
+		return 0, errCreateSubscriptionFailed
+	}
+
+	return id, nil
+}
+
+// GetSubscriptions gets all webhook subscriptions
+func (r *WebhooksRepo) GetSubscriptions(ctx context.Context) ([]models.WebhookSubscription, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetSubscriptions")
+	defer span.Finish()
+
+	var tmp []webhookSubscription
+	err := r.db.Select(ctx, &tmp, `
+								SELECT id, url, secret, event_types, created_at
+								FROM webhook_subscriptions
+								ORDER BY id
+								`)
+	if err != nil {
+		r.logger.Error("failed to get webhook subscriptions", zap.Error(err))
+		span.SetTag("error", errGetSubscriptionsFailed)
+
+		return nil, errGetSubscriptionsFailed
+	}
+
+	subscriptions := make([]models.WebhookSubscription, 0, len(tmp))
+	for x := range tmp {
+		subscriptions = append(subscriptions, tmp[x].toModel())
+	}
+
+	return subscriptions, nil
+}
+
+// ContainsSubscription checks if webhook subscription exists
+func (r *WebhooksRepo) ContainsSubscription(ctx context.Context, id int) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.ContainsSubscription")
+	defer span.Finish()
+
+	var exists bool
+	err := r.db.ExecQueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM webhook_subscriptions WHERE id = $1)", id).
+		Scan(&exists)
+	if err != nil {
+		r.logger.Error("failed to check if webhook subscription exists",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errFindingSubscription)
+
+		return false, errFindingSubscription
+	}
+
+	return exists, nil
+}
+
+// DeleteSubscription deletes webhook subscription along with its deliveries
+func (r *WebhooksRepo) DeleteSubscription(ctx context.Context, id int) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.DeleteSubscription")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	if err != nil {
+		r.logger.Error("failed to delete webhook subscription",
+			zap.Int("id", id),
+			zap.Error(err),
+		)
+		span.SetTag("error", errDeleteSubscriptionFailed)
+
+		return errDeleteSubscriptionFailed
+	}
+
+	return nil
+}
+
+// EnqueueEvent creates delivery of an event for every subscription interested in it
+func (r *WebhooksRepo) EnqueueEvent(ctx context.Context, tx pgx.Tx, event models.WebhookEvent, orderID int,
+	payload []byte) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.EnqueueEvent")
+	defer span.Finish()
+
+	exec := r.db.Exec
+	if tx != nil {
+		exec = tx.Exec
+	}
+
+	_, err := exec(ctx, `
+						INSERT INTO webhook_deliveries(subscription_id, event_type, order_id, payload)
+						SELECT id, $1, $2, $3
+						FROM webhook_subscriptions
+						WHERE $1 = ANY (event_types)
+						ON CONFLICT (subscription_id, event_type, order_id) DO NOTHING
+						`, string(event), orderID, payload)
+	if err != nil {
+		r.logger.Error("failed to enqueue webhook event",
+			zap.String("event", string(event)),
+			zap.Int("order_id", orderID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errEnqueueWebhookEventFailed)
+
+		return errEnqueueWebhookEventFailed
+	}
+
+	return nil
+}
+
+// GetAndMarkDeliveries gets deliveries ready to be sent and marks them as being processed
+func (r *WebhooksRepo) GetAndMarkDeliveries(ctx context.Context, batchSize int) ([]models.WebhookDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetAndMarkDeliveries")
+	defer span.Finish()
+
+	deliveries := make([]models.WebhookDelivery, 0)
+	err := r.db.Select(ctx, &deliveries, `
+									WITH cte AS (
+										SELECT id
+										FROM webhook_deliveries
+										WHERE ((job_status = 1 OR job_status = 3) AND next_attempt_at <= now()) OR
+											(job_status = 2 AND updated_at < (now() - INTERVAL '5 minutes'))
+										ORDER BY next_attempt_at
+										LIMIT $1
+										FOR UPDATE SKIP LOCKED
+									),
+									updated_deliveries AS (
+										UPDATE webhook_deliveries
+											SET job_status = 2,
+												updated_at = now()
+											WHERE id IN (SELECT id FROM cte)
+											RETURNING *
+									)
+									SELECT * FROM updated_deliveries ORDER BY next_attempt_at;
+									`, batchSize)
+	if err != nil {
+		r.logger.Error("failed to get webhook deliveries",
+			zap.Int("batch_size", batchSize),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetDeliveriesFailed)
+
+		return nil, errGetDeliveriesFailed
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery saves result of a delivery attempt
+func (r *WebhooksRepo) UpdateDelivery(ctx context.Context, delivery models.WebhookDelivery) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.UpdateDelivery")
+	defer span.Finish()
+
+	_, err := r.db.Exec(ctx, `
+							UPDATE webhook_deliveries
+							SET job_status = $1,
+								attempts_left = $2,
+								next_attempt_at = $3,
+								response_status = $4,
+								last_error = $5,
+								updated_at = now()
+							WHERE id = $6
+							`, delivery.JobStatus, delivery.AttemptsLeft, delivery.NextAttemptAt,
+		delivery.ResponseStatus, delivery.LastError, delivery.ID)
+	if err != nil {
+		r.logger.Error("failed to update webhook delivery",
+			zap.Int("id", delivery.ID),
+			zap.Error(err),
+		)
+		span.SetTag("error", errUpdateDeliveryFailed)
+
+		return errUpdateDeliveryFailed
+	}
+
+	return nil
+}
+
+// GetDeliveries gets deliveries that satisfy conditions, newest first
+func (r *WebhooksRepo) GetDeliveries(ctx context.Context, params []query.Cond, count int,
+	page int) ([]models.WebhookDelivery, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetDeliveries")
+	defer span.Finish()
+
+	selectQuery, args := query.BuildSelectQuery("webhook_deliveries",
+		query.Where(params...),
+		query.OrderBy("id"),
+		query.Desc(true),
+		query.Limit(count),
+		query.Offset(page*count),
+	)
+
+	deliveries := make([]models.WebhookDelivery, 0)
+	err := r.db.Select(ctx, &deliveries, selectQuery, args...)
+	if err != nil {
+		r.logger.Error("failed to get webhook deliveries",
+			zap.String("query", selectQuery),
+			zap.Any("params", args),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetDeliveriesFailed)
+
+		return nil, errGetDeliveriesFailed
+	}
+
+	return deliveries, nil
+}
+
+// GetExpiredOrders gets stored orders expired in [from, to) that somebody is subscribed to,
+// but expiry event wasn't enqueued for yet
+func (r *WebhooksRepo) GetExpiredOrders(ctx context.Context, from time.Time, to time.Time,
+	count int) ([]models.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repo.GetExpiredOrders")
+	defer span.Finish()
+
+	var tmp []order
+	err := r.db.Select(ctx, &tmp, `
+								SELECT o.*
+								FROM orders o
+								WHERE o.status = $1
+								  AND o.expiry_date >= $2
+								  AND o.expiry_date < $3
+								  AND EXISTS(SELECT 1
+											 FROM webhook_subscriptions s
+											 WHERE $4 = ANY (s.event_types))
+								  AND NOT EXISTS(SELECT 1
+												 FROM webhook_deliveries d
+												 WHERE d.order_id = o.id
+												   AND d.event_type = $4)
+								ORDER BY o.expiry_date
+								LIMIT $5
+								`, models.StoredOrder, from, to, string(models.OrderExpiredEvent), count)
+	if err != nil {
+		r.logger.Error("failed to get expired orders",
+			zap.Time("from", from),
+			zap.Time("to", to),
+			zap.Error(err),
+		)
+		span.SetTag("error", errGetExpiredOrdersFailed)
+
+		return nil, errGetExpiredOrdersFailed
+	}
+
+	orders := make([]models.Order, 0, len(tmp))
+	for x := range tmp {
+		orders = append(orders, *convertToModel(&tmp[x]))
+	}
+
+	return orders, nil
+}
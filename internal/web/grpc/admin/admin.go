@@ -4,26 +4,49 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
 )
 
 // Handler is a gRPC admin handler implementation
 type Handler struct {
-	Service admin.Service
+	Service     admin.Service
+	AuthService auth.Service
 	proto.UnimplementedAdminServiceServer
 	logger *zap.Logger
 }
 
 var (
-	errMissingFields = status.Errorf(codes.InvalidArgument, "missing fields")
+	errMissingFields   = status.Errorf(codes.InvalidArgument, "missing fields")
+	errUnauthenticated = status.Errorf(codes.Unauthenticated, "unauthenticated")
 )
 
-// NewHandler creates an instance of new grpc admin Handler
-func NewHandler(logger *zap.Logger, service admin.Service) *Handler {
+// NewHandler creates an instance of new grpc admin Handler, auth service is used to unlock admins
+func NewHandler(logger *zap.Logger, service admin.Service, authService auth.Service) *Handler {
 	return &Handler{
-		Service: service,
-		logger:  logger,
+		Service:     service,
+		AuthService: authService,
+		logger:      logger,
 	}
 }
+
+// toProto converts admin to its grpc representation, password hash is never sent
+func toProto(someAdmin models.Admin) *proto.Admin {
+	res := &proto.Admin{
+		Id:                int32(someAdmin.ID),
+		Username:          someAdmin.Username,
+		Role:              someAdmin.Role.String(),
+		Status:            someAdmin.Status(),
+		CreatedAt:         timestamppb.New(someAdmin.CreatedAt),
+		PasswordChangedAt: timestamppb.New(someAdmin.PasswordChangedAt),
+	}
+	if someAdmin.DeactivatedAt != nil {
+		res.DeactivatedAt = timestamppb.New(*someAdmin.DeactivatedAt)
+	}
+
+	return res
+}
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"errors"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
@@ -9,6 +10,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
 )
 
@@ -36,11 +38,26 @@ func (h *Handler) CreateAdmin(ctx context.Context, req *proto.CreateAdminRequest
 		return nil, errMissingFields
 	}
 
-	admin := *models.NewAdmin(int(req.GetId()), req.GetUsername(), req.GetPassword())
+	role, err := models.ParseRole(req.GetRole())
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	admin := models.Admin{
+		ID:       int(req.GetId()),
+		Username: req.GetUsername(),
+		Role:     role,
+	}
 	span.SetTag("admin_id", int(req.GetId()))
 
-	err := h.Service.CreateAdmin(ctx, admin)
-	if err != nil {
+	err = h.Service.CreateAdmin(ctx, admin, req.GetPassword())
+	if errors.Is(err, password.ErrPolicyViolation) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		span.SetTag("error", err)
 
 		return nil, status.Error(codes.Internal, err.Error())
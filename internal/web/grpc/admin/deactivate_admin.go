@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+)
+
+// DeactivateAdmin is a grpc handler over service for deactivating admin, deactivated admin can't log in
+func (h *Handler) DeactivateAdmin(ctx context.Context,
+	req *proto.DeactivateAdminRequest) (*proto.DeactivateAdminResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.DeactivateAdmin")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "DeactivateAdmin"),
+	)
+
+	logger.Info("Received request to deactivate admin",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	err := h.Service.DeactivateAdmin(ctx, req.GetUsername())
+	if errors.Is(err, admin.ErrAdminDoesntExist) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully deactivated admin",
+		zap.String("username", req.GetUsername()),
+	)
+	span.SetTag("username", req.GetUsername())
+
+	return &proto.DeactivateAdminResponse{
+		Output: "success",
+	}, nil
+}
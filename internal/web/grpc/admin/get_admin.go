@@ -0,0 +1,52 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+)
+
+// GetAdmin is a grpc handler over service for getting admin by username
+func (h *Handler) GetAdmin(ctx context.Context, req *proto.GetAdminRequest) (*proto.GetAdminResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.GetAdmin")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "GetAdmin"),
+	)
+
+	logger.Info("Received request to get admin",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	someAdmin, err := h.Service.GetAdminByUsername(ctx, req.GetUsername())
+	if errors.Is(err, admin.ErrAdminDoesntExist) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GetAdminResponse{
+		Admin: toProto(someAdmin),
+	}, nil
+}
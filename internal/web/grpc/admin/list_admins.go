@@ -0,0 +1,61 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+)
+
+// ListAdmins is a grpc handler over service for getting a page of admins
+func (h *Handler) ListAdmins(ctx context.Context, req *proto.ListAdminsRequest) (*proto.ListAdminsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ListAdmins")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "ListAdmins"),
+	)
+
+	logger.Info("Received request to list admins",
+		zap.String("role", req.GetRole()),
+		zap.String("status", req.GetStatus()),
+	)
+
+	var role models.Role
+	if req.GetRole() != "" {
+		var err error
+		role, err = models.ParseRole(req.GetRole())
+		if err != nil {
+			span.SetTag("error", err)
+
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	admins, err := h.Service.ListAdmins(ctx, role, req.GetStatus(), int(req.GetCount()), int(req.GetPage()))
+	if errors.Is(err, admin.ErrUnknownStatus) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.ListAdminsResponse{
+		Admins: make([]*proto.Admin, 0, len(admins)),
+	}
+	for _, someAdmin := range admins {
+		resp.Admins = append(resp.Admins, toProto(someAdmin))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+)
+
+// ReactivateAdmin is a grpc handler over service for reactivating deactivated admin
+func (h *Handler) ReactivateAdmin(ctx context.Context,
+	req *proto.ReactivateAdminRequest) (*proto.ReactivateAdminResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ReactivateAdmin")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "ReactivateAdmin"),
+	)
+
+	logger.Info("Received request to reactivate admin",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	err := h.Service.ReactivateAdmin(ctx, req.GetUsername())
+	if errors.Is(err, admin.ErrAdminDoesntExist) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully reactivated admin",
+		zap.String("username", req.GetUsername()),
+	)
+	span.SetTag("username", req.GetUsername())
+
+	return &proto.ReactivateAdminResponse{
+		Output: "success",
+	}, nil
+}
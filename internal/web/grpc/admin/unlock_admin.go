@@ -0,0 +1,66 @@
+package admin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+)
+
+// UnlockAdmin is a grpc handler over service for lifting login lock of an admin
+func (h *Handler) UnlockAdmin(ctx context.Context, req *proto.UnlockAdminRequest) (*proto.UnlockAdminResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.UnlockAdmin")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "UnlockAdmin"),
+	)
+
+	logger.Info("Received request to unlock admin",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	// the unlock is attributed to the admin performing it
+	actor, ok := auth_handler.AdminFromContext(ctx)
+	if !ok {
+		span.SetTag("error", errUnauthenticated)
+
+		return nil, errUnauthenticated
+	}
+
+	err := h.AuthService.Unlock(ctx, actor.ID, req.GetUsername())
+	if errors.Is(err, auth.ErrAdminDoesntExist) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully unlocked admin",
+		zap.String("username", req.GetUsername()),
+	)
+	span.SetTag("username", req.GetUsername())
+
+	return &proto.UnlockAdminResponse{
+		Output: "success",
+	}, nil
+}
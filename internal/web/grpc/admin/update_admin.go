@@ -2,13 +2,14 @@ package admin
 
 import (
 	"context"
+	"errors"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
 )
 
@@ -35,11 +36,12 @@ func (h *Handler) UpdateAdmin(ctx context.Context, req *proto.UpdateAdminRequest
 		return nil, errMissingFields
 	}
 
-	admin := *models.NewAdmin(0, req.GetUsername(), req.GetNewPassword())
-	span.SetTag("created username", admin.Username)
+	err := h.Service.UpdateAdmin(ctx, req.GetUsername(), req.GetPassword(), req.GetNewPassword())
+	if errors.Is(err, password.ErrPolicyViolation) {
+		span.SetTag("error", err)
 
-	err := h.Service.UpdateAdmin(ctx, req.GetUsername(), req.GetPassword(), admin)
-	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		span.SetTag("error", err)
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -48,7 +50,7 @@ func (h *Handler) UpdateAdmin(ctx context.Context, req *proto.UpdateAdminRequest
 	logger.Info("Successfully updated admin",
 		zap.String("username", req.GetUsername()),
 	)
-	span.SetTag("success username", admin.Username)
+	span.SetTag("success username", req.GetUsername())
 
 	return &proto.UpdateAdminResponse{
 		Output: "success",
@@ -0,0 +1,56 @@
+package apikey
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+)
+
+// Handler is a gRPC API key handler implementation
+type Handler struct {
+	Service apikey.Service
+	proto.UnimplementedAPIKeyServiceServer
+	logger *zap.Logger
+}
+
+var (
+	errMissingFields   = status.Errorf(codes.InvalidArgument, "missing fields")
+	errUnauthenticated = status.Errorf(codes.Unauthenticated, "unauthenticated")
+)
+
+// NewHandler creates an instance of new grpc API key Handler
+func NewHandler(logger *zap.Logger, service apikey.Service) *Handler {
+	return &Handler{
+		Service: service,
+		logger:  logger,
+	}
+}
+
+func toProto(key models.APIKey) *proto.APIKey {
+	scopes := make([]string, 0, len(key.Scopes))
+	for _, scope := range key.Scopes {
+		scopes = append(scopes, string(scope))
+	}
+
+	res := &proto.APIKey{
+		Id:        int32(key.ID),
+		Name:      key.Name,
+		Prefix:    key.Prefix,
+		Scopes:    scopes,
+		CreatedBy: int32(key.CreatedBy),
+		CreatedAt: timestamppb.New(key.CreatedAt),
+	}
+	if key.LastUsedAt != nil {
+		res.LastUsedAt = timestamppb.New(*key.LastUsedAt)
+	}
+	if key.RevokedAt != nil {
+		res.RevokedAt = timestamppb.New(*key.RevokedAt)
+	}
+
+	return res
+}
@@ -0,0 +1,71 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+)
+
+// CreateAPIKey is a grpc handler over service for creating API key on behalf of the caller
+func (h *Handler) CreateAPIKey(ctx context.Context,
+	req *proto.CreateAPIKeyRequest) (*proto.CreateAPIKeyResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.CreateAPIKey")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "CreateAPIKey"),
+	)
+
+	logger.Info("Received request to create api key",
+		zap.String("name", req.GetName()),
+		zap.Strings("scopes", req.GetScopes()),
+	)
+
+	if req.GetName() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	creator, ok := auth.AdminFromContext(ctx)
+	if !ok {
+		span.SetTag("error", errUnauthenticated)
+
+		return nil, errUnauthenticated
+	}
+
+	key, apiKey, err := h.Service.CreateAPIKey(ctx, creator.ID, req.GetName(), req.GetScopes())
+	switch {
+	case errors.Is(err, apikey.ErrNoName) || errors.Is(err, apikey.ErrNoScopes) ||
+		errors.Is(err, models.ErrUnknownScope):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully created api key",
+		zap.Int("id", apiKey.ID),
+		zap.String("prefix", apiKey.Prefix),
+	)
+
+	return &proto.CreateAPIKeyResponse{
+		Key:    key,
+		ApiKey: toProto(apiKey),
+	}, nil
+}
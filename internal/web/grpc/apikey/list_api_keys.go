@@ -0,0 +1,38 @@
+package apikey
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+)
+
+// ListAPIKeys is a grpc handler over service for getting API keys
+func (h *Handler) ListAPIKeys(ctx context.Context, _ *proto.ListAPIKeysRequest) (*proto.ListAPIKeysResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ListAPIKeys")
+	defer span.Finish()
+
+	h.logger.Info("Received request to list api keys",
+		zap.String("handler", "ListAPIKeys"),
+	)
+
+	keys, err := h.Service.GetAPIKeys(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.ListAPIKeysResponse{
+		ApiKeys: make([]*proto.APIKey, 0, len(keys)),
+	}
+	for _, key := range keys {
+		resp.ApiKeys = append(resp.ApiKeys, toProto(key))
+	}
+
+	return resp, nil
+}
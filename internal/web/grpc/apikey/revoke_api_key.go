@@ -0,0 +1,53 @@
+package apikey
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+)
+
+// RevokeAPIKey is a grpc handler over service for revoking API key by its prefix
+func (h *Handler) RevokeAPIKey(ctx context.Context,
+	req *proto.RevokeAPIKeyRequest) (*proto.RevokeAPIKeyResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.RevokeAPIKey")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "RevokeAPIKey"),
+	)
+
+	logger.Info("Received request to revoke api key",
+		zap.String("prefix", req.GetPrefix()),
+	)
+
+	if req.GetPrefix() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	err := h.Service.RevokeAPIKey(ctx, req.GetPrefix())
+	if errors.Is(err, apikey.ErrAPIKeyNotFound) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RevokeAPIKeyResponse{
+		Output: "success",
+	}, nil
+}
@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+)
+
+// Handler is a gRPC audit log handler implementation
+type Handler struct {
+	Service logs.Service
+	proto.UnimplementedAuditServiceServer
+	logger *zap.Logger
+}
+
+// NewHandler creates an instance of new grpc audit log Handler
+func NewHandler(logger *zap.Logger, service logs.Service) *Handler {
+	return &Handler{
+		Service: service,
+		logger:  logger,
+	}
+}
+
+func toProto(log models.Log) *proto.Log {
+	res := &proto.Log{
+		Id:            int32(log.ID),
+		OrderId:       int32(log.OrderID),
+		AdminId:       int32(log.AdminID),
+		Message:       log.Message,
+		Date:          timestamppb.New(log.Date),
+		Url:           log.URL,
+		Method:        log.Method,
+		Status:        int32(log.Status),
+		JobStatus:     int32(log.JobStatus),
+		AttemptsLeft:  int32(log.AttemptsLeft),
+		UpdatedAt:     timestamppb.New(log.UpdatedAt),
+		PrevHash:      log.PrevHash,
+		Hash:          log.Hash,
+		NextAttemptAt: timestamppb.New(log.NextAttemptAt),
+	}
+	if len(log.Diff) != 0 {
+		res.Diff = make(map[string]*proto.Change, len(log.Diff))
+		for name, change := range log.Diff {
+			res.Diff[name] = &proto.Change{
+				Old: change.OldJSON(),
+				New: change.NewJSON(),
+			}
+		}
+	}
+	if log.APIKeyID != nil {
+		apiKeyID := int32(*log.APIKeyID)
+		res.ApiKeyId = &apiKeyID
+	}
+
+	return res
+}
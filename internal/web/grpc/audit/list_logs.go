@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+)
+
+// ListLogs is a grpc handler over service for getting a page of audit logs
+func (h *Handler) ListLogs(ctx context.Context, req *proto.ListLogsRequest) (*proto.ListLogsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ListLogs")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "ListLogs"),
+	)
+
+	logger.Info("Received request to list audit logs",
+		zap.Int32("admin_id", req.GetAdminId()),
+		zap.Int32("order_id", req.GetOrderId()),
+		zap.String("method", req.GetMethod()),
+		zap.Int32("status", req.GetStatus()),
+	)
+
+	filter := models.LogFilter{
+		AdminID:   int(req.GetAdminId()),
+		OrderID:   int(req.GetOrderId()),
+		Method:    req.GetMethod(),
+		Status:    int(req.GetStatus()),
+		JobStatus: int(req.GetJobStatus()),
+	}
+	if req.GetDateFrom() != nil {
+		filter.From = req.GetDateFrom().AsTime()
+	}
+	if req.GetDateTo() != nil {
+		filter.To = req.GetDateTo().AsTime()
+	}
+
+	auditLogs, err := h.Service.ListLogs(ctx, filter, int(req.GetCount()), int(req.GetPage()))
+	if errors.Is(err, logs.ErrWrongDateRange) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.ListLogsResponse{
+		Logs: make([]*proto.Log, 0, len(auditLogs)),
+	}
+	for _, log := range auditLogs {
+		resp.Logs = append(resp.Logs, toProto(log))
+	}
+
+	return resp, nil
+}
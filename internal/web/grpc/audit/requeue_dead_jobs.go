@@ -0,0 +1,46 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+)
+
+// RequeueDeadJobs is a grpc handler over service for sending dead audit jobs to Kafka again
+func (h *Handler) RequeueDeadJobs(ctx context.Context,
+	req *proto.RequeueDeadJobsRequest) (*proto.RequeueDeadJobsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.RequeueDeadJobs")
+	defer span.Finish()
+
+	h.logger.Info("Received request to requeue dead jobs",
+		zap.String("handler", "RequeueDeadJobs"),
+		zap.Int32s("ids", req.GetIds()),
+	)
+
+	var ids []int
+	for _, id := range req.GetIds() {
+		ids = append(ids, int(id))
+	}
+
+	requeued, err := h.Service.RequeueDeadJobs(ctx, ids)
+	if errors.Is(err, logs.ErrWrongLogID) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.RequeueDeadJobsResponse{
+		Requeued: int32(requeued),
+	}, nil
+}
@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+)
+
+// VerifyAuditChain is a grpc handler over service for verifying hash chain of audit logs
+func (h *Handler) VerifyAuditChain(ctx context.Context,
+	_ *proto.VerifyAuditChainRequest) (*proto.VerifyAuditChainResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.VerifyAuditChain")
+	defer span.Finish()
+
+	h.logger.Info("Received request to verify audit chain",
+		zap.String("handler", "VerifyAuditChain"),
+	)
+
+	report, err := h.Service.VerifyAuditChain(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.VerifyAuditChainResponse{
+		Checked:     int32(report.Checked),
+		Valid:       report.Valid,
+		BrokenLogId: int32(report.BrokenLogID),
+		Reason:      report.Reason,
+	}, nil
+}
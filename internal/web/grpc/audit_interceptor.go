@@ -0,0 +1,136 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	admin_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+	apikey_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+	audit_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+	client_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
+	order_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+	webhook_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// auditMethod is written to audit logs instead of http method
+const auditMethod = "GRPC"
+
+// auditedMethods are methods that change state, calls to them are written to audit log
+var auditedMethods = map[string]bool{
+	order_proto.OrderService_CreateOrder_FullMethodName:    true,
+	order_proto.OrderService_UpdateOrder_FullMethodName:    true,
+	order_proto.OrderService_DeleteOrder_FullMethodName:    true,
+	order_proto.OrderService_RegenerateCode_FullMethodName: true,
+
+	client_proto.ClientService_CreateClient_FullMethodName: true,
+
+	admin_proto.AdminService_CreateAdmin_FullMethodName:     true,
+	admin_proto.AdminService_UpdateAdmin_FullMethodName:     true,
+	admin_proto.AdminService_DeleteAdmin_FullMethodName:     true,
+	admin_proto.AdminService_UnlockAdmin_FullMethodName:     true,
+	admin_proto.AdminService_DeactivateAdmin_FullMethodName: true,
+	admin_proto.AdminService_ReactivateAdmin_FullMethodName: true,
+
+	webhook_proto.WebhookService_CreateSubscription_FullMethodName: true,
+	webhook_proto.WebhookService_DeleteSubscription_FullMethodName: true,
+
+	apikey_proto.APIKeyService_CreateAPIKey_FullMethodName: true,
+	apikey_proto.APIKeyService_RevokeAPIKey_FullMethodName: true,
+
+	audit_proto.AuditService_RequeueDeadJobs_FullMethodName: true,
+}
+
+type orderRequest interface {
+	GetId() int32
+}
+
+type outputResponse interface {
+	GetOutput() string
+}
+
+// AuditInterceptor is an interceptor that writes audit logs of calls changing state the same way
+// AuditLoggerMiddleware does for http, it must be chained after auth interceptor. Calls finishing
+// during graceful stop are logged too, audit logger is shut down after the server
+func AuditInterceptor(auditLogger *auditlogger.Service) grpc.UnaryServerInterceptor {
+	return func(reqCtx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		if !auditedMethods[info.FullMethod] {
+			return handler(reqCtx, req)
+		}
+
+		reqCtx = auditlogger.ContextWithDiffRecorder(reqCtx)
+		resp, err := handler(reqCtx, req)
+
+		// admin is put into context by auth interceptor
+		someAdmin, _ := auth_handler.AdminFromContext(reqCtx)
+		currentLog := *models.NewLog(auditOrderID(info.FullMethod, req), someAdmin.ID, auditMessage(resp, err),
+			info.FullMethod, auditMethod, httpStatusFromCode(status.Code(err)))
+		if someAdmin.APIKeyID != 0 {
+			currentLog.APIKeyID = &someAdmin.APIKeyID
+		}
+		currentLog.Diff = auditlogger.DiffFromContext(reqCtx)
+		auditLogger.CreateLog(context.WithoutCancel(reqCtx), currentLog)
+
+		return resp, err
+	}
+}
+
+// auditOrderID returns id of an order request is about, it's -1 for requests not about orders
+func auditOrderID(method string, req interface{}) int {
+	request, ok := req.(orderRequest)
+	if !ok || !strings.HasPrefix(method, "/"+order_proto.OrderService_ServiceDesc.ServiceName+"/") ||
+		request.GetId() == 0 {
+		return -1
+	}
+
+	return int(request.GetId())
+}
+
+// auditMessage summarizes response without its payload, so tokens, keys and secrets don't get into logs
+func auditMessage(resp interface{}, err error) string {
+	if err != nil {
+		return status.Convert(err).Message()
+	}
+
+	if response, ok := resp.(outputResponse); ok && response.GetOutput() != "" {
+		return response.GetOutput()
+	}
+
+	return codes.OK.String()
+}
+
+// httpStatusFromCode maps grpc code to http status, so audit logs of both transports look the same
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied, codes.FailedPrecondition:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
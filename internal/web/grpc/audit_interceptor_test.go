@@ -0,0 +1,145 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	admin_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+	auth_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+	order_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+)
+
+func TestAuditInterceptor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		method string
+		req    interface{}
+		resp   interface{}
+		err    error
+		diff   models.Diff
+		admin  models.Admin
+		// stopping is true if server is gracefully stopping while call is served
+		stopping    bool
+		expectedLog *models.Log
+	}{
+		{
+			name:   "Successful order call",
+			method: order_proto.OrderService_DeleteOrder_FullMethodName,
+			req:    &order_proto.DeleteOrderRequest{Id: 42},
+			resp:   &order_proto.DeleteOrderResponse{Output: "success"},
+			diff:   models.Diff{"status": {Old: 1}},
+			admin:  models.Admin{ID: 3, Username: "user"},
+			expectedLog: &models.Log{OrderID: 42, AdminID: 3, Message: "success",
+				URL: order_proto.OrderService_DeleteOrder_FullMethodName, Method: auditMethod, Status: http.StatusOK,
+				Diff: models.Diff{"status": {Old: 1}}},
+		},
+		{
+			name:   "Failed call",
+			method: admin_proto.AdminService_DeactivateAdmin_FullMethodName,
+			req:    &admin_proto.DeactivateAdminRequest{Username: "nobody"},
+			err:    status.Error(codes.NotFound, "admin doesn't exist"),
+			admin:  models.Admin{ID: 3, Username: "user"},
+			expectedLog: &models.Log{OrderID: -1, AdminID: 3, Message: "admin doesn't exist",
+				URL: admin_proto.AdminService_DeactivateAdmin_FullMethodName, Method: auditMethod,
+				Status: http.StatusNotFound},
+		},
+		{
+			name:   "Response payload isn't logged",
+			method: order_proto.OrderService_RegenerateCode_FullMethodName,
+			req:    &order_proto.RegenerateCodeRequest{Id: 7},
+			resp:   &order_proto.RegenerateCodeResponse{PickupCode: "1234"},
+			admin:  models.Admin{ID: 1, APIKeyID: 5},
+			expectedLog: &models.Log{OrderID: 7, AdminID: 1, APIKeyID: intPtr(5), Message: codes.OK.String(),
+				URL: order_proto.OrderService_RegenerateCode_FullMethodName, Method: auditMethod,
+				Status: http.StatusOK},
+		},
+		{
+			name:     "Call finishing during graceful stop",
+			method:   order_proto.OrderService_DeleteOrder_FullMethodName,
+			req:      &order_proto.DeleteOrderRequest{Id: 42},
+			resp:     &order_proto.DeleteOrderResponse{Output: "success"},
+			admin:    models.Admin{ID: 3, Username: "user"},
+			stopping: true,
+			expectedLog: &models.Log{OrderID: 42, AdminID: 3, Message: "success",
+				URL: order_proto.OrderService_DeleteOrder_FullMethodName, Method: auditMethod, Status: http.StatusOK},
+		},
+		{
+			name:   "Read method",
+			method: order_proto.OrderService_GetOrders_FullMethodName,
+			req:    &order_proto.GetOrdersRequest{},
+			resp:   &order_proto.GetOrdersResponse{},
+			admin:  models.Admin{ID: 3, Username: "user"},
+		},
+		{
+			name:   "Auth method",
+			method: auth_proto.AuthService_Login_FullMethodName,
+			req:    &auth_proto.LoginRequest{Username: "user", Password: "password"},
+			resp:   &auth_proto.TokenResponse{AccessToken: "token"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			logs := make(chan models.Log, 1)
+			storage := NewMocklogStorage(ctrl)
+			storage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, batch []models.Log) error {
+					for _, log := range batch {
+						logs <- log
+					}
+
+					return nil
+				}).AnyTimes()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			auditLogger, err := auditlogger.NewService(ctx, config.Config{}, storage, nil, 2, 1, 50*time.Millisecond)
+			require.NoError(t, err)
+			if tt.stopping {
+				cancel()
+			}
+
+			reqCtx := auth_handler.ContextWithAdmin(t.Context(), tt.admin)
+			info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+			resp, err := AuditInterceptor(auditLogger)(reqCtx, tt.req, info,
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					auditlogger.RecordDiff(ctx, tt.diff)
+
+					return tt.resp, tt.err
+				})
+			assert.Equal(t, tt.resp, resp)
+			assert.Equal(t, tt.err, err)
+
+			select {
+			case log := <-logs:
+				require.NotNil(t, tt.expectedLog, "unexpected audit log")
+				log.Date = time.Time{}
+				assert.Equal(t, *tt.expectedLog, log)
+			case <-time.After(300 * time.Millisecond):
+				assert.Nil(t, tt.expectedLog, "audit log wasn't written")
+			}
+		})
+	}
+}
+
+func intPtr(x int) *int {
+	return &x
+}
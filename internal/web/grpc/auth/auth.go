@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"net"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+)
+
+// Handler is a gRPC auth handler implementation
+type Handler struct {
+	Service auth.Service
+	proto.UnimplementedAuthServiceServer
+	logger *zap.Logger
+}
+
+var (
+	errMissingFields = status.Errorf(codes.InvalidArgument, "missing fields")
+	errNoBearerToken = status.Errorf(codes.Unauthenticated, "bearer token wasn't provided")
+)
+
+// NewHandler creates an instance of new grpc auth Handler
+func NewHandler(logger *zap.Logger, service auth.Service) *Handler {
+	return &Handler{
+		Service: service,
+		logger:  logger,
+	}
+}
+
+type adminContextKey struct{}
+
+// ContextWithAdmin returns context carrying admin authenticated by auth interceptors
+func ContextWithAdmin(ctx context.Context, admin models.Admin) context.Context {
+	return context.WithValue(ctx, adminContextKey{}, admin)
+}
+
+// AdminFromContext returns admin authenticated by auth interceptors
+func AdminFromContext(ctx context.Context) (models.Admin, bool) {
+	admin, ok := ctx.Value(adminContextKey{}).(models.Admin)
+
+	return admin, ok
+}
+
+// ClientIP returns ip of the peer that made the call
+func ClientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	return host
+}
+
+func toTokenResponse(tokens models.TokenPair) *proto.TokenResponse {
+	return &proto.TokenResponse{
+		AccessToken:      tokens.AccessToken,
+		AccessExpiresAt:  timestamppb.New(tokens.AccessExpiresAt),
+		RefreshToken:     tokens.RefreshToken,
+		RefreshExpiresAt: timestamppb.New(tokens.RefreshExpiresAt),
+	}
+}
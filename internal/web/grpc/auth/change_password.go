@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+)
+
+// ChangePassword is a grpc handler over service for changing password by current credentials
+func (h *Handler) ChangePassword(ctx context.Context,
+	req *proto.ChangePasswordRequest) (*proto.ChangePasswordResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ChangePassword")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "ChangePassword"),
+	)
+
+	logger.Info("Received request to change password",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" || req.GetPassword() == "" || req.GetNewPassword() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.String("username", req.GetUsername()),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	err := h.Service.ChangePassword(ctx, req.GetUsername(), req.GetPassword(), req.GetNewPassword(), ClientIP(ctx))
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	} else if errors.Is(err, auth.ErrLoginLocked) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if errors.Is(err, password.ErrPolicyViolation) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully changed password",
+		zap.String("username", req.GetUsername()),
+	)
+
+	return &proto.ChangePasswordResponse{
+		Output: "success",
+	}, nil
+}
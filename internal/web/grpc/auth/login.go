@@ -0,0 +1,59 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+)
+
+// Login is a grpc handler over service for issuing tokens
+func (h *Handler) Login(ctx context.Context, req *proto.LoginRequest) (*proto.TokenResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.Login")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "Login"),
+	)
+
+	logger.Info("Received request to login",
+		zap.String("username", req.GetUsername()),
+	)
+
+	if req.GetUsername() == "" || req.GetPassword() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.String("username", req.GetUsername()),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	tokens, err := h.Service.Login(ctx, req.GetUsername(), req.GetPassword(), ClientIP(ctx))
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	} else if errors.Is(err, auth.ErrPasswordExpired) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if errors.Is(err, auth.ErrLoginLocked) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return toTokenResponse(tokens), nil
+}
@@ -0,0 +1,52 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+)
+
+// Logout is a grpc handler over service for revoking tokens
+func (h *Handler) Logout(ctx context.Context, req *proto.LogoutRequest) (*proto.LogoutResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.Logout")
+	defer span.Finish()
+
+	h.logger.Info("Received request to logout",
+		zap.String("handler", "Logout"),
+	)
+
+	var accessToken string
+	var ok bool
+	if md, found := metadata.FromIncomingContext(ctx); found && len(md.Get("authorization")) > 0 {
+		accessToken, ok = strings.CutPrefix(md.Get("authorization")[0], "Bearer ")
+	}
+	if !ok || accessToken == "" {
+		span.SetTag("error", errNoBearerToken)
+
+		return nil, errNoBearerToken
+	}
+
+	err := h.Service.Logout(ctx, accessToken, req.GetRefreshToken())
+	if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrInvalidRefreshToken) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.LogoutResponse{
+		Output: "success",
+	}, nil
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+)
+
+// Refresh is a grpc handler over service for rotating tokens
+func (h *Handler) Refresh(ctx context.Context, req *proto.RefreshRequest) (*proto.TokenResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.Refresh")
+	defer span.Finish()
+
+	h.logger.Info("Received request to refresh tokens",
+		zap.String("handler", "Refresh"),
+	)
+
+	if req.GetRefreshToken() == "" {
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	tokens, err := h.Service.Refresh(ctx, req.GetRefreshToken())
+	if errors.Is(err, auth.ErrInvalidRefreshToken) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	} else if errors.Is(err, auth.ErrPasswordExpired) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if errors.Is(err, auth.ErrPasswordExpired) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return toTokenResponse(tokens), nil
+}
@@ -0,0 +1,244 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	admin_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+	apikey_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+	audit_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+	auth_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+	client_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
+	order_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+	webhook_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+var (
+	errUnauthenticated  = status.Error(codes.Unauthenticated, "unauthenticated")
+	errPermissionDenied = status.Error(codes.PermissionDenied, "permission denied")
+)
+
+// methodPolicy describes who may call a method
+type methodPolicy struct {
+	// public methods are available without authentication
+	public bool
+
+	// permission is required from the admin, any authenticated admin may call the method if it's empty
+	permission models.Permission
+
+	// self lets admin call the method without permission if request username is their own
+	self bool
+}
+
+// methodPolicies maps every method served to its policy, methods missing here are denied
+var methodPolicies = map[string]methodPolicy{
+	auth_proto.AuthService_Login_FullMethodName:   {public: true},
+	auth_proto.AuthService_Refresh_FullMethodName: {public: true},
+	auth_proto.AuthService_Logout_FullMethodName:  {public: true},
+
+	// password is checked by the method itself, so expired passwords can be changed
+	auth_proto.AuthService_ChangePassword_FullMethodName: {public: true},
+
+	order_proto.OrderService_CreateOrder_FullMethodName:    {permission: models.AcceptOrdersPermission},
+	order_proto.OrderService_UpdateOrder_FullMethodName:    {permission: models.WriteOrdersPermission},
+	order_proto.OrderService_RegenerateCode_FullMethodName: {permission: models.WriteOrdersPermission},
+	order_proto.OrderService_GetOrders_FullMethodName:      {permission: models.ReadOrdersPermission},
+	order_proto.OrderService_DeleteOrder_FullMethodName:    {permission: models.DeleteOrdersPermission},
+
+	client_proto.ClientService_CreateClient_FullMethodName:     {permission: models.ManageClientsPermission},
+	client_proto.ClientService_GetClientByPhone_FullMethodName: {permission: models.ManageClientsPermission},
+
+	admin_proto.AdminService_CreateAdmin_FullMethodName:     {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_UpdateAdmin_FullMethodName:     {permission: models.ManageAdminsPermission, self: true},
+	admin_proto.AdminService_DeleteAdmin_FullMethodName:     {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_UnlockAdmin_FullMethodName:     {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_ListAdmins_FullMethodName:      {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_GetAdmin_FullMethodName:        {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_DeactivateAdmin_FullMethodName: {permission: models.ManageAdminsPermission},
+	admin_proto.AdminService_ReactivateAdmin_FullMethodName: {permission: models.ManageAdminsPermission},
+
+	webhook_proto.WebhookService_CreateSubscription_FullMethodName: {permission: models.ManageWebhooksPermission},
+	webhook_proto.WebhookService_ListSubscriptions_FullMethodName:  {permission: models.ManageWebhooksPermission},
+	webhook_proto.WebhookService_DeleteSubscription_FullMethodName: {permission: models.ManageWebhooksPermission},
+	webhook_proto.WebhookService_ListDeliveries_FullMethodName:     {permission: models.ManageWebhooksPermission},
+
+	apikey_proto.APIKeyService_CreateAPIKey_FullMethodName: {permission: models.ManageAdminsPermission},
+	apikey_proto.APIKeyService_ListAPIKeys_FullMethodName:  {permission: models.ManageAdminsPermission},
+	apikey_proto.APIKeyService_RevokeAPIKey_FullMethodName: {permission: models.ManageAdminsPermission},
+
+	audit_proto.AuditService_ListLogs_FullMethodName:         {permission: models.ReadLogsPermission},
+	audit_proto.AuditService_VerifyAuditChain_FullMethodName: {permission: models.ReadLogsPermission},
+	audit_proto.AuditService_RequeueDeadJobs_FullMethodName:  {permission: models.ManageLogsPermission},
+}
+
+// Authenticator checks credentials from the "x-api-key" or "authorization" metadata and method policies
+type Authenticator struct {
+	authService      auth.Service
+	adminService     admin.Service
+	apiKeyService    apikey.Service
+	basicAuthEnabled bool
+}
+
+// NewAuthenticator creates an instance of Authenticator, basic auth is accepted
+// along with API keys and bearer access tokens if it's enabled
+func NewAuthenticator(authService auth.Service, adminService admin.Service, apiKeyService apikey.Service,
+	basicAuthEnabled bool) *Authenticator {
+	return &Authenticator{
+		authService:      authService,
+		adminService:     adminService,
+		apiKeyService:    apiKeyService,
+		basicAuthEnabled: basicAuthEnabled,
+	}
+}
+
+// UnaryInterceptor is an interceptor that authenticates and authorizes unary calls,
+// authenticated admin is put into context
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := a.authorize(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor is an interceptor that authenticates and authorizes streaming calls,
+// authenticated admin is put into stream context
+func (a *Authenticator) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler) error {
+		ctx, err := a.authorize(stream.Context(), info.FullMethod, nil)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &authenticatedStream{ServerStream: stream, ctx: ctx})
+	}
+}
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+type usernameRequest interface {
+	GetUsername() string
+}
+
+func (a *Authenticator) authorize(ctx context.Context, method string, req interface{}) (context.Context, error) {
+	policy, ok := methodPolicies[method]
+	if !ok {
+		return nil, errPermissionDenied
+	}
+	if policy.public {
+		return ctx, nil
+	}
+
+	someAdmin, err := a.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if policy.permission != "" && !someAdmin.HasPermission(policy.permission) {
+		request, ok := req.(usernameRequest)
+		if !policy.self || !ok || request.GetUsername() != someAdmin.Username {
+			return nil, errPermissionDenied
+		}
+	}
+
+	return auth_handler.ContextWithAdmin(ctx, someAdmin), nil
+}
+
+// authenticate resolves admin by credentials, admin is always fetched from storage,
+// so deleted or deactivated admins and changed roles take effect before access tokens expire.
+// API keys are resolved to admins limited to key scopes
+func (a *Authenticator) authenticate(ctx context.Context) (models.Admin, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if keys := md.Get("x-api-key"); len(keys) != 0 {
+		someAdmin, err := a.apiKeyService.Authenticate(ctx, keys[0])
+		if errors.Is(err, apikey.ErrInvalidAPIKey) {
+			return models.Admin{}, errUnauthenticated
+		} else if err != nil {
+			return models.Admin{}, status.Error(codes.Internal, err.Error())
+		}
+
+		return someAdmin, nil
+	}
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return models.Admin{}, errUnauthenticated
+	}
+
+	var username string
+	if accessToken, ok := strings.CutPrefix(values[0], "Bearer "); ok {
+		identity, err := a.authService.Authenticate(ctx, accessToken)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			return models.Admin{}, errUnauthenticated
+		} else if err != nil {
+			return models.Admin{}, status.Error(codes.Internal, err.Error())
+		}
+		username = identity.Username
+	} else {
+		var password string
+		username, password, ok = parseBasicAuth(values[0])
+		if !ok || !a.basicAuthEnabled {
+			return models.Admin{}, errUnauthenticated
+		}
+
+		someAdmin, err := a.authService.VerifyCredentials(ctx, username, password, auth_handler.ClientIP(ctx))
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			return models.Admin{}, errUnauthenticated
+		} else if errors.Is(err, auth.ErrPasswordExpired) {
+			return models.Admin{}, status.Error(codes.FailedPrecondition, err.Error())
+		} else if errors.Is(err, auth.ErrLoginLocked) {
+			return models.Admin{}, status.Error(codes.ResourceExhausted, err.Error())
+		} else if err != nil {
+			return models.Admin{}, status.Error(codes.Internal, err.Error())
+		}
+
+		return someAdmin, nil
+	}
+
+	someAdmin, err := a.adminService.GetAdminByUsername(ctx, username)
+	if err != nil || !someAdmin.IsActive() {
+		return models.Admin{}, errUnauthenticated
+	}
+
+	return someAdmin, nil
+}
+
+func parseBasicAuth(header string) (string, string, bool) {
+	credsStr, ok := strings.CutPrefix(header, "Basic ")
+	if !ok {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(credsStr)
+	if err != nil {
+		return "", "", false
+	}
+
+	username, password, ok := strings.Cut(string(decoded), ":")
+
+	return username, password, ok
+}
@@ -0,0 +1,328 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/jwt"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+	admin_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	apikey_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	auth_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	admin_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+	apikey_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+	auth_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+	order_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+)
+
+func TestAuthenticator_UnaryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	require.NoError(t, err)
+	operator := models.Admin{ID: 1, Username: "user", Password: string(hash), Role: models.OperatorRole,
+		PasswordChangedAt: time.Now()}
+	expired := operator
+	expired.PasswordChangedAt = time.Now().Add(-password.DefaultMaxAge)
+	accessToken, err := jwt.Sign(jwt.Claims{ID: "1", Subject: "user", AdminID: 1,
+		ExpiresAt: time.Now().Add(time.Minute).Unix()}, []byte("secret"))
+	require.NoError(t, err)
+	basic := "Basic " + base64.StdEncoding.EncodeToString([]byte("user:password"))
+	lockedUntil := time.Now().Add(time.Minute)
+
+	tests := []struct {
+		name          string
+		method        string
+		authorization string
+		req           interface{}
+		mockSetup     func(*MockadminStorage, *MocktokenStorage, *MockloginAttemptStorage)
+		expectedCode  codes.Code
+	}{
+		{
+			name:         "Public method",
+			method:       auth_proto.AuthService_Login_FullMethodName,
+			mockSetup:    func(_ *MockadminStorage, _ *MocktokenStorage, _ *MockloginAttemptStorage) {},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "No credentials",
+			method:       order_proto.OrderService_GetOrders_FullMethodName,
+			mockSetup:    func(_ *MockadminStorage, _ *MocktokenStorage, _ *MockloginAttemptStorage) {},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "Unknown method",
+			method:        "/order.proto.OrderService/DropTable",
+			authorization: basic,
+			mockSetup:     func(_ *MockadminStorage, _ *MocktokenStorage, _ *MockloginAttemptStorage) {},
+			expectedCode:  codes.PermissionDenied,
+		},
+		{
+			name:          "Valid basic auth",
+			method:        order_proto.OrderService_GetOrders_FullMethodName,
+			authorization: basic,
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:          "Wrong password",
+			method:        order_proto.OrderService_GetOrders_FullMethodName,
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("user:wrong")),
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+				attempts.EXPECT().RegisterFailedLogin(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+					gomock.Any()).Return(1, nil).Times(2)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "Locked login",
+			method:        order_proto.OrderService_GetOrders_FullMethodName,
+			authorization: basic,
+			mockSetup: func(_ *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return([]models.LoginAttempt{
+					{Kind: models.UsernameLoginAttempt, Key: "user", LockedUntil: &lockedUntil},
+				}, nil)
+			},
+			expectedCode: codes.ResourceExhausted,
+		},
+		{
+			name:          "Expired password",
+			method:        order_proto.OrderService_GetOrders_FullMethodName,
+			authorization: basic,
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(expired, nil)
+			},
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:          "Valid access token",
+			method:        order_proto.OrderService_CreateOrder_FullMethodName,
+			authorization: "Bearer " + accessToken,
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage, _ *MockloginAttemptStorage) {
+				tokens.EXPECT().IsAccessTokenRevoked(gomock.Any(), "1").Return(false, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:          "Access token of deleted admin",
+			method:        order_proto.OrderService_CreateOrder_FullMethodName,
+			authorization: "Bearer " + accessToken,
+			mockSetup: func(admins *MockadminStorage, tokens *MocktokenStorage, _ *MockloginAttemptStorage) {
+				tokens.EXPECT().IsAccessTokenRevoked(gomock.Any(), "1").Return(false, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(false, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:          "Missing permission",
+			method:        order_proto.OrderService_DeleteOrder_FullMethodName,
+			authorization: basic,
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:          "Update own account",
+			method:        admin_proto.AdminService_UpdateAdmin_FullMethodName,
+			authorization: basic,
+			req:           &admin_proto.UpdateAdminRequest{Username: "user"},
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:          "Update other account",
+			method:        admin_proto.AdminService_UpdateAdmin_FullMethodName,
+			authorization: basic,
+			req:           &admin_proto.UpdateAdminRequest{Username: "root"},
+			mockSetup: func(admins *MockadminStorage, _ *MocktokenStorage, attempts *MockloginAttemptStorage) {
+				attempts.EXPECT().GetLoginAttempts(gomock.Any(), "user", "").Return(nil, nil)
+				admins.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				admins.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			tokens := NewMocktokenStorage(ctrl)
+			attempts := NewMockloginAttemptStorage(ctrl)
+			tt.mockSetup(admins, tokens, attempts)
+
+			policy := password.NewPolicy(0, 0, 0, nil)
+			adminService := admin_service.NewService(zap.NewNop(), admins, policy)
+			authenticator := NewAuthenticator(
+				*auth_service.NewService(zap.NewNop(), admins, tokens, attempts, NewMocklogStorage(ctrl),
+					adminService, policy, NewMocktxManager(ctrl), "secret", time.Minute, time.Hour),
+				*adminService, *apikey_service.NewService(zap.NewNop(), NewMockapiKeyStorage(ctrl)), true)
+
+			ctx := t.Context()
+			if tt.authorization != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tt.authorization))
+			}
+
+			_, err := authenticator.UnaryInterceptor()(ctx, tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					if tt.method != auth_proto.AuthService_Login_FullMethodName {
+						someAdmin, ok := auth_handler.AdminFromContext(ctx)
+						assert.True(t, ok)
+						assert.Equal(t, "user", someAdmin.Username)
+					}
+
+					return nil, nil
+				})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestAuthenticator_APIKey(t *testing.T) {
+	t.Parallel()
+
+	key, apiKey, err := models.NewAPIKey("courier", []models.Permission{models.AcceptOrdersPermission}, 1)
+	require.NoError(t, err)
+	apiKey.ID = 5
+	apiKey.CreatorRole = models.SuperadminRole
+	revokedAt := time.Now()
+	revoked := *apiKey
+	revoked.RevokedAt = &revokedAt
+	deactivatedCreator := *apiKey
+	deactivatedCreator.CreatorDeactivatedAt = &revokedAt
+
+	tests := []struct {
+		name         string
+		method       string
+		key          string
+		mockSetup    func(*MockapiKeyStorage)
+		expectedCode codes.Code
+	}{
+		{
+			name:   "Scope granted",
+			method: order_proto.OrderService_CreateOrder_FullMethodName,
+			key:    key,
+			mockSetup: func(apiKeys *MockapiKeyStorage) {
+				apiKeys.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+				apiKeys.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil)
+				apiKeys.EXPECT().TouchAPIKey(gomock.Any(), 5, gomock.Any()).Return(nil)
+			},
+			expectedCode: codes.OK,
+		},
+		{
+			name:   "Scope not granted",
+			method: order_proto.OrderService_GetOrders_FullMethodName,
+			key:    key,
+			mockSetup: func(apiKeys *MockapiKeyStorage) {
+				apiKeys.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+				apiKeys.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil)
+				apiKeys.EXPECT().TouchAPIKey(gomock.Any(), 5, gomock.Any()).Return(nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Keys can't manage keys",
+			method: apikey_proto.APIKeyService_CreateAPIKey_FullMethodName,
+			key:    key,
+			mockSetup: func(apiKeys *MockapiKeyStorage) {
+				apiKeys.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+				apiKeys.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil)
+				apiKeys.EXPECT().TouchAPIKey(gomock.Any(), 5, gomock.Any()).Return(nil)
+			},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:   "Revoked key",
+			method: order_proto.OrderService_CreateOrder_FullMethodName,
+			key:    key,
+			mockSetup: func(apiKeys *MockapiKeyStorage) {
+				apiKeys.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+				apiKeys.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(revoked, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:   "Creator deactivated",
+			method: order_proto.OrderService_CreateOrder_FullMethodName,
+			key:    key,
+			mockSetup: func(apiKeys *MockapiKeyStorage) {
+				apiKeys.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+				apiKeys.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(deactivatedCreator, nil)
+			},
+			expectedCode: codes.Unauthenticated,
+		},
+		{
+			name:         "Malformed key",
+			method:       order_proto.OrderService_CreateOrder_FullMethodName,
+			key:          "secret",
+			mockSetup:    func(_ *MockapiKeyStorage) {},
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+
+			admins := NewMockadminStorage(ctrl)
+			apiKeys := NewMockapiKeyStorage(ctrl)
+			tt.mockSetup(apiKeys)
+
+			policy := password.NewPolicy(0, 0, 0, nil)
+			adminService := admin_service.NewService(zap.NewNop(), admins, policy)
+			authenticator := NewAuthenticator(
+				*auth_service.NewService(zap.NewNop(), admins, NewMocktokenStorage(ctrl),
+					NewMockloginAttemptStorage(ctrl), NewMocklogStorage(ctrl), adminService, policy,
+					NewMocktxManager(ctrl), "secret", time.Minute, time.Hour),
+				*adminService, *apikey_service.NewService(zap.NewNop(), apiKeys), false)
+
+			ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs("x-api-key", tt.key))
+
+			_, err := authenticator.UnaryInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method},
+				func(ctx context.Context, _ interface{}) (interface{}, error) {
+					someAdmin, ok := auth_handler.AdminFromContext(ctx)
+					assert.True(t, ok)
+					assert.Equal(t, 1, someAdmin.ID)
+					assert.Equal(t, 5, someAdmin.APIKeyID)
+
+					return nil, nil
+				})
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
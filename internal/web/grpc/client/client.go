@@ -0,0 +1,29 @@
+package client
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
+)
+
+// Handler is a gRPC client handler implementation
+type Handler struct {
+	Service client.Service
+	proto.UnimplementedClientServiceServer
+	logger *zap.Logger
+}
+
+var (
+	errMissingFields = status.Errorf(codes.InvalidArgument, "missing fields")
+)
+
+// NewHandler creates an instance of new grpc client Handler
+func NewHandler(logger *zap.Logger, service client.Service) *Handler {
+	return &Handler{
+		Service: service,
+		logger:  logger,
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
+)
+
+// CreateClient is a grpc handler over service for registering client
+func (h *Handler) CreateClient(ctx context.Context, req *proto.CreateClientRequest) (*proto.CreateClientResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.CreateClient")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "CreateClient"),
+	)
+
+	logger.Info("Received request to create client",
+		zap.String("phone", req.GetPhone()),
+	)
+
+	if req.GetPhone() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Int("client_id", int(req.GetId())),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	newClient, err := models.NewClient(int(req.GetId()), req.GetName(), req.GetPhone(), req.GetEmail())
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	id, err := h.Service.CreateClient(ctx, *newClient)
+	if errors.Is(err, client.ErrPhoneUsed) || errors.Is(err, client.ErrIDUsed) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	span.SetTag("client_id", id)
+
+	logger.Info("Successfully created client",
+		zap.Int("client_id", id),
+	)
+
+	return &proto.CreateClientResponse{
+		Id: int32(id),
+	}, nil
+}
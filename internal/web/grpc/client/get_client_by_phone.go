@@ -0,0 +1,66 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
+)
+
+// GetClientByPhone is a grpc handler over service for finding client by phone
+func (h *Handler) GetClientByPhone(ctx context.Context,
+	req *proto.GetClientByPhoneRequest) (*proto.GetClientByPhoneResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.GetClientByPhone")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "GetClientByPhone"),
+	)
+
+	logger.Info("Received request to get client",
+		zap.String("phone", req.GetPhone()),
+	)
+
+	if req.GetPhone() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	found, err := h.Service.GetClientByPhone(ctx, req.GetPhone())
+	switch {
+	case errors.Is(err, models.ErrWrongPhone):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, client.ErrClientNotFound):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	case err != nil:
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.GetClientByPhoneResponse{
+		Client: &proto.Client{
+			Id:        int32(found.ID),
+			Name:      found.Name,
+			Phone:     found.Phone,
+			Email:     found.Email,
+			CreatedAt: timestamppb.New(found.CreatedAt),
+		},
+	}, nil
+}
@@ -0,0 +1,3 @@
+//go:generate mockgen -typed -source=server.go -destination=./mock_storages_test.go -package=grpc
+
+package grpc
@@ -0,0 +1,2815 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: server.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=server.go -destination=./mock_storages_test.go -package=grpc
+//
+
+// Package grpc is a generated GoMock package.
+package grpc
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	pgx "github.com/jackc/pgx/v4"
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	query "gitlab.ozon.dev/alexplay1224/homework/internal/query"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockorderStorage is a mock of orderStorage interface.
+type MockorderStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockorderStorageMockRecorder is the mock recorder for MockorderStorage.
+type MockorderStorageMockRecorder struct {
+	mock *MockorderStorage
+}
+
+// NewMockorderStorage creates a new mock instance.
+func NewMockorderStorage(ctrl *gomock.Controller) *MockorderStorage {
+	mock := &MockorderStorage{ctrl: ctrl}
+	mock.recorder = &MockorderStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderStorage) EXPECT() *MockorderStorageMockRecorder {
+	return m.recorder
+}
+
+// AddOrder mocks base method.
+func (m *MockorderStorage) AddOrder(arg0 context.Context, arg1 pgx.Tx, arg2 models.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddOrder indicates an expected call of AddOrder.
+func (mr *MockorderStorageMockRecorder) AddOrder(arg0, arg1, arg2 any) *MockorderStorageAddOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOrder", reflect.TypeOf((*MockorderStorage)(nil).AddOrder), arg0, arg1, arg2)
+	return &MockorderStorageAddOrderCall{Call: call}
+}
+
+// MockorderStorageAddOrderCall wrap *gomock.Call
+type MockorderStorageAddOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageAddOrderCall) Return(arg0 error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageAddOrderCall) Do(f func(context.Context, pgx.Tx, models.Order) error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageAddOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, models.Order) error) *MockorderStorageAddOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Contains mocks base method.
+func (m *MockorderStorage) Contains(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Contains", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Contains indicates an expected call of Contains.
+func (mr *MockorderStorageMockRecorder) Contains(arg0, arg1, arg2 any) *MockorderStorageContainsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Contains", reflect.TypeOf((*MockorderStorage)(nil).Contains), arg0, arg1, arg2)
+	return &MockorderStorageContainsCall{Call: call}
+}
+
+// MockorderStorageContainsCall wrap *gomock.Call
+type MockorderStorageContainsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageContainsCall) Return(arg0 bool, arg1 error) *MockorderStorageContainsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageContainsCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockorderStorageContainsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageContainsCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockorderStorageContainsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByID mocks base method.
+func (m *MockorderStorage) GetByID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockorderStorageMockRecorder) GetByID(arg0, arg1, arg2 any) *MockorderStorageGetByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockorderStorage)(nil).GetByID), arg0, arg1, arg2)
+	return &MockorderStorageGetByIDCall{Call: call}
+}
+
+// MockorderStorageGetByIDCall wrap *gomock.Call
+type MockorderStorageGetByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetByIDCall) Return(arg0 models.Order, arg1 error) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetByIDCall) Do(f func(context.Context, pgx.Tx, int) (models.Order, error)) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetByIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.Order, error)) *MockorderStorageGetByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetByUserID mocks base method.
+func (m *MockorderStorage) GetByUserID(arg0 context.Context, arg1 pgx.Tx, arg2, arg3 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockorderStorageMockRecorder) GetByUserID(arg0, arg1, arg2, arg3 any) *MockorderStorageGetByUserIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockorderStorage)(nil).GetByUserID), arg0, arg1, arg2, arg3)
+	return &MockorderStorageGetByUserIDCall{Call: call}
+}
+
+// MockorderStorageGetByUserIDCall wrap *gomock.Call
+type MockorderStorageGetByUserIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetByUserIDCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetByUserIDCall) Do(f func(context.Context, pgx.Tx, int, int) ([]models.Order, error)) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetByUserIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int, int) ([]models.Order, error)) *MockorderStorageGetByUserIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetOrders mocks base method.
+func (m *MockorderStorage) GetOrders(arg0 context.Context, arg1 pgx.Tx, arg2 []query.Cond, arg3, arg4 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrders", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrders indicates an expected call of GetOrders.
+func (mr *MockorderStorageMockRecorder) GetOrders(arg0, arg1, arg2, arg3, arg4 any) *MockorderStorageGetOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrders", reflect.TypeOf((*MockorderStorage)(nil).GetOrders), arg0, arg1, arg2, arg3, arg4)
+	return &MockorderStorageGetOrdersCall{Call: call}
+}
+
+// MockorderStorageGetOrdersCall wrap *gomock.Call
+type MockorderStorageGetOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetOrdersCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetOrdersCall) Do(f func(context.Context, pgx.Tx, []query.Cond, int, int) ([]models.Order, error)) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetOrdersCall) DoAndReturn(f func(context.Context, pgx.Tx, []query.Cond, int, int) ([]models.Order, error)) *MockorderStorageGetOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetReturns mocks base method.
+func (m *MockorderStorage) GetReturns(arg0 context.Context, arg1 pgx.Tx) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReturns", arg0, arg1)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReturns indicates an expected call of GetReturns.
+func (mr *MockorderStorageMockRecorder) GetReturns(arg0, arg1 any) *MockorderStorageGetReturnsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReturns", reflect.TypeOf((*MockorderStorage)(nil).GetReturns), arg0, arg1)
+	return &MockorderStorageGetReturnsCall{Call: call}
+}
+
+// MockorderStorageGetReturnsCall wrap *gomock.Call
+type MockorderStorageGetReturnsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageGetReturnsCall) Return(arg0 []models.Order, arg1 error) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageGetReturnsCall) Do(f func(context.Context, pgx.Tx) ([]models.Order, error)) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageGetReturnsCall) DoAndReturn(f func(context.Context, pgx.Tx) ([]models.Order, error)) *MockorderStorageGetReturnsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RemoveOrder mocks base method.
+func (m *MockorderStorage) RemoveOrder(arg0 context.Context, arg1 pgx.Tx, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveOrder", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveOrder indicates an expected call of RemoveOrder.
+func (mr *MockorderStorageMockRecorder) RemoveOrder(arg0, arg1, arg2 any) *MockorderStorageRemoveOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveOrder", reflect.TypeOf((*MockorderStorage)(nil).RemoveOrder), arg0, arg1, arg2)
+	return &MockorderStorageRemoveOrderCall{Call: call}
+}
+
+// MockorderStorageRemoveOrderCall wrap *gomock.Call
+type MockorderStorageRemoveOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageRemoveOrderCall) Return(arg0 error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageRemoveOrderCall) Do(f func(context.Context, pgx.Tx, int) error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageRemoveOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, int) error) *MockorderStorageRemoveOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateOrder mocks base method.
+func (m *MockorderStorage) UpdateOrder(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 models.Order) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOrder", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateOrder indicates an expected call of UpdateOrder.
+func (mr *MockorderStorageMockRecorder) UpdateOrder(arg0, arg1, arg2, arg3 any) *MockorderStorageUpdateOrderCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOrder", reflect.TypeOf((*MockorderStorage)(nil).UpdateOrder), arg0, arg1, arg2, arg3)
+	return &MockorderStorageUpdateOrderCall{Call: call}
+}
+
+// MockorderStorageUpdateOrderCall wrap *gomock.Call
+type MockorderStorageUpdateOrderCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderStorageUpdateOrderCall) Return(arg0 error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderStorageUpdateOrderCall) Do(f func(context.Context, pgx.Tx, int, models.Order) error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderStorageUpdateOrderCall) DoAndReturn(f func(context.Context, pgx.Tx, int, models.Order) error) *MockorderStorageUpdateOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockadminStorage is a mock of adminStorage interface.
+type MockadminStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockadminStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockadminStorageMockRecorder is the mock recorder for MockadminStorage.
+type MockadminStorageMockRecorder struct {
+	mock *MockadminStorage
+}
+
+// NewMockadminStorage creates a new mock instance.
+func NewMockadminStorage(ctrl *gomock.Controller) *MockadminStorage {
+	mock := &MockadminStorage{ctrl: ctrl}
+	mock.recorder = &MockadminStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockadminStorage) EXPECT() *MockadminStorageMockRecorder {
+	return m.recorder
+}
+
+// AddPasswordHistory mocks base method.
+func (m *MockadminStorage) AddPasswordHistory(arg0 context.Context, arg1 int, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPasswordHistory", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddPasswordHistory indicates an expected call of AddPasswordHistory.
+func (mr *MockadminStorageMockRecorder) AddPasswordHistory(arg0, arg1, arg2, arg3 any) *MockadminStorageAddPasswordHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPasswordHistory", reflect.TypeOf((*MockadminStorage)(nil).AddPasswordHistory), arg0, arg1, arg2, arg3)
+	return &MockadminStorageAddPasswordHistoryCall{Call: call}
+}
+
+// MockadminStorageAddPasswordHistoryCall wrap *gomock.Call
+type MockadminStorageAddPasswordHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageAddPasswordHistoryCall) Return(arg0 error) *MockadminStorageAddPasswordHistoryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageAddPasswordHistoryCall) Do(f func(context.Context, int, string, time.Time) error) *MockadminStorageAddPasswordHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageAddPasswordHistoryCall) DoAndReturn(f func(context.Context, int, string, time.Time) error) *MockadminStorageAddPasswordHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainsID mocks base method.
+func (m *MockadminStorage) ContainsID(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsID", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsID indicates an expected call of ContainsID.
+func (mr *MockadminStorageMockRecorder) ContainsID(arg0, arg1 any) *MockadminStorageContainsIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsID", reflect.TypeOf((*MockadminStorage)(nil).ContainsID), arg0, arg1)
+	return &MockadminStorageContainsIDCall{Call: call}
+}
+
+// MockadminStorageContainsIDCall wrap *gomock.Call
+type MockadminStorageContainsIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageContainsIDCall) Return(arg0 bool, arg1 error) *MockadminStorageContainsIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageContainsIDCall) Do(f func(context.Context, int) (bool, error)) *MockadminStorageContainsIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageContainsIDCall) DoAndReturn(f func(context.Context, int) (bool, error)) *MockadminStorageContainsIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainsUsername mocks base method.
+func (m *MockadminStorage) ContainsUsername(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsUsername", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsUsername indicates an expected call of ContainsUsername.
+func (mr *MockadminStorageMockRecorder) ContainsUsername(arg0, arg1 any) *MockadminStorageContainsUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsUsername", reflect.TypeOf((*MockadminStorage)(nil).ContainsUsername), arg0, arg1)
+	return &MockadminStorageContainsUsernameCall{Call: call}
+}
+
+// MockadminStorageContainsUsernameCall wrap *gomock.Call
+type MockadminStorageContainsUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageContainsUsernameCall) Return(arg0 bool, arg1 error) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageContainsUsernameCall) Do(f func(context.Context, string) (bool, error)) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageContainsUsernameCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockadminStorageContainsUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAdmin mocks base method.
+func (m *MockadminStorage) CreateAdmin(arg0 context.Context, arg1 models.Admin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAdmin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAdmin indicates an expected call of CreateAdmin.
+func (mr *MockadminStorageMockRecorder) CreateAdmin(arg0, arg1 any) *MockadminStorageCreateAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockadminStorage)(nil).CreateAdmin), arg0, arg1)
+	return &MockadminStorageCreateAdminCall{Call: call}
+}
+
+// MockadminStorageCreateAdminCall wrap *gomock.Call
+type MockadminStorageCreateAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageCreateAdminCall) Return(arg0 error) *MockadminStorageCreateAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageCreateAdminCall) Do(f func(context.Context, models.Admin) error) *MockadminStorageCreateAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageCreateAdminCall) DoAndReturn(f func(context.Context, models.Admin) error) *MockadminStorageCreateAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteAdmin mocks base method.
+func (m *MockadminStorage) DeleteAdmin(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAdmin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAdmin indicates an expected call of DeleteAdmin.
+func (mr *MockadminStorageMockRecorder) DeleteAdmin(arg0, arg1 any) *MockadminStorageDeleteAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAdmin", reflect.TypeOf((*MockadminStorage)(nil).DeleteAdmin), arg0, arg1)
+	return &MockadminStorageDeleteAdminCall{Call: call}
+}
+
+// MockadminStorageDeleteAdminCall wrap *gomock.Call
+type MockadminStorageDeleteAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageDeleteAdminCall) Return(arg0 error) *MockadminStorageDeleteAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageDeleteAdminCall) Do(f func(context.Context, string) error) *MockadminStorageDeleteAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageDeleteAdminCall) DoAndReturn(f func(context.Context, string) error) *MockadminStorageDeleteAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAdminByUsername mocks base method.
+func (m *MockadminStorage) GetAdminByUsername(arg0 context.Context, arg1 string) (models.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAdminByUsername", arg0, arg1)
+	ret0, _ := ret[0].(models.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAdminByUsername indicates an expected call of GetAdminByUsername.
+func (mr *MockadminStorageMockRecorder) GetAdminByUsername(arg0, arg1 any) *MockadminStorageGetAdminByUsernameCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminByUsername", reflect.TypeOf((*MockadminStorage)(nil).GetAdminByUsername), arg0, arg1)
+	return &MockadminStorageGetAdminByUsernameCall{Call: call}
+}
+
+// MockadminStorageGetAdminByUsernameCall wrap *gomock.Call
+type MockadminStorageGetAdminByUsernameCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageGetAdminByUsernameCall) Return(arg0 models.Admin, arg1 error) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageGetAdminByUsernameCall) Do(f func(context.Context, string) (models.Admin, error)) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageGetAdminByUsernameCall) DoAndReturn(f func(context.Context, string) (models.Admin, error)) *MockadminStorageGetAdminByUsernameCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPasswordHistory mocks base method.
+func (m *MockadminStorage) GetPasswordHistory(arg0 context.Context, arg1, arg2 int) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPasswordHistory", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPasswordHistory indicates an expected call of GetPasswordHistory.
+func (mr *MockadminStorageMockRecorder) GetPasswordHistory(arg0, arg1, arg2 any) *MockadminStorageGetPasswordHistoryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPasswordHistory", reflect.TypeOf((*MockadminStorage)(nil).GetPasswordHistory), arg0, arg1, arg2)
+	return &MockadminStorageGetPasswordHistoryCall{Call: call}
+}
+
+// MockadminStorageGetPasswordHistoryCall wrap *gomock.Call
+type MockadminStorageGetPasswordHistoryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageGetPasswordHistoryCall) Return(arg0 []string, arg1 error) *MockadminStorageGetPasswordHistoryCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageGetPasswordHistoryCall) Do(f func(context.Context, int, int) ([]string, error)) *MockadminStorageGetPasswordHistoryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageGetPasswordHistoryCall) DoAndReturn(f func(context.Context, int, int) ([]string, error)) *MockadminStorageGetPasswordHistoryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListAdmins mocks base method.
+func (m *MockadminStorage) ListAdmins(arg0 context.Context, arg1 []query.Cond, arg2, arg3 int) ([]models.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdmins", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdmins indicates an expected call of ListAdmins.
+func (mr *MockadminStorageMockRecorder) ListAdmins(arg0, arg1, arg2, arg3 any) *MockadminStorageListAdminsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmins", reflect.TypeOf((*MockadminStorage)(nil).ListAdmins), arg0, arg1, arg2, arg3)
+	return &MockadminStorageListAdminsCall{Call: call}
+}
+
+// MockadminStorageListAdminsCall wrap *gomock.Call
+type MockadminStorageListAdminsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageListAdminsCall) Return(arg0 []models.Admin, arg1 error) *MockadminStorageListAdminsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageListAdminsCall) Do(f func(context.Context, []query.Cond, int, int) ([]models.Admin, error)) *MockadminStorageListAdminsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageListAdminsCall) DoAndReturn(f func(context.Context, []query.Cond, int, int) ([]models.Admin, error)) *MockadminStorageListAdminsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetAdminDeactivatedAt mocks base method.
+func (m *MockadminStorage) SetAdminDeactivatedAt(arg0 context.Context, arg1 string, arg2 *time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAdminDeactivatedAt", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAdminDeactivatedAt indicates an expected call of SetAdminDeactivatedAt.
+func (mr *MockadminStorageMockRecorder) SetAdminDeactivatedAt(arg0, arg1, arg2 any) *MockadminStorageSetAdminDeactivatedAtCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAdminDeactivatedAt", reflect.TypeOf((*MockadminStorage)(nil).SetAdminDeactivatedAt), arg0, arg1, arg2)
+	return &MockadminStorageSetAdminDeactivatedAtCall{Call: call}
+}
+
+// MockadminStorageSetAdminDeactivatedAtCall wrap *gomock.Call
+type MockadminStorageSetAdminDeactivatedAtCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageSetAdminDeactivatedAtCall) Return(arg0 error) *MockadminStorageSetAdminDeactivatedAtCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageSetAdminDeactivatedAtCall) Do(f func(context.Context, string, *time.Time) error) *MockadminStorageSetAdminDeactivatedAtCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageSetAdminDeactivatedAtCall) DoAndReturn(f func(context.Context, string, *time.Time) error) *MockadminStorageSetAdminDeactivatedAtCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateAdmin mocks base method.
+func (m *MockadminStorage) UpdateAdmin(arg0 context.Context, arg1 int, arg2 models.Admin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAdmin", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAdmin indicates an expected call of UpdateAdmin.
+func (mr *MockadminStorageMockRecorder) UpdateAdmin(arg0, arg1, arg2 any) *MockadminStorageUpdateAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAdmin", reflect.TypeOf((*MockadminStorage)(nil).UpdateAdmin), arg0, arg1, arg2)
+	return &MockadminStorageUpdateAdminCall{Call: call}
+}
+
+// MockadminStorageUpdateAdminCall wrap *gomock.Call
+type MockadminStorageUpdateAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminStorageUpdateAdminCall) Return(arg0 error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminStorageUpdateAdminCall) Do(f func(context.Context, int, models.Admin) error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminStorageUpdateAdminCall) DoAndReturn(f func(context.Context, int, models.Admin) error) *MockadminStorageUpdateAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockclientStorage is a mock of clientStorage interface.
+type MockclientStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockclientStorageMockRecorder is the mock recorder for MockclientStorage.
+type MockclientStorageMockRecorder struct {
+	mock *MockclientStorage
+}
+
+// NewMockclientStorage creates a new mock instance.
+func NewMockclientStorage(ctrl *gomock.Controller) *MockclientStorage {
+	mock := &MockclientStorage{ctrl: ctrl}
+	mock.recorder = &MockclientStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientStorage) EXPECT() *MockclientStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsClientID mocks base method.
+func (m *MockclientStorage) ContainsClientID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsClientID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsClientID indicates an expected call of ContainsClientID.
+func (mr *MockclientStorageMockRecorder) ContainsClientID(arg0, arg1, arg2 any) *MockclientStorageContainsClientIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsClientID", reflect.TypeOf((*MockclientStorage)(nil).ContainsClientID), arg0, arg1, arg2)
+	return &MockclientStorageContainsClientIDCall{Call: call}
+}
+
+// MockclientStorageContainsClientIDCall wrap *gomock.Call
+type MockclientStorageContainsClientIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsClientIDCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsClientIDCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsClientIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockclientStorageContainsClientIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ContainsPhone mocks base method.
+func (m *MockclientStorage) ContainsPhone(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsPhone", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsPhone indicates an expected call of ContainsPhone.
+func (mr *MockclientStorageMockRecorder) ContainsPhone(arg0, arg1 any) *MockclientStorageContainsPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsPhone", reflect.TypeOf((*MockclientStorage)(nil).ContainsPhone), arg0, arg1)
+	return &MockclientStorageContainsPhoneCall{Call: call}
+}
+
+// MockclientStorageContainsPhoneCall wrap *gomock.Call
+type MockclientStorageContainsPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageContainsPhoneCall) Return(arg0 bool, arg1 error) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageContainsPhoneCall) Do(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageContainsPhoneCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockclientStorageContainsPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateClient mocks base method.
+func (m *MockclientStorage) CreateClient(arg0 context.Context, arg1 models.Client) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockclientStorageMockRecorder) CreateClient(arg0, arg1 any) *MockclientStorageCreateClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockclientStorage)(nil).CreateClient), arg0, arg1)
+	return &MockclientStorageCreateClientCall{Call: call}
+}
+
+// MockclientStorageCreateClientCall wrap *gomock.Call
+type MockclientStorageCreateClientCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageCreateClientCall) Return(arg0 int, arg1 error) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageCreateClientCall) Do(f func(context.Context, models.Client) (int, error)) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageCreateClientCall) DoAndReturn(f func(context.Context, models.Client) (int, error)) *MockclientStorageCreateClientCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByID mocks base method.
+func (m *MockclientStorage) GetClientByID(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByID", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByID indicates an expected call of GetClientByID.
+func (mr *MockclientStorageMockRecorder) GetClientByID(arg0, arg1, arg2 any) *MockclientStorageGetClientByIDCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByID", reflect.TypeOf((*MockclientStorage)(nil).GetClientByID), arg0, arg1, arg2)
+	return &MockclientStorageGetClientByIDCall{Call: call}
+}
+
+// MockclientStorageGetClientByIDCall wrap *gomock.Call
+type MockclientStorageGetClientByIDCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageGetClientByIDCall) Return(arg0 models.Client, arg1 error) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageGetClientByIDCall) Do(f func(context.Context, pgx.Tx, int) (models.Client, error)) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageGetClientByIDCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.Client, error)) *MockclientStorageGetClientByIDCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByPhone mocks base method.
+func (m *MockclientStorage) GetClientByPhone(arg0 context.Context, arg1 string) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByPhone", arg0, arg1)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByPhone indicates an expected call of GetClientByPhone.
+func (mr *MockclientStorageMockRecorder) GetClientByPhone(arg0, arg1 any) *MockclientStorageGetClientByPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByPhone", reflect.TypeOf((*MockclientStorage)(nil).GetClientByPhone), arg0, arg1)
+	return &MockclientStorageGetClientByPhoneCall{Call: call}
+}
+
+// MockclientStorageGetClientByPhoneCall wrap *gomock.Call
+type MockclientStorageGetClientByPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientStorageGetClientByPhoneCall) Return(arg0 models.Client, arg1 error) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientStorageGetClientByPhoneCall) Do(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientStorageGetClientByPhoneCall) DoAndReturn(f func(context.Context, string) (models.Client, error)) *MockclientStorageGetClientByPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockpickupCodeStorage is a mock of pickupCodeStorage interface.
+type MockpickupCodeStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockpickupCodeStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockpickupCodeStorageMockRecorder is the mock recorder for MockpickupCodeStorage.
+type MockpickupCodeStorageMockRecorder struct {
+	mock *MockpickupCodeStorage
+}
+
+// NewMockpickupCodeStorage creates a new mock instance.
+func NewMockpickupCodeStorage(ctrl *gomock.Controller) *MockpickupCodeStorage {
+	mock := &MockpickupCodeStorage{ctrl: ctrl}
+	mock.recorder = &MockpickupCodeStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockpickupCodeStorage) EXPECT() *MockpickupCodeStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsPickupCode mocks base method.
+func (m *MockpickupCodeStorage) ContainsPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsPickupCode indicates an expected call of ContainsPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) ContainsPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageContainsPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).ContainsPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageContainsPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageContainsPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageContainsPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageContainsPickupCodeCall) Return(arg0 bool, arg1 error) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageContainsPickupCodeCall) Do(f func(context.Context, pgx.Tx, int) (bool, error)) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageContainsPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (bool, error)) *MockpickupCodeStorageContainsPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeletePickupCode mocks base method.
+func (m *MockpickupCodeStorage) DeletePickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePickupCode indicates an expected call of DeletePickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) DeletePickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageDeletePickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).DeletePickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageDeletePickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageDeletePickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageDeletePickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageDeletePickupCodeCall) Return(arg0 error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageDeletePickupCodeCall) Do(f func(context.Context, pgx.Tx, int) error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageDeletePickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) error) *MockpickupCodeStorageDeletePickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetPickupCode mocks base method.
+func (m *MockpickupCodeStorage) GetPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 int) (models.PickupCode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.PickupCode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPickupCode indicates an expected call of GetPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) GetPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageGetPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).GetPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageGetPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageGetPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageGetPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageGetPickupCodeCall) Return(arg0 models.PickupCode, arg1 error) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageGetPickupCodeCall) Do(f func(context.Context, pgx.Tx, int) (models.PickupCode, error)) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageGetPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, int) (models.PickupCode, error)) *MockpickupCodeStorageGetPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegisterFailedAttempt mocks base method.
+func (m *MockpickupCodeStorage) RegisterFailedAttempt(arg0 context.Context, arg1 pgx.Tx, arg2, arg3 int, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailedAttempt", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterFailedAttempt indicates an expected call of RegisterFailedAttempt.
+func (mr *MockpickupCodeStorageMockRecorder) RegisterFailedAttempt(arg0, arg1, arg2, arg3, arg4 any) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailedAttempt", reflect.TypeOf((*MockpickupCodeStorage)(nil).RegisterFailedAttempt), arg0, arg1, arg2, arg3, arg4)
+	return &MockpickupCodeStorageRegisterFailedAttemptCall{Call: call}
+}
+
+// MockpickupCodeStorageRegisterFailedAttemptCall wrap *gomock.Call
+type MockpickupCodeStorageRegisterFailedAttemptCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) Return(arg0 error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) Do(f func(context.Context, pgx.Tx, int, int, time.Time) error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageRegisterFailedAttemptCall) DoAndReturn(f func(context.Context, pgx.Tx, int, int, time.Time) error) *MockpickupCodeStorageRegisterFailedAttemptCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// SetPickupCode mocks base method.
+func (m *MockpickupCodeStorage) SetPickupCode(arg0 context.Context, arg1 pgx.Tx, arg2 models.PickupCode) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPickupCode", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPickupCode indicates an expected call of SetPickupCode.
+func (mr *MockpickupCodeStorageMockRecorder) SetPickupCode(arg0, arg1, arg2 any) *MockpickupCodeStorageSetPickupCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPickupCode", reflect.TypeOf((*MockpickupCodeStorage)(nil).SetPickupCode), arg0, arg1, arg2)
+	return &MockpickupCodeStorageSetPickupCodeCall{Call: call}
+}
+
+// MockpickupCodeStorageSetPickupCodeCall wrap *gomock.Call
+type MockpickupCodeStorageSetPickupCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockpickupCodeStorageSetPickupCodeCall) Return(arg0 error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockpickupCodeStorageSetPickupCodeCall) Do(f func(context.Context, pgx.Tx, models.PickupCode) error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockpickupCodeStorageSetPickupCodeCall) DoAndReturn(f func(context.Context, pgx.Tx, models.PickupCode) error) *MockpickupCodeStorageSetPickupCodeCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocknotificationStorage is a mock of notificationStorage interface.
+type MocknotificationStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocknotificationStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocknotificationStorageMockRecorder is the mock recorder for MocknotificationStorage.
+type MocknotificationStorageMockRecorder struct {
+	mock *MocknotificationStorage
+}
+
+// NewMocknotificationStorage creates a new mock instance.
+func NewMocknotificationStorage(ctrl *gomock.Controller) *MocknotificationStorage {
+	mock := &MocknotificationStorage{ctrl: ctrl}
+	mock.recorder = &MocknotificationStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocknotificationStorage) EXPECT() *MocknotificationStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateNotification mocks base method.
+func (m *MocknotificationStorage) CreateNotification(arg0 context.Context, arg1 pgx.Tx, arg2 models.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MocknotificationStorageMockRecorder) CreateNotification(arg0, arg1, arg2 any) *MocknotificationStorageCreateNotificationCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MocknotificationStorage)(nil).CreateNotification), arg0, arg1, arg2)
+	return &MocknotificationStorageCreateNotificationCall{Call: call}
+}
+
+// MocknotificationStorageCreateNotificationCall wrap *gomock.Call
+type MocknotificationStorageCreateNotificationCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocknotificationStorageCreateNotificationCall) Return(arg0 error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocknotificationStorageCreateNotificationCall) Do(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocknotificationStorageCreateNotificationCall) DoAndReturn(f func(context.Context, pgx.Tx, models.Notification) error) *MocknotificationStorageCreateNotificationCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockwebhookStorage is a mock of webhookStorage interface.
+type MockwebhookStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockwebhookStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockwebhookStorageMockRecorder is the mock recorder for MockwebhookStorage.
+type MockwebhookStorageMockRecorder struct {
+	mock *MockwebhookStorage
+}
+
+// NewMockwebhookStorage creates a new mock instance.
+func NewMockwebhookStorage(ctrl *gomock.Controller) *MockwebhookStorage {
+	mock := &MockwebhookStorage{ctrl: ctrl}
+	mock.recorder = &MockwebhookStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockwebhookStorage) EXPECT() *MockwebhookStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsSubscription mocks base method.
+func (m *MockwebhookStorage) ContainsSubscription(arg0 context.Context, arg1 int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsSubscription", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsSubscription indicates an expected call of ContainsSubscription.
+func (mr *MockwebhookStorageMockRecorder) ContainsSubscription(arg0, arg1 any) *MockwebhookStorageContainsSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).ContainsSubscription), arg0, arg1)
+	return &MockwebhookStorageContainsSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageContainsSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageContainsSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageContainsSubscriptionCall) Return(arg0 bool, arg1 error) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageContainsSubscriptionCall) Do(f func(context.Context, int) (bool, error)) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageContainsSubscriptionCall) DoAndReturn(f func(context.Context, int) (bool, error)) *MockwebhookStorageContainsSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateSubscription mocks base method.
+func (m *MockwebhookStorage) CreateSubscription(arg0 context.Context, arg1 models.WebhookSubscription) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSubscription", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSubscription indicates an expected call of CreateSubscription.
+func (mr *MockwebhookStorageMockRecorder) CreateSubscription(arg0, arg1 any) *MockwebhookStorageCreateSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).CreateSubscription), arg0, arg1)
+	return &MockwebhookStorageCreateSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageCreateSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageCreateSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageCreateSubscriptionCall) Return(arg0 int, arg1 error) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageCreateSubscriptionCall) Do(f func(context.Context, models.WebhookSubscription) (int, error)) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageCreateSubscriptionCall) DoAndReturn(f func(context.Context, models.WebhookSubscription) (int, error)) *MockwebhookStorageCreateSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeleteSubscription mocks base method.
+func (m *MockwebhookStorage) DeleteSubscription(arg0 context.Context, arg1 int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSubscription", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSubscription indicates an expected call of DeleteSubscription.
+func (mr *MockwebhookStorageMockRecorder) DeleteSubscription(arg0, arg1 any) *MockwebhookStorageDeleteSubscriptionCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSubscription", reflect.TypeOf((*MockwebhookStorage)(nil).DeleteSubscription), arg0, arg1)
+	return &MockwebhookStorageDeleteSubscriptionCall{Call: call}
+}
+
+// MockwebhookStorageDeleteSubscriptionCall wrap *gomock.Call
+type MockwebhookStorageDeleteSubscriptionCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageDeleteSubscriptionCall) Return(arg0 error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageDeleteSubscriptionCall) Do(f func(context.Context, int) error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageDeleteSubscriptionCall) DoAndReturn(f func(context.Context, int) error) *MockwebhookStorageDeleteSubscriptionCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// EnqueueEvent mocks base method.
+func (m *MockwebhookStorage) EnqueueEvent(arg0 context.Context, arg1 pgx.Tx, arg2 models.WebhookEvent, arg3 int, arg4 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueEvent", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueEvent indicates an expected call of EnqueueEvent.
+func (mr *MockwebhookStorageMockRecorder) EnqueueEvent(arg0, arg1, arg2, arg3, arg4 any) *MockwebhookStorageEnqueueEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueEvent", reflect.TypeOf((*MockwebhookStorage)(nil).EnqueueEvent), arg0, arg1, arg2, arg3, arg4)
+	return &MockwebhookStorageEnqueueEventCall{Call: call}
+}
+
+// MockwebhookStorageEnqueueEventCall wrap *gomock.Call
+type MockwebhookStorageEnqueueEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageEnqueueEventCall) Return(arg0 error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageEnqueueEventCall) Do(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageEnqueueEventCall) DoAndReturn(f func(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error) *MockwebhookStorageEnqueueEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAndMarkDeliveries mocks base method.
+func (m *MockwebhookStorage) GetAndMarkDeliveries(arg0 context.Context, arg1 int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndMarkDeliveries", arg0, arg1)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndMarkDeliveries indicates an expected call of GetAndMarkDeliveries.
+func (mr *MockwebhookStorageMockRecorder) GetAndMarkDeliveries(arg0, arg1 any) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndMarkDeliveries", reflect.TypeOf((*MockwebhookStorage)(nil).GetAndMarkDeliveries), arg0, arg1)
+	return &MockwebhookStorageGetAndMarkDeliveriesCall{Call: call}
+}
+
+// MockwebhookStorageGetAndMarkDeliveriesCall wrap *gomock.Call
+type MockwebhookStorageGetAndMarkDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) Return(arg0 []models.WebhookDelivery, arg1 error) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) Do(f func(context.Context, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetAndMarkDeliveriesCall) DoAndReturn(f func(context.Context, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetAndMarkDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetDeliveries mocks base method.
+func (m *MockwebhookStorage) GetDeliveries(arg0 context.Context, arg1 []query.Cond, arg2, arg3 int) ([]models.WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeliveries", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeliveries indicates an expected call of GetDeliveries.
+func (mr *MockwebhookStorageMockRecorder) GetDeliveries(arg0, arg1, arg2, arg3 any) *MockwebhookStorageGetDeliveriesCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeliveries", reflect.TypeOf((*MockwebhookStorage)(nil).GetDeliveries), arg0, arg1, arg2, arg3)
+	return &MockwebhookStorageGetDeliveriesCall{Call: call}
+}
+
+// MockwebhookStorageGetDeliveriesCall wrap *gomock.Call
+type MockwebhookStorageGetDeliveriesCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetDeliveriesCall) Return(arg0 []models.WebhookDelivery, arg1 error) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetDeliveriesCall) Do(f func(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetDeliveriesCall) DoAndReturn(f func(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)) *MockwebhookStorageGetDeliveriesCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetExpiredOrders mocks base method.
+func (m *MockwebhookStorage) GetExpiredOrders(arg0 context.Context, arg1, arg2 time.Time, arg3 int) ([]models.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredOrders", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredOrders indicates an expected call of GetExpiredOrders.
+func (mr *MockwebhookStorageMockRecorder) GetExpiredOrders(arg0, arg1, arg2, arg3 any) *MockwebhookStorageGetExpiredOrdersCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredOrders", reflect.TypeOf((*MockwebhookStorage)(nil).GetExpiredOrders), arg0, arg1, arg2, arg3)
+	return &MockwebhookStorageGetExpiredOrdersCall{Call: call}
+}
+
+// MockwebhookStorageGetExpiredOrdersCall wrap *gomock.Call
+type MockwebhookStorageGetExpiredOrdersCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetExpiredOrdersCall) Return(arg0 []models.Order, arg1 error) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetExpiredOrdersCall) Do(f func(context.Context, time.Time, time.Time, int) ([]models.Order, error)) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetExpiredOrdersCall) DoAndReturn(f func(context.Context, time.Time, time.Time, int) ([]models.Order, error)) *MockwebhookStorageGetExpiredOrdersCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetSubscriptions mocks base method.
+func (m *MockwebhookStorage) GetSubscriptions(arg0 context.Context) ([]models.WebhookSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSubscriptions", arg0)
+	ret0, _ := ret[0].([]models.WebhookSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSubscriptions indicates an expected call of GetSubscriptions.
+func (mr *MockwebhookStorageMockRecorder) GetSubscriptions(arg0 any) *MockwebhookStorageGetSubscriptionsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscriptions", reflect.TypeOf((*MockwebhookStorage)(nil).GetSubscriptions), arg0)
+	return &MockwebhookStorageGetSubscriptionsCall{Call: call}
+}
+
+// MockwebhookStorageGetSubscriptionsCall wrap *gomock.Call
+type MockwebhookStorageGetSubscriptionsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageGetSubscriptionsCall) Return(arg0 []models.WebhookSubscription, arg1 error) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageGetSubscriptionsCall) Do(f func(context.Context) ([]models.WebhookSubscription, error)) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageGetSubscriptionsCall) DoAndReturn(f func(context.Context) ([]models.WebhookSubscription, error)) *MockwebhookStorageGetSubscriptionsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateDelivery mocks base method.
+func (m *MockwebhookStorage) UpdateDelivery(arg0 context.Context, arg1 models.WebhookDelivery) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDelivery", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDelivery indicates an expected call of UpdateDelivery.
+func (mr *MockwebhookStorageMockRecorder) UpdateDelivery(arg0, arg1 any) *MockwebhookStorageUpdateDeliveryCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDelivery", reflect.TypeOf((*MockwebhookStorage)(nil).UpdateDelivery), arg0, arg1)
+	return &MockwebhookStorageUpdateDeliveryCall{Call: call}
+}
+
+// MockwebhookStorageUpdateDeliveryCall wrap *gomock.Call
+type MockwebhookStorageUpdateDeliveryCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockwebhookStorageUpdateDeliveryCall) Return(arg0 error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockwebhookStorageUpdateDeliveryCall) Do(f func(context.Context, models.WebhookDelivery) error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockwebhookStorageUpdateDeliveryCall) DoAndReturn(f func(context.Context, models.WebhookDelivery) error) *MockwebhookStorageUpdateDeliveryCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockorderEventStorage is a mock of orderEventStorage interface.
+type MockorderEventStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockorderEventStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockorderEventStorageMockRecorder is the mock recorder for MockorderEventStorage.
+type MockorderEventStorageMockRecorder struct {
+	mock *MockorderEventStorage
+}
+
+// NewMockorderEventStorage creates a new mock instance.
+func NewMockorderEventStorage(ctrl *gomock.Controller) *MockorderEventStorage {
+	mock := &MockorderEventStorage{ctrl: ctrl}
+	mock.recorder = &MockorderEventStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockorderEventStorage) EXPECT() *MockorderEventStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateOrderEvent mocks base method.
+func (m *MockorderEventStorage) CreateOrderEvent(arg0 context.Context, arg1 pgx.Tx, arg2 models.OrderEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrderEvent", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrderEvent indicates an expected call of CreateOrderEvent.
+func (mr *MockorderEventStorageMockRecorder) CreateOrderEvent(arg0, arg1, arg2 any) *MockorderEventStorageCreateOrderEventCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrderEvent", reflect.TypeOf((*MockorderEventStorage)(nil).CreateOrderEvent), arg0, arg1, arg2)
+	return &MockorderEventStorageCreateOrderEventCall{Call: call}
+}
+
+// MockorderEventStorageCreateOrderEventCall wrap *gomock.Call
+type MockorderEventStorageCreateOrderEventCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderEventStorageCreateOrderEventCall) Return(arg0 error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderEventStorageCreateOrderEventCall) Do(f func(context.Context, pgx.Tx, models.OrderEvent) error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderEventStorageCreateOrderEventCall) DoAndReturn(f func(context.Context, pgx.Tx, models.OrderEvent) error) *MockorderEventStorageCreateOrderEventCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktokenStorage is a mock of tokenStorage interface.
+type MocktokenStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocktokenStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocktokenStorageMockRecorder is the mock recorder for MocktokenStorage.
+type MocktokenStorageMockRecorder struct {
+	mock *MocktokenStorage
+}
+
+// NewMocktokenStorage creates a new mock instance.
+func NewMocktokenStorage(ctrl *gomock.Controller) *MocktokenStorage {
+	mock := &MocktokenStorage{ctrl: ctrl}
+	mock.recorder = &MocktokenStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktokenStorage) EXPECT() *MocktokenStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsRefreshToken mocks base method.
+func (m *MocktokenStorage) ContainsRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsRefreshToken indicates an expected call of ContainsRefreshToken.
+func (mr *MocktokenStorageMockRecorder) ContainsRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageContainsRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).ContainsRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageContainsRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageContainsRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageContainsRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageContainsRefreshTokenCall) Return(arg0 bool, arg1 error) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageContainsRefreshTokenCall) Do(f func(context.Context, pgx.Tx, string) (bool, error)) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageContainsRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, string) (bool, error)) *MocktokenStorageContainsRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateRefreshToken mocks base method.
+func (m *MocktokenStorage) CreateRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 models.RefreshToken) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateRefreshToken indicates an expected call of CreateRefreshToken.
+func (mr *MocktokenStorageMockRecorder) CreateRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageCreateRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).CreateRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageCreateRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageCreateRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageCreateRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageCreateRefreshTokenCall) Return(arg0 error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageCreateRefreshTokenCall) Do(f func(context.Context, pgx.Tx, models.RefreshToken) error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageCreateRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, models.RefreshToken) error) *MocktokenStorageCreateRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetRefreshToken mocks base method.
+func (m *MocktokenStorage) GetRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 string) (models.RefreshToken, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRefreshToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(models.RefreshToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRefreshToken indicates an expected call of GetRefreshToken.
+func (mr *MocktokenStorageMockRecorder) GetRefreshToken(arg0, arg1, arg2 any) *MocktokenStorageGetRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).GetRefreshToken), arg0, arg1, arg2)
+	return &MocktokenStorageGetRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageGetRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageGetRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageGetRefreshTokenCall) Return(arg0 models.RefreshToken, arg1 error) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageGetRefreshTokenCall) Do(f func(context.Context, pgx.Tx, string) (models.RefreshToken, error)) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageGetRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, string) (models.RefreshToken, error)) *MocktokenStorageGetRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// IsAccessTokenRevoked mocks base method.
+func (m *MocktokenStorage) IsAccessTokenRevoked(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccessTokenRevoked", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAccessTokenRevoked indicates an expected call of IsAccessTokenRevoked.
+func (mr *MocktokenStorageMockRecorder) IsAccessTokenRevoked(arg0, arg1 any) *MocktokenStorageIsAccessTokenRevokedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccessTokenRevoked", reflect.TypeOf((*MocktokenStorage)(nil).IsAccessTokenRevoked), arg0, arg1)
+	return &MocktokenStorageIsAccessTokenRevokedCall{Call: call}
+}
+
+// MocktokenStorageIsAccessTokenRevokedCall wrap *gomock.Call
+type MocktokenStorageIsAccessTokenRevokedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageIsAccessTokenRevokedCall) Return(arg0 bool, arg1 error) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageIsAccessTokenRevokedCall) Do(f func(context.Context, string) (bool, error)) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageIsAccessTokenRevokedCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MocktokenStorageIsAccessTokenRevokedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAccessToken mocks base method.
+func (m *MocktokenStorage) RevokeAccessToken(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAccessToken", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAccessToken indicates an expected call of RevokeAccessToken.
+func (mr *MocktokenStorageMockRecorder) RevokeAccessToken(arg0, arg1, arg2 any) *MocktokenStorageRevokeAccessTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAccessToken", reflect.TypeOf((*MocktokenStorage)(nil).RevokeAccessToken), arg0, arg1, arg2)
+	return &MocktokenStorageRevokeAccessTokenCall{Call: call}
+}
+
+// MocktokenStorageRevokeAccessTokenCall wrap *gomock.Call
+type MocktokenStorageRevokeAccessTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeAccessTokenCall) Return(arg0 error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeAccessTokenCall) Do(f func(context.Context, string, time.Time) error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeAccessTokenCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MocktokenStorageRevokeAccessTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAdminRefreshTokens mocks base method.
+func (m *MocktokenStorage) RevokeAdminRefreshTokens(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAdminRefreshTokens", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAdminRefreshTokens indicates an expected call of RevokeAdminRefreshTokens.
+func (mr *MocktokenStorageMockRecorder) RevokeAdminRefreshTokens(arg0, arg1, arg2, arg3 any) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAdminRefreshTokens", reflect.TypeOf((*MocktokenStorage)(nil).RevokeAdminRefreshTokens), arg0, arg1, arg2, arg3)
+	return &MocktokenStorageRevokeAdminRefreshTokensCall{Call: call}
+}
+
+// MocktokenStorageRevokeAdminRefreshTokensCall wrap *gomock.Call
+type MocktokenStorageRevokeAdminRefreshTokensCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) Return(arg0 error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) Do(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeAdminRefreshTokensCall) DoAndReturn(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeAdminRefreshTokensCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeRefreshToken mocks base method.
+func (m *MocktokenStorage) RevokeRefreshToken(arg0 context.Context, arg1 pgx.Tx, arg2 int, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeRefreshToken", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeRefreshToken indicates an expected call of RevokeRefreshToken.
+func (mr *MocktokenStorageMockRecorder) RevokeRefreshToken(arg0, arg1, arg2, arg3 any) *MocktokenStorageRevokeRefreshTokenCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeRefreshToken", reflect.TypeOf((*MocktokenStorage)(nil).RevokeRefreshToken), arg0, arg1, arg2, arg3)
+	return &MocktokenStorageRevokeRefreshTokenCall{Call: call}
+}
+
+// MocktokenStorageRevokeRefreshTokenCall wrap *gomock.Call
+type MocktokenStorageRevokeRefreshTokenCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktokenStorageRevokeRefreshTokenCall) Return(arg0 error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktokenStorageRevokeRefreshTokenCall) Do(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktokenStorageRevokeRefreshTokenCall) DoAndReturn(f func(context.Context, pgx.Tx, int, time.Time) error) *MocktokenStorageRevokeRefreshTokenCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockloginAttemptStorage is a mock of loginAttemptStorage interface.
+type MockloginAttemptStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockloginAttemptStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockloginAttemptStorageMockRecorder is the mock recorder for MockloginAttemptStorage.
+type MockloginAttemptStorageMockRecorder struct {
+	mock *MockloginAttemptStorage
+}
+
+// NewMockloginAttemptStorage creates a new mock instance.
+func NewMockloginAttemptStorage(ctrl *gomock.Controller) *MockloginAttemptStorage {
+	mock := &MockloginAttemptStorage{ctrl: ctrl}
+	mock.recorder = &MockloginAttemptStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockloginAttemptStorage) EXPECT() *MockloginAttemptStorageMockRecorder {
+	return m.recorder
+}
+
+// GetLoginAttempts mocks base method.
+func (m *MockloginAttemptStorage) GetLoginAttempts(arg0 context.Context, arg1, arg2 string) ([]models.LoginAttempt, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLoginAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.LoginAttempt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLoginAttempts indicates an expected call of GetLoginAttempts.
+func (mr *MockloginAttemptStorageMockRecorder) GetLoginAttempts(arg0, arg1, arg2 any) *MockloginAttemptStorageGetLoginAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoginAttempts", reflect.TypeOf((*MockloginAttemptStorage)(nil).GetLoginAttempts), arg0, arg1, arg2)
+	return &MockloginAttemptStorageGetLoginAttemptsCall{Call: call}
+}
+
+// MockloginAttemptStorageGetLoginAttemptsCall wrap *gomock.Call
+type MockloginAttemptStorageGetLoginAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) Return(arg0 []models.LoginAttempt, arg1 error) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) Do(f func(context.Context, string, string) ([]models.LoginAttempt, error)) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageGetLoginAttemptsCall) DoAndReturn(f func(context.Context, string, string) ([]models.LoginAttempt, error)) *MockloginAttemptStorageGetLoginAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// LockLogin mocks base method.
+func (m *MockloginAttemptStorage) LockLogin(arg0 context.Context, arg1, arg2 string, arg3 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockLogin", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LockLogin indicates an expected call of LockLogin.
+func (mr *MockloginAttemptStorageMockRecorder) LockLogin(arg0, arg1, arg2, arg3 any) *MockloginAttemptStorageLockLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockLogin", reflect.TypeOf((*MockloginAttemptStorage)(nil).LockLogin), arg0, arg1, arg2, arg3)
+	return &MockloginAttemptStorageLockLoginCall{Call: call}
+}
+
+// MockloginAttemptStorageLockLoginCall wrap *gomock.Call
+type MockloginAttemptStorageLockLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageLockLoginCall) Return(arg0 error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageLockLoginCall) Do(f func(context.Context, string, string, time.Time) error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageLockLoginCall) DoAndReturn(f func(context.Context, string, string, time.Time) error) *MockloginAttemptStorageLockLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegisterFailedLogin mocks base method.
+func (m *MockloginAttemptStorage) RegisterFailedLogin(arg0 context.Context, arg1, arg2 string, arg3, arg4 time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailedLogin", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailedLogin indicates an expected call of RegisterFailedLogin.
+func (mr *MockloginAttemptStorageMockRecorder) RegisterFailedLogin(arg0, arg1, arg2, arg3, arg4 any) *MockloginAttemptStorageRegisterFailedLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailedLogin", reflect.TypeOf((*MockloginAttemptStorage)(nil).RegisterFailedLogin), arg0, arg1, arg2, arg3, arg4)
+	return &MockloginAttemptStorageRegisterFailedLoginCall{Call: call}
+}
+
+// MockloginAttemptStorageRegisterFailedLoginCall wrap *gomock.Call
+type MockloginAttemptStorageRegisterFailedLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) Return(arg0 int, arg1 error) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) Do(f func(context.Context, string, string, time.Time, time.Time) (int, error)) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageRegisterFailedLoginCall) DoAndReturn(f func(context.Context, string, string, time.Time, time.Time) (int, error)) *MockloginAttemptStorageRegisterFailedLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ResetLoginAttempts mocks base method.
+func (m *MockloginAttemptStorage) ResetLoginAttempts(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetLoginAttempts", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetLoginAttempts indicates an expected call of ResetLoginAttempts.
+func (mr *MockloginAttemptStorageMockRecorder) ResetLoginAttempts(arg0, arg1, arg2 any) *MockloginAttemptStorageResetLoginAttemptsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetLoginAttempts", reflect.TypeOf((*MockloginAttemptStorage)(nil).ResetLoginAttempts), arg0, arg1, arg2)
+	return &MockloginAttemptStorageResetLoginAttemptsCall{Call: call}
+}
+
+// MockloginAttemptStorageResetLoginAttemptsCall wrap *gomock.Call
+type MockloginAttemptStorageResetLoginAttemptsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) Return(arg0 error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) Do(f func(context.Context, string, string) error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockloginAttemptStorageResetLoginAttemptsCall) DoAndReturn(f func(context.Context, string, string) error) *MockloginAttemptStorageResetLoginAttemptsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MockapiKeyStorage is a mock of apiKeyStorage interface.
+type MockapiKeyStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockapiKeyStorageMockRecorder
+	isgomock struct{}
+}
+
+// MockapiKeyStorageMockRecorder is the mock recorder for MockapiKeyStorage.
+type MockapiKeyStorageMockRecorder struct {
+	mock *MockapiKeyStorage
+}
+
+// NewMockapiKeyStorage creates a new mock instance.
+func NewMockapiKeyStorage(ctrl *gomock.Controller) *MockapiKeyStorage {
+	mock := &MockapiKeyStorage{ctrl: ctrl}
+	mock.recorder = &MockapiKeyStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockapiKeyStorage) EXPECT() *MockapiKeyStorageMockRecorder {
+	return m.recorder
+}
+
+// ContainsAPIKey mocks base method.
+func (m *MockapiKeyStorage) ContainsAPIKey(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ContainsAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ContainsAPIKey indicates an expected call of ContainsAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) ContainsAPIKey(arg0, arg1 any) *MockapiKeyStorageContainsAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ContainsAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).ContainsAPIKey), arg0, arg1)
+	return &MockapiKeyStorageContainsAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageContainsAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageContainsAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageContainsAPIKeyCall) Return(arg0 bool, arg1 error) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageContainsAPIKeyCall) Do(f func(context.Context, string) (bool, error)) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageContainsAPIKeyCall) DoAndReturn(f func(context.Context, string) (bool, error)) *MockapiKeyStorageContainsAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockapiKeyStorage) CreateAPIKey(arg0 context.Context, arg1 models.APIKey) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) CreateAPIKey(arg0, arg1 any) *MockapiKeyStorageCreateAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).CreateAPIKey), arg0, arg1)
+	return &MockapiKeyStorageCreateAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageCreateAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageCreateAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageCreateAPIKeyCall) Return(arg0 int, arg1 error) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageCreateAPIKeyCall) Do(f func(context.Context, models.APIKey) (int, error)) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageCreateAPIKeyCall) DoAndReturn(f func(context.Context, models.APIKey) (int, error)) *MockapiKeyStorageCreateAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAPIKey mocks base method.
+func (m *MockapiKeyStorage) GetAPIKey(arg0 context.Context, arg1 string) (models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKey", arg0, arg1)
+	ret0, _ := ret[0].(models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKey indicates an expected call of GetAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) GetAPIKey(arg0, arg1 any) *MockapiKeyStorageGetAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).GetAPIKey), arg0, arg1)
+	return &MockapiKeyStorageGetAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageGetAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageGetAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageGetAPIKeyCall) Return(arg0 models.APIKey, arg1 error) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageGetAPIKeyCall) Do(f func(context.Context, string) (models.APIKey, error)) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageGetAPIKeyCall) DoAndReturn(f func(context.Context, string) (models.APIKey, error)) *MockapiKeyStorageGetAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAPIKeys mocks base method.
+func (m *MockapiKeyStorage) GetAPIKeys(arg0 context.Context) ([]models.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIKeys", arg0)
+	ret0, _ := ret[0].([]models.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIKeys indicates an expected call of GetAPIKeys.
+func (mr *MockapiKeyStorageMockRecorder) GetAPIKeys(arg0 any) *MockapiKeyStorageGetAPIKeysCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIKeys", reflect.TypeOf((*MockapiKeyStorage)(nil).GetAPIKeys), arg0)
+	return &MockapiKeyStorageGetAPIKeysCall{Call: call}
+}
+
+// MockapiKeyStorageGetAPIKeysCall wrap *gomock.Call
+type MockapiKeyStorageGetAPIKeysCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageGetAPIKeysCall) Return(arg0 []models.APIKey, arg1 error) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageGetAPIKeysCall) Do(f func(context.Context) ([]models.APIKey, error)) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageGetAPIKeysCall) DoAndReturn(f func(context.Context) ([]models.APIKey, error)) *MockapiKeyStorageGetAPIKeysCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockapiKeyStorage) RevokeAPIKey(arg0 context.Context, arg1 string, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) RevokeAPIKey(arg0, arg1, arg2 any) *MockapiKeyStorageRevokeAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).RevokeAPIKey), arg0, arg1, arg2)
+	return &MockapiKeyStorageRevokeAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageRevokeAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageRevokeAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageRevokeAPIKeyCall) Return(arg0 error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageRevokeAPIKeyCall) Do(f func(context.Context, string, time.Time) error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageRevokeAPIKeyCall) DoAndReturn(f func(context.Context, string, time.Time) error) *MockapiKeyStorageRevokeAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// TouchAPIKey mocks base method.
+func (m *MockapiKeyStorage) TouchAPIKey(arg0 context.Context, arg1 int, arg2 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchAPIKey", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchAPIKey indicates an expected call of TouchAPIKey.
+func (mr *MockapiKeyStorageMockRecorder) TouchAPIKey(arg0, arg1, arg2 any) *MockapiKeyStorageTouchAPIKeyCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchAPIKey", reflect.TypeOf((*MockapiKeyStorage)(nil).TouchAPIKey), arg0, arg1, arg2)
+	return &MockapiKeyStorageTouchAPIKeyCall{Call: call}
+}
+
+// MockapiKeyStorageTouchAPIKeyCall wrap *gomock.Call
+type MockapiKeyStorageTouchAPIKeyCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockapiKeyStorageTouchAPIKeyCall) Return(arg0 error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockapiKeyStorageTouchAPIKeyCall) Do(f func(context.Context, int, time.Time) error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockapiKeyStorageTouchAPIKeyCall) DoAndReturn(f func(context.Context, int, time.Time) error) *MockapiKeyStorageTouchAPIKeyCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocklogStorage is a mock of logStorage interface.
+type MocklogStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogStorageMockRecorder
+	isgomock struct{}
+}
+
+// MocklogStorageMockRecorder is the mock recorder for MocklogStorage.
+type MocklogStorageMockRecorder struct {
+	mock *MocklogStorage
+}
+
+// NewMocklogStorage creates a new mock instance.
+func NewMocklogStorage(ctrl *gomock.Controller) *MocklogStorage {
+	mock := &MocklogStorage{ctrl: ctrl}
+	mock.recorder = &MocklogStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogStorage) EXPECT() *MocklogStorageMockRecorder {
+	return m.recorder
+}
+
+// CreateLog mocks base method.
+func (m *MocklogStorage) CreateLog(arg0 context.Context, arg1 []models.Log) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLog", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateLog indicates an expected call of CreateLog.
+func (mr *MocklogStorageMockRecorder) CreateLog(arg0, arg1 any) *MocklogStorageCreateLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLog", reflect.TypeOf((*MocklogStorage)(nil).CreateLog), arg0, arg1)
+	return &MocklogStorageCreateLogCall{Call: call}
+}
+
+// MocklogStorageCreateLogCall wrap *gomock.Call
+type MocklogStorageCreateLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageCreateLogCall) Return(arg0 error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageCreateLogCall) Do(f func(context.Context, []models.Log) error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageCreateLogCall) DoAndReturn(f func(context.Context, []models.Log) error) *MocklogStorageCreateLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetAndMarkLogs mocks base method.
+func (m *MocklogStorage) GetAndMarkLogs(arg0 context.Context, arg1 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndMarkLogs", arg0, arg1)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndMarkLogs indicates an expected call of GetAndMarkLogs.
+func (mr *MocklogStorageMockRecorder) GetAndMarkLogs(arg0, arg1 any) *MocklogStorageGetAndMarkLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndMarkLogs", reflect.TypeOf((*MocklogStorage)(nil).GetAndMarkLogs), arg0, arg1)
+	return &MocklogStorageGetAndMarkLogsCall{Call: call}
+}
+
+// MocklogStorageGetAndMarkLogsCall wrap *gomock.Call
+type MocklogStorageGetAndMarkLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageGetAndMarkLogsCall) Return(arg0 []models.Log, arg1 error) *MocklogStorageGetAndMarkLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageGetAndMarkLogsCall) Do(f func(context.Context, int) ([]models.Log, error)) *MocklogStorageGetAndMarkLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageGetAndMarkLogsCall) DoAndReturn(f func(context.Context, int) ([]models.Log, error)) *MocklogStorageGetAndMarkLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetLogsAfter mocks base method.
+func (m *MocklogStorage) GetLogsAfter(arg0 context.Context, arg1, arg2 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLogsAfter", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogsAfter indicates an expected call of GetLogsAfter.
+func (mr *MocklogStorageMockRecorder) GetLogsAfter(arg0, arg1, arg2 any) *MocklogStorageGetLogsAfterCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogsAfter", reflect.TypeOf((*MocklogStorage)(nil).GetLogsAfter), arg0, arg1, arg2)
+	return &MocklogStorageGetLogsAfterCall{Call: call}
+}
+
+// MocklogStorageGetLogsAfterCall wrap *gomock.Call
+type MocklogStorageGetLogsAfterCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageGetLogsAfterCall) Return(arg0 []models.Log, arg1 error) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageGetLogsAfterCall) Do(f func(context.Context, int, int) ([]models.Log, error)) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageGetLogsAfterCall) DoAndReturn(f func(context.Context, int, int) ([]models.Log, error)) *MocklogStorageGetLogsAfterCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ListLogs mocks base method.
+func (m *MocklogStorage) ListLogs(arg0 context.Context, arg1 []query.Cond, arg2, arg3 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLogs indicates an expected call of ListLogs.
+func (mr *MocklogStorageMockRecorder) ListLogs(arg0, arg1, arg2, arg3 any) *MocklogStorageListLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogs", reflect.TypeOf((*MocklogStorage)(nil).ListLogs), arg0, arg1, arg2, arg3)
+	return &MocklogStorageListLogsCall{Call: call}
+}
+
+// MocklogStorageListLogsCall wrap *gomock.Call
+type MocklogStorageListLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageListLogsCall) Return(arg0 []models.Log, arg1 error) *MocklogStorageListLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageListLogsCall) Do(f func(context.Context, []query.Cond, int, int) ([]models.Log, error)) *MocklogStorageListLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageListLogsCall) DoAndReturn(f func(context.Context, []query.Cond, int, int) ([]models.Log, error)) *MocklogStorageListLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RequeueDeadLogs mocks base method.
+func (m *MocklogStorage) RequeueDeadLogs(arg0 context.Context, arg1 []int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequeueDeadLogs", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequeueDeadLogs indicates an expected call of RequeueDeadLogs.
+func (mr *MocklogStorageMockRecorder) RequeueDeadLogs(arg0, arg1 any) *MocklogStorageRequeueDeadLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequeueDeadLogs", reflect.TypeOf((*MocklogStorage)(nil).RequeueDeadLogs), arg0, arg1)
+	return &MocklogStorageRequeueDeadLogsCall{Call: call}
+}
+
+// MocklogStorageRequeueDeadLogsCall wrap *gomock.Call
+type MocklogStorageRequeueDeadLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageRequeueDeadLogsCall) Return(arg0 int, arg1 error) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageRequeueDeadLogsCall) Do(f func(context.Context, []int) (int, error)) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageRequeueDeadLogsCall) DoAndReturn(f func(context.Context, []int) (int, error)) *MocklogStorageRequeueDeadLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// UpdateLog mocks base method.
+func (m *MocklogStorage) UpdateLog(arg0 context.Context, arg1, arg2, arg3 int, arg4 time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLog", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLog indicates an expected call of UpdateLog.
+func (mr *MocklogStorageMockRecorder) UpdateLog(arg0, arg1, arg2, arg3, arg4 any) *MocklogStorageUpdateLogCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLog", reflect.TypeOf((*MocklogStorage)(nil).UpdateLog), arg0, arg1, arg2, arg3, arg4)
+	return &MocklogStorageUpdateLogCall{Call: call}
+}
+
+// MocklogStorageUpdateLogCall wrap *gomock.Call
+type MocklogStorageUpdateLogCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogStorageUpdateLogCall) Return(arg0 error) *MocklogStorageUpdateLogCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogStorageUpdateLogCall) Do(f func(context.Context, int, int, int, time.Time) error) *MocklogStorageUpdateLogCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogStorageUpdateLogCall) DoAndReturn(f func(context.Context, int, int, int, time.Time) error) *MocklogStorageUpdateLogCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// MocktxManager is a mock of txManager interface.
+type MocktxManager struct {
+	ctrl     *gomock.Controller
+	recorder *MocktxManagerMockRecorder
+	isgomock struct{}
+}
+
+// MocktxManagerMockRecorder is the mock recorder for MocktxManager.
+type MocktxManagerMockRecorder struct {
+	mock *MocktxManager
+}
+
+// NewMocktxManager creates a new mock instance.
+func NewMocktxManager(ctrl *gomock.Controller) *MocktxManager {
+	mock := &MocktxManager{ctrl: ctrl}
+	mock.recorder = &MocktxManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocktxManager) EXPECT() *MocktxManagerMockRecorder {
+	return m.recorder
+}
+
+// RunReadCommitted mocks base method.
+func (m *MocktxManager) RunReadCommitted(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunReadCommitted", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunReadCommitted indicates an expected call of RunReadCommitted.
+func (mr *MocktxManagerMockRecorder) RunReadCommitted(arg0, arg1 any) *MocktxManagerRunReadCommittedCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunReadCommitted", reflect.TypeOf((*MocktxManager)(nil).RunReadCommitted), arg0, arg1)
+	return &MocktxManagerRunReadCommittedCall{Call: call}
+}
+
+// MocktxManagerRunReadCommittedCall wrap *gomock.Call
+type MocktxManagerRunReadCommittedCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunReadCommittedCall) Return(arg0 error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunReadCommittedCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunReadCommittedCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunReadCommittedCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunRepeatableRead mocks base method.
+func (m *MocktxManager) RunRepeatableRead(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunRepeatableRead", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunRepeatableRead indicates an expected call of RunRepeatableRead.
+func (mr *MocktxManagerMockRecorder) RunRepeatableRead(arg0, arg1 any) *MocktxManagerRunRepeatableReadCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRepeatableRead", reflect.TypeOf((*MocktxManager)(nil).RunRepeatableRead), arg0, arg1)
+	return &MocktxManagerRunRepeatableReadCall{Call: call}
+}
+
+// MocktxManagerRunRepeatableReadCall wrap *gomock.Call
+type MocktxManagerRunRepeatableReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunRepeatableReadCall) Return(arg0 error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunRepeatableReadCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunRepeatableReadCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunRepeatableReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RunSerializable mocks base method.
+func (m *MocktxManager) RunSerializable(arg0 context.Context, arg1 func(context.Context, pgx.Tx) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunSerializable", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunSerializable indicates an expected call of RunSerializable.
+func (mr *MocktxManagerMockRecorder) RunSerializable(arg0, arg1 any) *MocktxManagerRunSerializableCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSerializable", reflect.TypeOf((*MocktxManager)(nil).RunSerializable), arg0, arg1)
+	return &MocktxManagerRunSerializableCall{Call: call}
+}
+
+// MocktxManagerRunSerializableCall wrap *gomock.Call
+type MocktxManagerRunSerializableCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocktxManagerRunSerializableCall) Return(arg0 error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocktxManagerRunSerializableCall) Do(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocktxManagerRunSerializableCall) DoAndReturn(f func(context.Context, func(context.Context, pgx.Tx) error) error) *MocktxManagerRunSerializableCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -2,6 +2,7 @@ package order
 
 import (
 	"context"
+	"errors"
 
 	"github.com/Rhymond/go-money"
 	"github.com/opentracing/opentracing-go"
@@ -9,7 +10,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
 )
@@ -37,6 +40,22 @@ func (h *Handler) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest
 		return nil, errMissingFields
 	}
 
+	currencyCode := money.RUB
+	if req.GetCurrency() != "" {
+		var err error
+		currencyCode, err = currency.Validate(req.GetCurrency())
+		if err != nil {
+			logger.Error(errUnknownCurrency.Error(),
+				zap.Int("order_id", int(req.GetId())),
+				zap.String("currency", req.GetCurrency()),
+				zap.Error(err),
+			)
+			span.SetTag("error", errUnknownCurrency)
+
+			return nil, errUnknownCurrency
+		}
+	}
+
 	packagingName := models.GetPackagingName(models.PackagingType(req.GetPackaging()))
 	extraPackagingName := models.GetPackagingName(models.PackagingType(req.GetExtraPackaging()))
 
@@ -56,9 +75,13 @@ func (h *Handler) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest
 	packagings = append(packagings, packaging)
 	packagings = append(packagings, extraPackaging)
 
-	err = h.Service.AcceptOrder(ctx, int(req.GetId()), int(req.GetUserId()), req.GetWeight(),
-		*money.New(req.GetPrice(), money.RUB), req.GetExpiryDate().AsTime(), packagings)
-	if err != nil {
+	pickupCode, err := h.Service.AcceptOrder(ctx, int(req.GetId()), int(req.GetUserId()), req.GetWeight(),
+		*money.New(req.GetPrice(), currencyCode), req.GetExpiryDate().AsTime(), packagings)
+	if errors.Is(err, order.ErrClientNotFound) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
 		span.SetTag("error", err)
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -74,7 +97,8 @@ func (h *Handler) CreateOrder(ctx context.Context, req *proto.CreateOrderRequest
 	monitoring.SetOrderTotalPrice(req.GetPrice())
 
 	return &proto.CreateOrderResponse{
-		Output: "success",
+		Output:     "success",
+		PickupCode: pickupCode,
 	}, nil
 }
 
@@ -2,6 +2,8 @@ package order
 
 import (
 	"context"
+	"errors"
+	"strings"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
@@ -9,6 +11,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
 )
@@ -28,7 +31,11 @@ func (h *Handler) GetOrders(ctx context.Context, req *proto.GetOrdersRequest) (*
 	)
 
 	orders, err := h.Service.GetOrders(ctx, conds, int(req.GetCount()), int(req.GetPage()))
-	if err != nil {
+	if errors.Is(err, models.ErrWrongPhone) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	} else if err != nil {
 		span.SetTag("error", err)
 
 		return nil, status.Error(codes.Internal, err.Error())
@@ -47,22 +54,32 @@ func (h *Handler) GetOrders(ctx context.Context, req *proto.GetOrdersRequest) (*
 			ArrivalDate:    timestamppb.New(o.ArrivalDate),
 			ExpiryDate:     timestamppb.New(o.ExpiryDate),
 			LastChange:     timestamppb.New(o.LastChange),
+			Currency:       o.Price.Currency().Code,
 		})
 	}
 
+	total, err := h.Service.TotalPrice(orders)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	logger.Info("Successfully got orders",
 		zap.Any("conditions", conds),
 	)
 
 	return &proto.GetOrdersResponse{
-		Orders: ordersResponse,
+		Orders:        ordersResponse,
+		Total:         total.Amount(),
+		TotalCurrency: total.Currency().Code,
 	}, nil
 }
 
 //nolint:gocognit
 //nolint:gocyclo
 func makeConditions(req *proto.GetOrdersRequest) []query.Cond {
-	conds := make([]query.Cond, 0, 17)
+	conds := make([]query.Cond, 0, 19)
 
 	if req.Id != nil {
 		conds = append(conds, query.Cond{
@@ -128,6 +145,22 @@ func makeConditions(req *proto.GetOrdersRequest) []query.Cond {
 		})
 	}
 
+	if req.Currency != nil {
+		conds = append(conds, query.Cond{
+			Field:    "currency",
+			Value:    strings.ToUpper(req.GetCurrency()),
+			Operator: query.Equals,
+		})
+	}
+
+	if req.Phone != nil {
+		conds = append(conds, query.Cond{
+			Field:    "phone",
+			Value:    req.GetPhone(),
+			Operator: query.Equals,
+		})
+	}
+
 	if req.Status != nil {
 		conds = append(conds, query.Cond{
 			Field:    "status",
@@ -19,6 +19,7 @@ type Handler struct {
 var (
 	errMissingFields   = status.Errorf(codes.InvalidArgument, "missing required fields")
 	errNoSuchPackaging = status.Errorf(codes.InvalidArgument, "no such packaging")
+	errUnknownCurrency = status.Errorf(codes.InvalidArgument, "unknown currency")
 )
 
 // NewHandler creates an instance of new grpc order Handler
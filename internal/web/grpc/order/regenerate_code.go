@@ -0,0 +1,63 @@
+package order
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+)
+
+// RegenerateCode is grpc handler over service for issuing new pickup code
+func (h *Handler) RegenerateCode(ctx context.Context,
+	req *proto.RegenerateCodeRequest) (*proto.RegenerateCodeResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.RegenerateCode")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "RegenerateCode"),
+	)
+
+	logger.Info("Received request to regenerate pickup code",
+		zap.Int("order_id", int(req.GetId())),
+	)
+
+	if req.GetId() == 0 {
+		logger.Error(errMissingFields.Error(),
+			zap.Int("order_id", int(req.GetId())),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	pickupCode, err := h.Service.RegenerateCode(ctx, int(req.GetId()))
+	switch {
+	case errors.Is(err, order.ErrOrderNotFound):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, order.ErrOrderNotEligible):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully regenerated pickup code",
+		zap.Int("order_id", int(req.GetId())),
+	)
+
+	return &proto.RegenerateCodeResponse{
+		PickupCode: pickupCode,
+	}, nil
+}
@@ -2,12 +2,14 @@ package order
 
 import (
 	"context"
+	"errors"
 
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
 )
@@ -37,8 +39,17 @@ func (h *Handler) UpdateOrder(ctx context.Context, req *proto.UpdateOrderRequest
 		return nil, errMissingFields
 	}
 
-	err := h.Service.ProcessOrder(ctx, int(req.GetUserId()), int(req.GetId()), req.GetAction())
-	if err != nil {
+	err := h.Service.ProcessOrder(ctx, int(req.GetUserId()), int(req.GetId()), req.GetAction(), req.GetPickupCode())
+	switch {
+	case errors.Is(err, order.ErrWrongPickupCode), errors.Is(err, order.ErrNoPickupCode):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, order.ErrPickupCodeLocked):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.ResourceExhausted, err.Error())
+	case err != nil:
 		span.SetTag("error", err)
 
 		return nil, status.Error(codes.Internal, err.Error())
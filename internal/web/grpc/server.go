@@ -4,27 +4,57 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/jackc/pgx/v4"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 	admin_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	apikey_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+	auth_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	client_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+	logs_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
 	order_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
+	webhook_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/webhook"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/apikey"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/audit"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/client"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/order"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/grpc/webhook"
 	admin_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/admin/proto"
+	apikey_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/apikey/proto"
+	audit_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/audit/proto"
+	auth_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/auth/proto"
+	client_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/client/proto"
 	order_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/order/proto"
+	webhook_proto "gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
 	"gitlab.ozon.dev/alexplay1224/homework/pkg/monitoring"
 )
 
+// auditShutdownTimeout limits waiting for audit logs to be written on shutdown
+const auditShutdownTimeout = 10 * time.Second
+
 // Server is a struct for a grpc server
 type Server struct {
-	orderHandler order.Handler
-	adminHandler admin.Handler
+	orderHandler   order.Handler
+	adminHandler   admin.Handler
+	clientHandler  client.Handler
+	webhookHandler webhook.Handler
+	authHandler    auth.Handler
+	apiKeyHandler  apikey.Handler
+	auditHandler   audit.Handler
+	logs           logStorage
+	broker         kafka.Broker
 }
 
 type orderStorage interface {
@@ -45,6 +75,81 @@ type adminStorage interface {
 	DeleteAdmin(context.Context, string) error
 	ContainsUsername(context.Context, string) (bool, error)
 	ContainsID(context.Context, int) (bool, error)
+	GetPasswordHistory(context.Context, int, int) ([]string, error)
+	AddPasswordHistory(context.Context, int, string, time.Time) error
+	ListAdmins(context.Context, []query.Cond, int, int) ([]models.Admin, error)
+	SetAdminDeactivatedAt(context.Context, string, *time.Time) error
+}
+
+type clientStorage interface {
+	CreateClient(context.Context, models.Client) (int, error)
+	GetClientByPhone(context.Context, string) (models.Client, error)
+	GetClientByID(context.Context, pgx.Tx, int) (models.Client, error)
+	ContainsPhone(context.Context, string) (bool, error)
+	ContainsClientID(context.Context, pgx.Tx, int) (bool, error)
+}
+
+type pickupCodeStorage interface {
+	SetPickupCode(context.Context, pgx.Tx, models.PickupCode) error
+	GetPickupCode(context.Context, pgx.Tx, int) (models.PickupCode, error)
+	ContainsPickupCode(context.Context, pgx.Tx, int) (bool, error)
+	RegisterFailedAttempt(context.Context, pgx.Tx, int, int, time.Time) error
+	DeletePickupCode(context.Context, pgx.Tx, int) error
+}
+
+type notificationStorage interface {
+	CreateNotification(context.Context, pgx.Tx, models.Notification) error
+}
+
+type webhookStorage interface {
+	CreateSubscription(context.Context, models.WebhookSubscription) (int, error)
+	GetSubscriptions(context.Context) ([]models.WebhookSubscription, error)
+	ContainsSubscription(context.Context, int) (bool, error)
+	DeleteSubscription(context.Context, int) error
+	GetAndMarkDeliveries(context.Context, int) ([]models.WebhookDelivery, error)
+	UpdateDelivery(context.Context, models.WebhookDelivery) error
+	GetDeliveries(context.Context, []query.Cond, int, int) ([]models.WebhookDelivery, error)
+	GetExpiredOrders(context.Context, time.Time, time.Time, int) ([]models.Order, error)
+	EnqueueEvent(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error
+}
+
+type orderEventStorage interface {
+	CreateOrderEvent(context.Context, pgx.Tx, models.OrderEvent) error
+}
+
+type tokenStorage interface {
+	CreateRefreshToken(context.Context, pgx.Tx, models.RefreshToken) error
+	GetRefreshToken(context.Context, pgx.Tx, string) (models.RefreshToken, error)
+	ContainsRefreshToken(context.Context, pgx.Tx, string) (bool, error)
+	RevokeRefreshToken(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAdminRefreshTokens(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAccessToken(context.Context, string, time.Time) error
+	IsAccessTokenRevoked(context.Context, string) (bool, error)
+}
+
+type loginAttemptStorage interface {
+	GetLoginAttempts(context.Context, string, string) ([]models.LoginAttempt, error)
+	RegisterFailedLogin(context.Context, string, string, time.Time, time.Time) (int, error)
+	LockLogin(context.Context, string, string, time.Time) error
+	ResetLoginAttempts(context.Context, string, string) error
+}
+
+type apiKeyStorage interface {
+	CreateAPIKey(context.Context, models.APIKey) (int, error)
+	GetAPIKeys(context.Context) ([]models.APIKey, error)
+	GetAPIKey(context.Context, string) (models.APIKey, error)
+	ContainsAPIKey(context.Context, string) (bool, error)
+	RevokeAPIKey(context.Context, string, time.Time) error
+	TouchAPIKey(context.Context, int, time.Time) error
+}
+
+type logStorage interface {
+	GetAndMarkLogs(context.Context, int) ([]models.Log, error)
+	UpdateLog(context.Context, int, int, int, time.Time) error
+	CreateLog(context.Context, []models.Log) error
+	ListLogs(context.Context, []query.Cond, int, int) ([]models.Log, error)
+	GetLogsAfter(context.Context, int, int) ([]models.Log, error)
+	RequeueDeadLogs(context.Context, []int) (int, error)
 }
 
 type txManager interface {
@@ -53,26 +158,75 @@ type txManager interface {
 	RunReadCommitted(context.Context, func(context.Context, pgx.Tx) error) error
 }
 
-// NewServer creates instance of a grpc server
-func NewServer(logger *zap.Logger, orders orderStorage, admins adminStorage, txManager txManager) *Server {
+// NewServer creates instance of a grpc server, audit logs are sent to Kafka by broker unless it's nil
+func NewServer(cfg config.Config, logger *zap.Logger, orders orderStorage, admins adminStorage,
+	clients clientStorage, codes pickupCodeStorage, notifications notificationStorage, webhooks webhookStorage,
+	events orderEventStorage, tokens tokenStorage, attempts loginAttemptStorage, apiKeys apiKeyStorage,
+	logs logStorage, broker kafka.Broker, txManager txManager, converter *currency.Converter,
+	policy *password.Policy) *Server {
+	adminService := admin_service.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "admins"),
+	), admins, policy)
+	authService := auth_service.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "auth"),
+	), admins, tokens, attempts, logs, adminService, policy, txManager, cfg.JWTSecret(), cfg.AccessTokenTTL(),
+		cfg.RefreshTokenTTL())
 	orderHandler := order.NewHandler(logger.With(
 		zap.String("layer", "handler"),
 		zap.String("domain", "orders"),
 	), *order_service.NewService(logger.With(
 		zap.String("layer", "service"),
 		zap.String("domain", "orders"),
-	), orders, txManager))
+	), orders, clients, codes, notifications, webhooks, events, txManager, converter))
 	adminHandler := admin.NewHandler(logger.With(
 		zap.String("layer", "handler"),
 		zap.String("domain", "admins"),
-	), *admin_service.NewService(logger.With(
+	), *adminService, *authService)
+	clientHandler := client.NewHandler(logger.With(
+		zap.String("layer", "handler"),
+		zap.String("domain", "clients"),
+	), *client_service.NewService(logger.With(
 		zap.String("layer", "service"),
-		zap.String("domain", "admins"),
-	), admins))
+		zap.String("domain", "clients"),
+	), clients))
+	webhookHandler := webhook.NewHandler(logger.With(
+		zap.String("layer", "handler"),
+		zap.String("domain", "webhooks"),
+	), *webhook_service.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "webhooks"),
+	), webhooks))
+	authHandler := auth.NewHandler(logger.With(
+		zap.String("layer", "handler"),
+		zap.String("domain", "auth"),
+	), *authService)
+	apiKeyHandler := apikey.NewHandler(logger.With(
+		zap.String("layer", "handler"),
+		zap.String("domain", "apikeys"),
+	), *apikey_service.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "apikeys"),
+	), apiKeys))
+	auditHandler := audit.NewHandler(logger.With(
+		zap.String("layer", "handler"),
+		zap.String("domain", "logs"),
+	), *logs_service.NewService(logger.With(
+		zap.String("layer", "service"),
+		zap.String("domain", "logs"),
+	), logs))
 
 	return &Server{
-		orderHandler: *orderHandler,
-		adminHandler: *adminHandler,
+		orderHandler:   *orderHandler,
+		adminHandler:   *adminHandler,
+		clientHandler:  *clientHandler,
+		webhookHandler: *webhookHandler,
+		authHandler:    *authHandler,
+		apiKeyHandler:  *apiKeyHandler,
+		auditHandler:   *auditHandler,
+		logs:           logs,
+		broker:         broker,
 	}
 }
 
@@ -89,12 +243,31 @@ func (s *Server) Run(ctx context.Context, cfg config.Config, logger *zap.Logger)
 	errCh := make(chan error)
 	monitoring.StartMetricsServer(errCh)
 
+	auditLogger, err := auditlogger.NewService(ctx, cfg, s.logs, s.broker, cfg.WorkerCount, cfg.BatchSize, cfg.Timeout)
+	if err != nil {
+		return err
+	}
+
+	authenticator := NewAuthenticator(s.authHandler.Service, s.adminHandler.Service, s.apiKeyHandler.Service,
+		cfg.BasicAuthEnabled())
 	grpcServer := grpc.NewServer(
-		grpc.UnaryInterceptor(MetricsInterceptor()),
+		grpc.ChainUnaryInterceptor(
+			MetricsInterceptor(),
+			authenticator.UnaryInterceptor(),
+			AuditInterceptor(auditLogger),
+		),
+		grpc.ChainStreamInterceptor(
+			authenticator.StreamInterceptor(),
+		),
 	)
 
 	order_proto.RegisterOrderServiceServer(grpcServer, &s.orderHandler)
 	admin_proto.RegisterAdminServiceServer(grpcServer, &s.adminHandler)
+	client_proto.RegisterClientServiceServer(grpcServer, &s.clientHandler)
+	webhook_proto.RegisterWebhookServiceServer(grpcServer, &s.webhookHandler)
+	auth_proto.RegisterAuthServiceServer(grpcServer, &s.authHandler)
+	apikey_proto.RegisterAPIKeyServiceServer(grpcServer, &s.apiKeyHandler)
+	audit_proto.RegisterAuditServiceServer(grpcServer, &s.auditHandler)
 
 	logger.Info(fmt.Sprintf("server listening at %v", lis.Addr()))
 
@@ -108,6 +281,13 @@ func (s *Server) Run(ctx context.Context, cfg config.Config, logger *zap.Logger)
 	case <-ctx.Done():
 		grpcServer.GracefulStop()
 
+		// logs queued before shutdown are written to sinks before exit
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), auditShutdownTimeout)
+		defer cancel()
+		if err = auditLogger.Shutdown(shutdownCtx); err != nil {
+			logger.Error("failed to drain audit logger", zap.Error(err))
+		}
+
 		return nil
 	case err := <-errCh:
 		return err
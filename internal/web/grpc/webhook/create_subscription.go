@@ -0,0 +1,62 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/webhook"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// CreateSubscription is a grpc handler over service for subscribing to order events
+func (h *Handler) CreateSubscription(ctx context.Context,
+	req *proto.CreateSubscriptionRequest) (*proto.CreateSubscriptionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.CreateSubscription")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "CreateSubscription"),
+	)
+
+	logger.Info("Received request to create webhook subscription",
+		zap.String("url", req.GetUrl()),
+		zap.Strings("events", req.GetEvents()),
+	)
+
+	if req.GetUrl() == "" {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	subscription, err := h.Service.CreateSubscription(ctx, req.GetUrl(), req.GetSecret(), req.GetEvents())
+	switch {
+	case errors.Is(err, webhook.ErrWrongURL) || errors.Is(err, webhook.ErrNoEvents) ||
+		errors.Is(err, models.ErrUnknownWebhookEvent):
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case err != nil:
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	logger.Info("Successfully created webhook subscription",
+		zap.Int("id", subscription.ID),
+	)
+
+	return &proto.CreateSubscriptionResponse{
+		Id:     int32(subscription.ID),
+		Secret: subscription.Secret,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/webhook"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// DeleteSubscription is a grpc handler over service for deleting webhook subscription
+func (h *Handler) DeleteSubscription(ctx context.Context,
+	req *proto.DeleteSubscriptionRequest) (*proto.DeleteSubscriptionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.DeleteSubscription")
+	defer span.Finish()
+
+	logger := h.logger.With(
+		zap.String("handler", "DeleteSubscription"),
+	)
+
+	logger.Info("Received request to delete webhook subscription",
+		zap.Int32("id", req.GetId()),
+	)
+
+	if req.GetId() == 0 {
+		logger.Error(errMissingFields.Error(),
+			zap.Error(errMissingFields),
+		)
+		span.SetTag("error", errMissingFields)
+
+		return nil, errMissingFields
+	}
+
+	err := h.Service.DeleteSubscription(ctx, int(req.GetId()))
+	if errors.Is(err, webhook.ErrSubscriptionNotFound) {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.NotFound, err.Error())
+	} else if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &proto.DeleteSubscriptionResponse{
+		Output: "success",
+	}, nil
+}
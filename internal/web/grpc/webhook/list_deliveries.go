@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// ListDeliveries is a grpc handler over service for getting webhook delivery log
+func (h *Handler) ListDeliveries(ctx context.Context,
+	req *proto.ListDeliveriesRequest) (*proto.ListDeliveriesResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ListDeliveries")
+	defer span.Finish()
+
+	h.logger.Info("Received request to list webhook deliveries",
+		zap.String("handler", "ListDeliveries"),
+		zap.Int32("subscription_id", req.GetSubscriptionId()),
+		zap.Int32("job_status", req.GetJobStatus()),
+	)
+
+	deliveries, err := h.Service.GetDeliveries(ctx, int(req.GetSubscriptionId()), int(req.GetJobStatus()),
+		int(req.GetCount()), int(req.GetPage()))
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.ListDeliveriesResponse{
+		Deliveries: make([]*proto.Delivery, 0, len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		resp.Deliveries = append(resp.Deliveries, &proto.Delivery{
+			Id:             int32(delivery.ID),
+			SubscriptionId: int32(delivery.SubscriptionID),
+			Event:          string(delivery.Event),
+			OrderId:        int32(delivery.OrderID),
+			JobStatus:      int32(delivery.JobStatus),
+			AttemptsLeft:   int32(delivery.AttemptsLeft),
+			ResponseStatus: int32(delivery.ResponseStatus),
+			LastError:      delivery.LastError,
+			CreatedAt:      timestamppb.New(delivery.CreatedAt),
+			NextAttemptAt:  timestamppb.New(delivery.NextAttemptAt),
+			UpdatedAt:      timestamppb.New(delivery.UpdatedAt),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,50 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// ListSubscriptions is a grpc handler over service for getting webhook subscriptions
+func (h *Handler) ListSubscriptions(ctx context.Context,
+	_ *proto.ListSubscriptionsRequest) (*proto.ListSubscriptionsResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "handler.ListSubscriptions")
+	defer span.Finish()
+
+	h.logger.Info("Received request to list webhook subscriptions",
+		zap.String("handler", "ListSubscriptions"),
+	)
+
+	subscriptions, err := h.Service.GetSubscriptions(ctx)
+	if err != nil {
+		span.SetTag("error", err)
+
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := &proto.ListSubscriptionsResponse{
+		Subscriptions: make([]*proto.Subscription, 0, len(subscriptions)),
+	}
+	for _, subscription := range subscriptions {
+		events := make([]string, 0, len(subscription.Events))
+		for _, event := range subscription.Events {
+			events = append(events, string(event))
+		}
+
+		resp.Subscriptions = append(resp.Subscriptions, &proto.Subscription{
+			Id:        int32(subscription.ID),
+			Url:       subscription.URL,
+			Events:    events,
+			CreatedAt: timestamppb.New(subscription.CreatedAt),
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/webhook"
+	"gitlab.ozon.dev/alexplay1224/homework/pkg/api/webhook/proto"
+)
+
+// Handler is a gRPC webhook handler implementation
+type Handler struct {
+	Service webhook.Service
+	proto.UnimplementedWebhookServiceServer
+	logger *zap.Logger
+}
+
+var (
+	errMissingFields = status.Errorf(codes.InvalidArgument, "missing fields")
+)
+
+// NewHandler creates an instance of new grpc webhook Handler
+func NewHandler(logger *zap.Logger, service webhook.Service) *Handler {
+	return &Handler{
+		Service: service,
+		logger:  logger,
+	}
+}
@@ -3,6 +3,7 @@ package admin
 import (
 	"context"
 	"errors"
+	"time"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 )
@@ -22,15 +23,30 @@ func NewHandler(adminService adminService) *Handler {
 const (
 	// AdminUsernameParam is a query param for admin username
 	AdminUsernameParam = "admin_username"
+
+	// RoleParam is a query param for admin role
+	RoleParam = "role"
+
+	// StatusParam is a query param for admin status, active or deactivated
+	StatusParam = "status"
+
+	// CountParam is a query param for page size
+	CountParam = "count"
+
+	// PageParam is a query param for page number
+	PageParam = "page"
 )
 
 type adminService interface {
-	CreateAdmin(context.Context, models.Admin) error
+	CreateAdmin(context.Context, models.Admin, string) error
 	GetAdminByUsername(context.Context, string) (models.Admin, error)
-	UpdateAdmin(context.Context, string, string, models.Admin) error
+	UpdateAdmin(context.Context, string, string, string) error
 	DeleteAdmin(context.Context, string, string) error
 	ContainsUsername(context.Context, string) (bool, error)
 	ContainsID(context.Context, int) (bool, error)
+	ListAdmins(context.Context, models.Role, string, int, int) ([]models.Admin, error)
+	DeactivateAdmin(context.Context, string) error
+	ReactivateAdmin(context.Context, string) error
 }
 
 var (
@@ -39,4 +55,30 @@ var (
 
 	// ErrNoUsername happens when username wasn't provided
 	ErrNoUsername = errors.New("username wasn't provided")
+
+	// ErrWrongNumberFormat happens when count or page isn't a number
+	ErrWrongNumberFormat = errors.New("wrong number format")
 )
+
+// adminResponse is an admin without password hash
+type adminResponse struct {
+	ID                int        `json:"id"`
+	Username          string     `json:"username"`
+	Role              string     `json:"role"`   // Role is one of operator, supervisor or superadmin
+	Status            string     `json:"status"` // Status is active or deactivated
+	CreatedAt         time.Time  `json:"created_at"`
+	PasswordChangedAt time.Time  `json:"password_changed_at"`
+	DeactivatedAt     *time.Time `json:"deactivated_at,omitempty"`
+}
+
+func newAdminResponse(admin models.Admin) adminResponse {
+	return adminResponse{
+		ID:                admin.ID,
+		Username:          admin.Username,
+		Role:              admin.Role.String(),
+		Status:            admin.Status(),
+		CreatedAt:         admin.CreatedAt,
+		PasswordChangedAt: admin.PasswordChangedAt,
+		DeactivatedAt:     admin.DeactivatedAt,
+	}
+}
@@ -3,9 +3,11 @@ package admin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 )
 
 // createAdminRequest represents the request body for creating an admin
@@ -21,9 +23,12 @@ type createAdminRequest struct {
 	ID       int    `json:"id"`       // ID is the unique identifier for the admin
 	Username string `json:"username"` // Username is the name the admin will use to log in
 	Password string `json:"password"` // Password is the admin's password
+	Role     string `json:"role"`     // Role is one of operator, supervisor or superadmin, operator by default
 }
 
 // CreateAdmin creates admin
+// @Security BearerAuth
+// @Security BasicAuth
 // @Summary Create admin
 // @Description Creates a new admin user
 // @Tags admins
@@ -31,7 +36,9 @@ type createAdminRequest struct {
 // @Produce json
 // @Param admin body createAdminRequest true "Admin details"
 // @Success 200 {string} string "Admin created successfully"
-// @Failure 400 {string} string "Invalid request or missing fields"
+// @Failure 400 {string} string "Invalid request, missing fields, unknown role or weak password"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal server error"
 // @Router /admins [post]
 func (h *Handler) CreateAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
@@ -47,10 +54,25 @@ func (h *Handler) CreateAdmin(ctx context.Context, w http.ResponseWriter, r *htt
 		return
 	}
 
-	admin := *models.NewAdmin(createRequest.ID, createRequest.Username, createRequest.Password)
-
-	err = h.adminService.CreateAdmin(ctx, admin)
+	role, err := models.ParseRole(createRequest.Role)
 	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	admin := models.Admin{
+		ID:       createRequest.ID,
+		Username: createRequest.Username,
+		Role:     role,
+	}
+
+	err = h.adminService.CreateAdmin(ctx, admin, createRequest.Password)
+	if errors.Is(err, password.ErrPolicyViolation) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	} else if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 
 		return
@@ -7,6 +7,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
 
 	"github.com/stretchr/testify/assert"
@@ -39,7 +40,7 @@ func TestHandler_CreateAdmin(t *testing.T) {
 				Password: "password",
 			},
 			mockSetup: func(adminService *MockadminService) {
-				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any(), "password").Return(nil).Times(1)
 			},
 			expectedCode: http.StatusOK,
 		},
@@ -51,7 +52,7 @@ func TestHandler_CreateAdmin(t *testing.T) {
 				Password: "password",
 			},
 			mockSetup: func(adminService *MockadminService) {
-				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).Return(admin.ErrIDUsed).Times(1)
+				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any(), "password").Return(admin.ErrIDUsed).Times(1)
 			},
 			expectedCode: http.StatusInternalServerError,
 		},
@@ -63,10 +64,23 @@ func TestHandler_CreateAdmin(t *testing.T) {
 				Password: "password",
 			},
 			mockSetup: func(adminService *MockadminService) {
-				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).Return(admin.ErrUsernameUsed).Times(1)
+				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any(), "password").Return(admin.ErrUsernameUsed).Times(1)
 			},
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Weak password",
+			args: createAdminRequest{
+				ID:       1,
+				Username: "admin",
+				Password: "password",
+			},
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().CreateAdmin(gomock.Any(), gomock.Any(), "password").
+					Return(password.ErrPolicyViolation).Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
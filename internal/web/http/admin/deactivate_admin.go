@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+)
+
+// DeactivateAdmin forbids admin to log in
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Deactivate admin
+// @Description Forbids admin to log in, unlike deletion admin and their audit logs are kept
+// @Tags admins
+// @Produce json
+// @Param username path string true "Admin Username"
+// @Success 200 {string} string "Admin deactivated"
+// @Failure 400 {string} string "Username wasn't provided"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Admin doesn't exist"
+// @Failure 500 {string} string "Internal server error"
+// @Router /admins/{username}/deactivate [post]
+func (h *Handler) DeactivateAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	h.setActivity(ctx, w, r, h.adminService.DeactivateAdmin)
+}
+
+// ReactivateAdmin allows deactivated admin to log in again
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Reactivate admin
+// @Description Allows deactivated admin to log in again
+// @Tags admins
+// @Produce json
+// @Param username path string true "Admin Username"
+// @Success 200 {string} string "Admin reactivated"
+// @Failure 400 {string} string "Username wasn't provided"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Admin doesn't exist"
+// @Failure 500 {string} string "Internal server error"
+// @Router /admins/{username}/reactivate [post]
+func (h *Handler) ReactivateAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	h.setActivity(ctx, w, r, h.adminService.ReactivateAdmin)
+}
+
+func (h *Handler) setActivity(ctx context.Context, w http.ResponseWriter, r *http.Request,
+	set func(context.Context, string) error) {
+	adminUsername, ok := mux.Vars(r)[AdminUsernameParam]
+	if !ok {
+		http.Error(w, ErrNoUsername.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	err := set(ctx, adminUsername)
+	if errors.Is(err, admin.ErrAdminDoesntExist) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
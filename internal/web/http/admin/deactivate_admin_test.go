@@ -0,0 +1,83 @@
+package admin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_DeactivateAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		reactivate   bool
+		mockSetup    func(service *MockadminService)
+		expectedCode int
+	}{
+		{
+			name: "Deactivate",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().DeactivateAdmin(gomock.Any(), "admin").Return(nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:       "Reactivate",
+			reactivate: true,
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().ReactivateAdmin(gomock.Any(), "admin").Return(nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Unknown admin",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().DeactivateAdmin(gomock.Any(), "admin").
+					Return(admin.ErrAdminDoesntExist).Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:       "Storage failure",
+			reactivate: true,
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().ReactivateAdmin(gomock.Any(), "admin").
+					Return(errors.New("failed to change admin activity")).Times(1)
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMockadminService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/admins/admin/deactivate", nil)
+			req = mux.SetURLVars(req, map[string]string{
+				AdminUsernameParam: "admin",
+			})
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			if tt.reactivate {
+				handler.ReactivateAdmin(t.Context(), res, req)
+			} else {
+				handler.DeactivateAdmin(t.Context(), res, req)
+			}
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+		})
+	}
+}
@@ -22,6 +22,8 @@ type deleteRequest struct {
 }
 
 // DeleteAdmin deletes an admin user
+// @Security BearerAuth
+// @Security BasicAuth
 // @Summary Delete an admin
 // @Description Delete an admin by providing the username and password for confirmation
 // @Tags admins
@@ -31,6 +33,8 @@ type deleteRequest struct {
 // @Param request body deleteRequest true "Delete Admin Request"
 // @Success 200 {string} string "Admin deleted successfully"
 // @Failure 400 {string} string "Invalid request or missing fields"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal server error"
 // @Router /admins/{username} [delete]
 func (h *Handler) DeleteAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
@@ -0,0 +1,58 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+)
+
+// GetAdmin gets admin by username
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Get admin
+// @Description Gets admin by username, password hash isn't returned
+// @Tags admins
+// @Produce json
+// @Param username path string true "Admin Username"
+// @Success 200 {object} adminResponse "Admin"
+// @Failure 400 {string} string "Username wasn't provided"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Admin doesn't exist"
+// @Failure 500 {string} string "Internal server error"
+// @Router /admins/{username} [get]
+func (h *Handler) GetAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	adminUsername, ok := mux.Vars(r)[AdminUsernameParam]
+	if !ok {
+		http.Error(w, ErrNoUsername.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	found, err := h.adminService.GetAdminByUsername(ctx, adminUsername)
+	if errors.Is(err, admin.ErrAdminDoesntExist) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(newAdminResponse(found))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_GetAdmin(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		username     string
+		mockSetup    func(service *MockadminService)
+		expectedCode int
+	}{
+		{
+			name:     "Existing admin",
+			username: "admin",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().GetAdminByUsername(gomock.Any(), "admin").
+					Return(models.Admin{ID: 1, Username: "admin", Password: "hash", Role: models.OperatorRole}, nil).
+					Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:     "Unknown admin",
+			username: "nobody",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().GetAdminByUsername(gomock.Any(), "nobody").
+					Return(models.Admin{}, admin.ErrAdminDoesntExist).Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMockadminService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/admins/"+tt.username, nil)
+			req = mux.SetURLVars(req, map[string]string{
+				AdminUsernameParam: tt.username,
+			})
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.GetAdmin(t.Context(), res, req)
+
+			require.Equal(t, tt.expectedCode, res.Code)
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+
+			var response adminResponse
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Equal(t, adminResponse{ID: 1, Username: "admin", Role: "operator",
+				Status: models.ActiveAdminStatus}, response)
+		})
+	}
+}
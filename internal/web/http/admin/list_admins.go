@@ -0,0 +1,105 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+)
+
+type listAdminsResponse struct {
+	Count  int             `json:"count"`
+	Admins []adminResponse `json:"admins"`
+}
+
+// ListAdmins gets a page of admins
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary List admins
+// @Description Gets a page of admins ordered by id, optionally filtered by role and status
+// @Tags admins
+// @Produce json
+// @Param role query string false "Role of admins: operator, supervisor or superadmin"
+// @Param status query string false "Status of admins: active or deactivated"
+// @Param count query int false "Number of admins per page"
+// @Param page query int false "Page number"
+// @Success 200 {object} listAdminsResponse "Admins"
+// @Failure 400 {string} string "Unknown role or status, wrong count or page"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /admins [get]
+func (h *Handler) ListAdmins(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	var role models.Role
+	if query.Get(RoleParam) != "" {
+		var err error
+		role, err = models.ParseRole(query.Get(RoleParam))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+	}
+
+	count, err := parseInt(query.Get(CountParam))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+	page, err := parseInt(query.Get(PageParam))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	admins, err := h.adminService.ListAdmins(ctx, role, query.Get(StatusParam), count, page)
+	if errors.Is(err, admin.ErrUnknownStatus) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	response := listAdminsResponse{
+		Count:  len(admins),
+		Admins: make([]adminResponse, 0, len(admins)),
+	}
+	for _, someAdmin := range admins {
+		response.Admins = append(response.Admins, newAdminResponse(someAdmin))
+	}
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func parseInt(param string) (int, error) {
+	if param == "" {
+		return 0, nil
+	}
+
+	res, err := strconv.Atoi(param)
+	if err != nil || res < 0 {
+		return 0, ErrWrongNumberFormat
+	}
+
+	return res, nil
+}
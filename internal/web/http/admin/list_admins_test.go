@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_ListAdmins(t *testing.T) {
+	t.Parallel()
+	deactivatedAt := time.Now()
+
+	tests := []struct {
+		name         string
+		query        string
+		mockSetup    func(service *MockadminService)
+		expectedCode int
+	}{
+		{
+			name:  "Filtered page",
+			query: "?role=supervisor&status=deactivated&count=10&page=1",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().ListAdmins(gomock.Any(), models.SupervisorRole, models.DeactivatedAdminStatus,
+					10, 1).Return([]models.Admin{{ID: 1, Username: "admin", Password: "hash",
+					Role: models.SupervisorRole, DeactivatedAt: &deactivatedAt}}, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:  "No filters",
+			query: "",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().ListAdmins(gomock.Any(), models.Role(0), "", 0, 0).
+					Return([]models.Admin{}, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Unknown role",
+			query:        "?role=janitor",
+			mockSetup:    func(_ *MockadminService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Wrong count",
+			query:        "?count=ten",
+			mockSetup:    func(_ *MockadminService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Unknown status",
+			query: "?status=sleeping",
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().ListAdmins(gomock.Any(), models.Role(0), "sleeping", 0, 0).
+					Return(nil, admin.ErrUnknownStatus).Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMockadminService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/admins"+tt.query, nil)
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.ListAdmins(t.Context(), res, req)
+
+			require.Equal(t, tt.expectedCode, res.Code)
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+
+			var response listAdminsResponse
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Equal(t, len(response.Admins), response.Count)
+			assert.NotContains(t, res.Body.String(), "password\"")
+		})
+	}
+}
@@ -120,17 +120,17 @@ func (c *MockadminServiceContainsUsernameCall) DoAndReturn(f func(context.Contex
 }
 
 // CreateAdmin mocks base method.
-func (m *MockadminService) CreateAdmin(arg0 context.Context, arg1 models.Admin) error {
+func (m *MockadminService) CreateAdmin(arg0 context.Context, arg1 models.Admin, arg2 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAdmin", arg0, arg1)
+	ret := m.ctrl.Call(m, "CreateAdmin", arg0, arg1, arg2)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateAdmin indicates an expected call of CreateAdmin.
-func (mr *MockadminServiceMockRecorder) CreateAdmin(arg0, arg1 any) *MockadminServiceCreateAdminCall {
+func (mr *MockadminServiceMockRecorder) CreateAdmin(arg0, arg1, arg2 any) *MockadminServiceCreateAdminCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockadminService)(nil).CreateAdmin), arg0, arg1)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAdmin", reflect.TypeOf((*MockadminService)(nil).CreateAdmin), arg0, arg1, arg2)
 	return &MockadminServiceCreateAdminCall{Call: call}
 }
 
@@ -146,13 +146,51 @@ func (c *MockadminServiceCreateAdminCall) Return(arg0 error) *MockadminServiceCr
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockadminServiceCreateAdminCall) Do(f func(context.Context, models.Admin) error) *MockadminServiceCreateAdminCall {
+func (c *MockadminServiceCreateAdminCall) Do(f func(context.Context, models.Admin, string) error) *MockadminServiceCreateAdminCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockadminServiceCreateAdminCall) DoAndReturn(f func(context.Context, models.Admin) error) *MockadminServiceCreateAdminCall {
+func (c *MockadminServiceCreateAdminCall) DoAndReturn(f func(context.Context, models.Admin, string) error) *MockadminServiceCreateAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// DeactivateAdmin mocks base method.
+func (m *MockadminService) DeactivateAdmin(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeactivateAdmin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeactivateAdmin indicates an expected call of DeactivateAdmin.
+func (mr *MockadminServiceMockRecorder) DeactivateAdmin(arg0, arg1 any) *MockadminServiceDeactivateAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeactivateAdmin", reflect.TypeOf((*MockadminService)(nil).DeactivateAdmin), arg0, arg1)
+	return &MockadminServiceDeactivateAdminCall{Call: call}
+}
+
+// MockadminServiceDeactivateAdminCall wrap *gomock.Call
+type MockadminServiceDeactivateAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminServiceDeactivateAdminCall) Return(arg0 error) *MockadminServiceDeactivateAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminServiceDeactivateAdminCall) Do(f func(context.Context, string) error) *MockadminServiceDeactivateAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminServiceDeactivateAdminCall) DoAndReturn(f func(context.Context, string) error) *MockadminServiceDeactivateAdminCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -234,8 +272,85 @@ func (c *MockadminServiceGetAdminByUsernameCall) DoAndReturn(f func(context.Cont
 	return c
 }
 
+// ListAdmins mocks base method.
+func (m *MockadminService) ListAdmins(arg0 context.Context, arg1 models.Role, arg2 string, arg3, arg4 int) ([]models.Admin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAdmins", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].([]models.Admin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAdmins indicates an expected call of ListAdmins.
+func (mr *MockadminServiceMockRecorder) ListAdmins(arg0, arg1, arg2, arg3, arg4 any) *MockadminServiceListAdminsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAdmins", reflect.TypeOf((*MockadminService)(nil).ListAdmins), arg0, arg1, arg2, arg3, arg4)
+	return &MockadminServiceListAdminsCall{Call: call}
+}
+
+// MockadminServiceListAdminsCall wrap *gomock.Call
+type MockadminServiceListAdminsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminServiceListAdminsCall) Return(arg0 []models.Admin, arg1 error) *MockadminServiceListAdminsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminServiceListAdminsCall) Do(f func(context.Context, models.Role, string, int, int) ([]models.Admin, error)) *MockadminServiceListAdminsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminServiceListAdminsCall) DoAndReturn(f func(context.Context, models.Role, string, int, int) ([]models.Admin, error)) *MockadminServiceListAdminsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// ReactivateAdmin mocks base method.
+func (m *MockadminService) ReactivateAdmin(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactivateAdmin", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReactivateAdmin indicates an expected call of ReactivateAdmin.
+func (mr *MockadminServiceMockRecorder) ReactivateAdmin(arg0, arg1 any) *MockadminServiceReactivateAdminCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactivateAdmin", reflect.TypeOf((*MockadminService)(nil).ReactivateAdmin), arg0, arg1)
+	return &MockadminServiceReactivateAdminCall{Call: call}
+}
+
+// MockadminServiceReactivateAdminCall wrap *gomock.Call
+type MockadminServiceReactivateAdminCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockadminServiceReactivateAdminCall) Return(arg0 error) *MockadminServiceReactivateAdminCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockadminServiceReactivateAdminCall) Do(f func(context.Context, string) error) *MockadminServiceReactivateAdminCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockadminServiceReactivateAdminCall) DoAndReturn(f func(context.Context, string) error) *MockadminServiceReactivateAdminCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UpdateAdmin mocks base method.
-func (m *MockadminService) UpdateAdmin(arg0 context.Context, arg1, arg2 string, arg3 models.Admin) error {
+func (m *MockadminService) UpdateAdmin(arg0 context.Context, arg1, arg2, arg3 string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateAdmin", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
@@ -261,13 +376,13 @@ func (c *MockadminServiceUpdateAdminCall) Return(arg0 error) *MockadminServiceUp
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockadminServiceUpdateAdminCall) Do(f func(context.Context, string, string, models.Admin) error) *MockadminServiceUpdateAdminCall {
+func (c *MockadminServiceUpdateAdminCall) Do(f func(context.Context, string, string, string) error) *MockadminServiceUpdateAdminCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockadminServiceUpdateAdminCall) DoAndReturn(f func(context.Context, string, string, models.Admin) error) *MockadminServiceUpdateAdminCall {
+func (c *MockadminServiceUpdateAdminCall) DoAndReturn(f func(context.Context, string, string, string) error) *MockadminServiceUpdateAdminCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
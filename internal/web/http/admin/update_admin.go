@@ -3,11 +3,12 @@ package admin
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 
-	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
-
 	"github.com/gorilla/mux"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 )
 
 // updateRequest represents the request body for updating an admin's password
@@ -25,15 +26,20 @@ type updateRequest struct {
 }
 
 // UpdateAdmin updates an admin's password
+// @Security BearerAuth
+// @Security BasicAuth
 // @Summary Update admin's password
-// @Description Update the password of an admin by providing the old and new passwords
+// @Description Update the password of an admin by providing the old and new passwords,
+// @Description new password must follow password policy and differ from recent ones
 // @Tags admins
 // @Accept json
 // @Produce json
 // @Param username path string true "Admin Username" // Param for username from URL
 // @Param request body updateRequest true "Update Admin Request"
 // @Success 200 {string} string "Admin password updated successfully"
-// @Failure 400 {string} string "Invalid request or missing fields"
+// @Failure 400 {string} string "Invalid request, missing fields or rejected password"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 500 {string} string "Internal server error"
 // @Router /admins/{username} [post]
 func (h *Handler) UpdateAdmin(ctx context.Context, w http.ResponseWriter, r *http.Request) {
@@ -58,10 +64,12 @@ func (h *Handler) UpdateAdmin(ctx context.Context, w http.ResponseWriter, r *htt
 		return
 	}
 
-	admin := *models.NewAdmin(0, adminUsername, request.NewPassword)
+	err = h.adminService.UpdateAdmin(ctx, adminUsername, request.Password, request.NewPassword)
+	if errors.Is(err, password.ErrPolicyViolation) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
 
-	err = h.adminService.UpdateAdmin(ctx, adminUsername, request.Password, admin)
-	if err != nil {
+		return
+	} else if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 
 		return
@@ -77,6 +77,19 @@ func TestUpdateAdmin(t *testing.T) {
 			},
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name:     "Reused password",
+			username: "test",
+			args: updareAdminRequest{
+				Password:    "password",
+				NewPassword: "new_password",
+			},
+			mockSetup: func(adminService *MockadminService) {
+				adminService.EXPECT().UpdateAdmin(gomock.Any(), "test", "password", "new_password").
+					Return(admin.ErrPasswordReused).Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,65 @@
+package audit
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Handler is a struct for handling audit log related calls
+type Handler struct {
+	logService logService
+}
+
+// NewHandler creates an instance of audit log Handler
+func NewHandler(logService logService) *Handler {
+	return &Handler{
+		logService: logService,
+	}
+}
+
+const (
+	// AdminIDParam is a query param for id of admin who made the call
+	AdminIDParam = "admin_id"
+
+	// OrderIDParam is a query param for id of order the call was about
+	OrderIDParam = "order_id"
+
+	// MethodParam is a query param for http method, or GRPC for grpc calls
+	MethodParam = "method"
+
+	// StatusParam is a query param for http status of response
+	StatusParam = "status"
+
+	// DateFromParam is a query param for the earliest date of logs
+	DateFromParam = "date_from"
+
+	// DateToParam is a query param for the latest date of logs
+	DateToParam = "date_to"
+
+	// JobStatusParam is a query param for status of log delivery job
+	JobStatusParam = "job_status"
+
+	// CountParam is a query param for page size
+	CountParam = "count"
+
+	// PageParam is a query param for page number
+	PageParam = "page"
+
+	inputDateAndTimeLayout = "2006.01.02-15:04:05"
+	inputDateLayout        = "2006.01.02"
+)
+
+type logService interface {
+	ListLogs(context.Context, models.LogFilter, int, int) ([]models.Log, error)
+	VerifyAuditChain(context.Context) (models.AuditChainReport, error)
+}
+
+var (
+	// ErrWrongNumberFormat happens when number param isn't a non-negative number
+	ErrWrongNumberFormat = errors.New("wrong number format")
+
+	// ErrWrongDateFormat happens when date param is in neither of supported layouts
+	ErrWrongDateFormat = errors.New("wrong date format")
+)
@@ -0,0 +1,141 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
+)
+
+type listLogsResponse struct {
+	Count int          `json:"count"`
+	Logs  []models.Log `json:"logs"`
+}
+
+// ListLogs gets a page of audit logs
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary List audit logs
+// @Description Gets a page of audit logs, newest first, optionally filtered
+// @Tags logs
+// @Produce json
+// @Param admin_id query int false "Id of admin who made the call"
+// @Param order_id query int false "Id of order the call was about"
+// @Param method query string false "Http method, or GRPC for grpc calls"
+// @Param status query int false "Http status of response"
+// @Param date_from query string false "Earliest date in 2006.01.02 or 2006.01.02-15:04:05 format"
+// @Param date_to query string false "Latest date in 2006.01.02 or 2006.01.02-15:04:05 format"
+// @Param job_status query int false "Status of log delivery job"
+// @Param count query int false "Number of logs per page"
+// @Param page query int false "Page number"
+// @Success 200 {object} listLogsResponse "Logs"
+// @Failure 400 {string} string "Wrong number or date format, wrong date range"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /logs [get]
+func (h *Handler) ListLogs(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	filter, count, page, err := parseListLogsQuery(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	auditLogs, err := h.logService.ListLogs(ctx, filter, count, page)
+	if errors.Is(err, logs.ErrWrongDateRange) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(listLogsResponse{
+		Count: len(auditLogs),
+		Logs:  auditLogs,
+	})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func parseListLogsQuery(r *http.Request) (models.LogFilter, int, int, error) {
+	query := r.URL.Query()
+	filter := models.LogFilter{
+		Method: query.Get(MethodParam),
+	}
+
+	var err error
+	numbers := map[string]*int{
+		AdminIDParam:   &filter.AdminID,
+		OrderIDParam:   &filter.OrderID,
+		StatusParam:    &filter.Status,
+		JobStatusParam: &filter.JobStatus,
+	}
+	for param, value := range numbers {
+		if *value, err = parseInt(query.Get(param)); err != nil {
+			return models.LogFilter{}, 0, 0, err
+		}
+	}
+
+	if filter.From, err = parseDate(query.Get(DateFromParam)); err != nil {
+		return models.LogFilter{}, 0, 0, err
+	}
+	if filter.To, err = parseDate(query.Get(DateToParam)); err != nil {
+		return models.LogFilter{}, 0, 0, err
+	}
+
+	count, err := parseInt(query.Get(CountParam))
+	if err != nil {
+		return models.LogFilter{}, 0, 0, err
+	}
+	page, err := parseInt(query.Get(PageParam))
+	if err != nil {
+		return models.LogFilter{}, 0, 0, err
+	}
+
+	return filter, count, page, nil
+}
+
+func parseInt(param string) (int, error) {
+	if param == "" {
+		return 0, nil
+	}
+
+	res, err := strconv.Atoi(param)
+	if err != nil || res < 0 {
+		return 0, ErrWrongNumberFormat
+	}
+
+	return res, nil
+}
+
+func parseDate(param string) (time.Time, error) {
+	if param == "" {
+		return time.Time{}, nil
+	}
+
+	date, err := time.Parse(inputDateLayout, param)
+	if err != nil {
+		date, err = time.Parse(inputDateAndTimeLayout, param)
+		if err != nil {
+			return time.Time{}, ErrWrongDateFormat
+		}
+	}
+
+	return date, nil
+}
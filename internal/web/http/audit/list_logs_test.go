@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_ListLogs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		query        string
+		mockSetup    func(service *MocklogService)
+		expectedCode int
+	}{
+		{
+			name: "Filtered page",
+			query: "?admin_id=1&order_id=2&method=GRPC&status=404&date_from=2025.04.01" +
+				"&date_to=2025.04.02-12:00:00&count=10&page=1",
+			mockSetup: func(logService *MocklogService) {
+				logService.EXPECT().ListLogs(gomock.Any(), models.LogFilter{
+					AdminID: 1,
+					OrderID: 2,
+					Method:  "GRPC",
+					Status:  404,
+					From:    time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+					To:      time.Date(2025, 4, 2, 12, 0, 0, 0, time.UTC),
+				}, 10, 1).Return([]models.Log{{ID: 1, OrderID: 2, AdminID: 1}}, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:  "No filters",
+			query: "",
+			mockSetup: func(logService *MocklogService) {
+				logService.EXPECT().ListLogs(gomock.Any(), models.LogFilter{}, 0, 0).
+					Return([]models.Log{}, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "Wrong admin id",
+			query:        "?admin_id=-1",
+			mockSetup:    func(_ *MocklogService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "Wrong date",
+			query:        "?date_from=yesterday",
+			mockSetup:    func(_ *MocklogService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Wrong date range",
+			query: "?date_from=2025.04.02&date_to=2025.04.01",
+			mockSetup: func(logService *MocklogService) {
+				logService.EXPECT().ListLogs(gomock.Any(), gomock.Any(), 0, 0).
+					Return(nil, logs.ErrWrongDateRange).Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			mockService := NewMocklogService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/logs"+tt.query, nil)
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.ListLogs(t.Context(), res, req)
+
+			require.Equal(t, tt.expectedCode, res.Code)
+			if tt.expectedCode != http.StatusOK {
+				return
+			}
+
+			var response listLogsResponse
+			require.NoError(t, json.Unmarshal(res.Body.Bytes(), &response))
+			assert.Equal(t, len(response.Logs), response.Count)
+		})
+	}
+}
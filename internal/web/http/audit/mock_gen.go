@@ -0,0 +1,3 @@
+//go:generate mockgen -typed -source=audit.go -destination=mock_log_service_test.go -package=audit
+
+package audit
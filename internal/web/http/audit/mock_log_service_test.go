@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: audit.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=audit.go -destination=mock_log_service_test.go -package=audit
+//
+
+// Package audit is a generated GoMock package.
+package audit
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MocklogService is a mock of logService interface.
+type MocklogService struct {
+	ctrl     *gomock.Controller
+	recorder *MocklogServiceMockRecorder
+	isgomock struct{}
+}
+
+// MocklogServiceMockRecorder is the mock recorder for MocklogService.
+type MocklogServiceMockRecorder struct {
+	mock *MocklogService
+}
+
+// NewMocklogService creates a new mock instance.
+func NewMocklogService(ctrl *gomock.Controller) *MocklogService {
+	mock := &MocklogService{ctrl: ctrl}
+	mock.recorder = &MocklogServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocklogService) EXPECT() *MocklogServiceMockRecorder {
+	return m.recorder
+}
+
+// ListLogs mocks base method.
+func (m *MocklogService) ListLogs(arg0 context.Context, arg1 models.LogFilter, arg2, arg3 int) ([]models.Log, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLogs", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]models.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLogs indicates an expected call of ListLogs.
+func (mr *MocklogServiceMockRecorder) ListLogs(arg0, arg1, arg2, arg3 any) *MocklogServiceListLogsCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLogs", reflect.TypeOf((*MocklogService)(nil).ListLogs), arg0, arg1, arg2, arg3)
+	return &MocklogServiceListLogsCall{Call: call}
+}
+
+// MocklogServiceListLogsCall wrap *gomock.Call
+type MocklogServiceListLogsCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogServiceListLogsCall) Return(arg0 []models.Log, arg1 error) *MocklogServiceListLogsCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogServiceListLogsCall) Do(f func(context.Context, models.LogFilter, int, int) ([]models.Log, error)) *MocklogServiceListLogsCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogServiceListLogsCall) DoAndReturn(f func(context.Context, models.LogFilter, int, int) ([]models.Log, error)) *MocklogServiceListLogsCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// VerifyAuditChain mocks base method.
+func (m *MocklogService) VerifyAuditChain(arg0 context.Context) (models.AuditChainReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyAuditChain", arg0)
+	ret0, _ := ret[0].(models.AuditChainReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyAuditChain indicates an expected call of VerifyAuditChain.
+func (mr *MocklogServiceMockRecorder) VerifyAuditChain(arg0 any) *MocklogServiceVerifyAuditChainCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAuditChain", reflect.TypeOf((*MocklogService)(nil).VerifyAuditChain), arg0)
+	return &MocklogServiceVerifyAuditChainCall{Call: call}
+}
+
+// MocklogServiceVerifyAuditChainCall wrap *gomock.Call
+type MocklogServiceVerifyAuditChainCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MocklogServiceVerifyAuditChainCall) Return(arg0 models.AuditChainReport, arg1 error) *MocklogServiceVerifyAuditChainCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MocklogServiceVerifyAuditChainCall) Do(f func(context.Context) (models.AuditChainReport, error)) *MocklogServiceVerifyAuditChainCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MocklogServiceVerifyAuditChainCall) DoAndReturn(f func(context.Context) (models.AuditChainReport, error)) *MocklogServiceVerifyAuditChainCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,39 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// VerifyAuditChain checks hash chain of audit logs
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Verify audit log chain
+// @Description Walks audit logs in order they were written and reports the first log whose hash or link is broken
+// @Tags logs
+// @Produce json
+// @Success 200 {object} models.AuditChainReport "Verification report"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 500 {string} string "Internal server error"
+// @Router /logs/verify [get]
+func (h *Handler) VerifyAuditChain(ctx context.Context, w http.ResponseWriter, _ *http.Request) {
+	report, err := h.logService.VerifyAuditChain(ctx)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Handler is a struct for handling login, token refresh and logout calls
+type Handler struct {
+	authService authService
+}
+
+// NewHandler creates an instance of auth Handler
+func NewHandler(authService authService) *Handler {
+	return &Handler{
+		authService: authService,
+	}
+}
+
+type authService interface {
+	Login(context.Context, string, string, string) (models.TokenPair, error)
+	Refresh(context.Context, string) (models.TokenPair, error)
+	Logout(context.Context, string, string) error
+	Unlock(context.Context, int, string) error
+	ChangePassword(context.Context, string, string, string, string) error
+}
+
+var (
+	// ErrFieldsMissing happens when some fields are missing
+	ErrFieldsMissing = errors.New("missing fields")
+
+	// ErrNoBearerToken happens when request doesn't have a bearer token
+	ErrNoBearerToken = errors.New("bearer token wasn't provided")
+
+	// ErrNoUsername happens when username wasn't provided
+	ErrNoUsername = errors.New("username wasn't provided")
+
+	// ErrUnauthenticated happens when request wasn't authorized by auth middlewares
+	ErrUnauthenticated = errors.New("unauthenticated")
+)
+
+type adminContextKey struct{}
+
+// ContextWithAdmin returns context carrying admin authorized by auth middlewares
+func ContextWithAdmin(ctx context.Context, admin models.Admin) context.Context {
+	return context.WithValue(ctx, adminContextKey{}, admin)
+}
+
+// AdminFromContext returns admin authorized by auth middlewares
+func AdminFromContext(ctx context.Context) (models.Admin, bool) {
+	admin, ok := ctx.Value(adminContextKey{}).(models.Admin)
+
+	return admin, ok
+}
+
+// ClientIP returns ip of the client that sent the request, forwarding headers are ignored
+// as they're set by the client itself
+func ClientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
@@ -0,0 +1,69 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+type changePasswordRequest struct {
+	Username    string `json:"username"`     // Username of the admin
+	Password    string `json:"password"`     // Current password of the admin
+	NewPassword string `json:"new_password"` // New password, it must follow password policy
+}
+
+// ChangePassword changes password by current credentials
+// @Summary Change password
+// @Description Changes password of an admin by their current credentials, works with expired passwords,
+// @Description so it's the way to log in again after password expiry
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body changePasswordRequest true "Credentials and new password"
+// @Success 200 {string} string "Password changed"
+// @Failure 400 {string} string "Invalid request, missing fields or rejected password"
+// @Failure 401 {string} string "Invalid username or password"
+// @Failure 429 {string} string "Too many failed login attempts"
+// @Failure 500 {string} string "Internal server error"
+// @Router /auth/password [post]
+func (h *Handler) ChangePassword(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var request changePasswordRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if request.Username == "" || request.Password == "" || request.NewPassword == "" {
+		http.Error(w, ErrFieldsMissing.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	err = h.authService.ChangePassword(ctx, request.Username, request.Password, request.NewPassword, ClientIP(r))
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	} else if errors.Is(err, auth.ErrLoginLocked) {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+
+		return
+	} else if errors.Is(err, password.ErrPolicyViolation) {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+type loginRequest struct {
+	Username string `json:"username"` // Username of the admin
+	Password string `json:"password"` // Password of the admin
+}
+
+// Login issues tokens
+// @Summary Login
+// @Description Checks admin credentials and issues short-lived access token and refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body loginRequest true "Credentials"
+// @Success 200 {object} models.TokenPair "Issued tokens"
+// @Failure 400 {string} string "Invalid request or missing fields"
+// @Failure 401 {string} string "Invalid username or password"
+// @Failure 403 {string} string "Password expired, it has to be changed at /auth/password"
+// @Failure 429 {string} string "Too many failed login attempts"
+// @Failure 500 {string} string "Internal server error"
+// @Router /auth/login [post]
+func (h *Handler) Login(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var request loginRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if request.Username == "" || request.Password == "" {
+		http.Error(w, ErrFieldsMissing.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	tokens, err := h.authService.Login(ctx, request.Username, request.Password, ClientIP(r))
+	if errors.Is(err, auth.ErrInvalidCredentials) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	} else if errors.Is(err, auth.ErrPasswordExpired) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	} else if errors.Is(err, auth.ErrLoginLocked) {
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeTokens(w, tokens)
+}
+
+func writeTokens(w http.ResponseWriter, tokens models.TokenPair) {
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
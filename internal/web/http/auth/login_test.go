@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+func TestHandler_Login(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		args         loginRequest
+		mockSetup    func(service *MockauthService)
+		expectedCode int
+	}{
+		{
+			name: "Missing fields",
+			args: loginRequest{
+				Username: "admin",
+			},
+			mockSetup:    func(_ *MockauthService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Correct request",
+			args: loginRequest{
+				Username: "admin",
+				Password: "password",
+			},
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Login(gomock.Any(), "admin", "password", "192.0.2.1").
+					Return(models.TokenPair{AccessToken: "access", RefreshToken: "refresh"}, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "Wrong credentials",
+			args: loginRequest{
+				Username: "admin",
+				Password: "wrong",
+			},
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Login(gomock.Any(), "admin", "wrong", "192.0.2.1").
+					Return(models.TokenPair{}, auth.ErrInvalidCredentials).Times(1)
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "Locked login",
+			args: loginRequest{
+				Username: "admin",
+				Password: "password",
+			},
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Login(gomock.Any(), "admin", "password", "192.0.2.1").
+					Return(models.TokenPair{}, auth.ErrLoginLocked).Times(1)
+			},
+			expectedCode: http.StatusTooManyRequests,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := NewMockauthService(ctrl)
+			tt.mockSetup(mockService)
+
+			reqBody, err := json.Marshal(tt.args)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(reqBody))
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.Login(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+type logoutRequest struct {
+	RefreshToken string `json:"refresh_token"` // RefreshToken is optional, it is revoked along with access token
+}
+
+// Logout revokes tokens
+// @Security BearerAuth
+// @Summary Logout
+// @Description Revokes access token from the Authorization header and passed refresh token
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body logoutRequest false "Refresh token"
+// @Success 200 {string} string "Logged out"
+// @Failure 401 {string} string "Invalid token"
+// @Failure 500 {string} string "Internal server error"
+// @Router /auth/logout [post]
+func (h *Handler) Logout(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || accessToken == "" {
+		http.Error(w, ErrNoBearerToken.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	// body is optional, without it only access token is revoked
+	var request logoutRequest
+	_ = json.NewDecoder(r.Body).Decode(&request)
+
+	err := h.authService.Logout(ctx, accessToken, request.RefreshToken)
+	if errors.Is(err, auth.ErrInvalidToken) || errors.Is(err, auth.ErrInvalidRefreshToken) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
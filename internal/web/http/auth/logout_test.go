@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+func TestHandler_Logout(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		header       string
+		body         string
+		mockSetup    func(service *MockauthService)
+		expectedCode int
+	}{
+		{
+			name:         "No bearer token",
+			header:       "Basic YWRtaW46cGFzc3dvcmQ=",
+			mockSetup:    func(_ *MockauthService) {},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:   "Access token only",
+			header: "Bearer access",
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Logout(gomock.Any(), "access", "").Return(nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "Both tokens",
+			header: "Bearer access",
+			body:   `{"refresh_token":"refresh"}`,
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Logout(gomock.Any(), "access", "refresh").Return(nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:   "Invalid token",
+			header: "Bearer forged",
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Logout(gomock.Any(), "forged", "").Return(auth.ErrInvalidToken).Times(1)
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := NewMockauthService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/auth/logout", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", tt.header)
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.Logout(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+		})
+	}
+}
@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: auth.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=auth.go -destination=mock_auth_service_test.go -package=auth
+//
+
+// Package auth is a generated GoMock package.
+package auth
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockauthService is a mock of authService interface.
+type MockauthService struct {
+	ctrl     *gomock.Controller
+	recorder *MockauthServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockauthServiceMockRecorder is the mock recorder for MockauthService.
+type MockauthServiceMockRecorder struct {
+	mock *MockauthService
+}
+
+// NewMockauthService creates a new mock instance.
+func NewMockauthService(ctrl *gomock.Controller) *MockauthService {
+	mock := &MockauthService{ctrl: ctrl}
+	mock.recorder = &MockauthServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauthService) EXPECT() *MockauthServiceMockRecorder {
+	return m.recorder
+}
+
+// ChangePassword mocks base method.
+func (m *MockauthService) ChangePassword(arg0 context.Context, arg1, arg2, arg3, arg4 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePassword", arg0, arg1, arg2, arg3, arg4)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangePassword indicates an expected call of ChangePassword.
+func (mr *MockauthServiceMockRecorder) ChangePassword(arg0, arg1, arg2, arg3, arg4 any) *MockauthServiceChangePasswordCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePassword", reflect.TypeOf((*MockauthService)(nil).ChangePassword), arg0, arg1, arg2, arg3, arg4)
+	return &MockauthServiceChangePasswordCall{Call: call}
+}
+
+// MockauthServiceChangePasswordCall wrap *gomock.Call
+type MockauthServiceChangePasswordCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceChangePasswordCall) Return(arg0 error) *MockauthServiceChangePasswordCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceChangePasswordCall) Do(f func(context.Context, string, string, string, string) error) *MockauthServiceChangePasswordCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceChangePasswordCall) DoAndReturn(f func(context.Context, string, string, string, string) error) *MockauthServiceChangePasswordCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Login mocks base method.
+func (m *MockauthService) Login(arg0 context.Context, arg1, arg2, arg3 string) (models.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(models.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockauthServiceMockRecorder) Login(arg0, arg1, arg2, arg3 any) *MockauthServiceLoginCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockauthService)(nil).Login), arg0, arg1, arg2, arg3)
+	return &MockauthServiceLoginCall{Call: call}
+}
+
+// MockauthServiceLoginCall wrap *gomock.Call
+type MockauthServiceLoginCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceLoginCall) Return(arg0 models.TokenPair, arg1 error) *MockauthServiceLoginCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceLoginCall) Do(f func(context.Context, string, string, string) (models.TokenPair, error)) *MockauthServiceLoginCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceLoginCall) DoAndReturn(f func(context.Context, string, string, string) (models.TokenPair, error)) *MockauthServiceLoginCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Logout mocks base method.
+func (m *MockauthService) Logout(arg0 context.Context, arg1, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockauthServiceMockRecorder) Logout(arg0, arg1, arg2 any) *MockauthServiceLogoutCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockauthService)(nil).Logout), arg0, arg1, arg2)
+	return &MockauthServiceLogoutCall{Call: call}
+}
+
+// MockauthServiceLogoutCall wrap *gomock.Call
+type MockauthServiceLogoutCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceLogoutCall) Return(arg0 error) *MockauthServiceLogoutCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceLogoutCall) Do(f func(context.Context, string, string) error) *MockauthServiceLogoutCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceLogoutCall) DoAndReturn(f func(context.Context, string, string) error) *MockauthServiceLogoutCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Refresh mocks base method.
+func (m *MockauthService) Refresh(arg0 context.Context, arg1 string) (models.TokenPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refresh", arg0, arg1)
+	ret0, _ := ret[0].(models.TokenPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Refresh indicates an expected call of Refresh.
+func (mr *MockauthServiceMockRecorder) Refresh(arg0, arg1 any) *MockauthServiceRefreshCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refresh", reflect.TypeOf((*MockauthService)(nil).Refresh), arg0, arg1)
+	return &MockauthServiceRefreshCall{Call: call}
+}
+
+// MockauthServiceRefreshCall wrap *gomock.Call
+type MockauthServiceRefreshCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceRefreshCall) Return(arg0 models.TokenPair, arg1 error) *MockauthServiceRefreshCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceRefreshCall) Do(f func(context.Context, string) (models.TokenPair, error)) *MockauthServiceRefreshCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceRefreshCall) DoAndReturn(f func(context.Context, string) (models.TokenPair, error)) *MockauthServiceRefreshCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// Unlock mocks base method.
+func (m *MockauthService) Unlock(arg0 context.Context, arg1 int, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockauthServiceMockRecorder) Unlock(arg0, arg1, arg2 any) *MockauthServiceUnlockCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockauthService)(nil).Unlock), arg0, arg1, arg2)
+	return &MockauthServiceUnlockCall{Call: call}
+}
+
+// MockauthServiceUnlockCall wrap *gomock.Call
+type MockauthServiceUnlockCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockauthServiceUnlockCall) Return(arg0 error) *MockauthServiceUnlockCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockauthServiceUnlockCall) Do(f func(context.Context, int, string) error) *MockauthServiceUnlockCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockauthServiceUnlockCall) DoAndReturn(f func(context.Context, int, string) error) *MockauthServiceUnlockCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,3 @@
+//go:generate mockgen -typed -source=auth.go -destination=mock_auth_service_test.go -package=auth
+
+package auth
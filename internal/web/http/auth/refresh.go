@@ -0,0 +1,60 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+)
+
+type refreshRequest struct {
+	RefreshToken string `json:"refresh_token"` // RefreshToken issued on login or previous refresh
+}
+
+// Refresh rotates tokens
+// @Summary Refresh tokens
+// @Description Exchanges refresh token for a new pair of tokens, refresh token can be used only once
+// @Tags auth
+// @Accept json
+// @Produce json
+// @Param request body refreshRequest true "Refresh token"
+// @Success 200 {object} models.TokenPair "Issued tokens"
+// @Failure 400 {string} string "Invalid request or missing fields"
+// @Failure 401 {string} string "Invalid refresh token"
+// @Failure 403 {string} string "Password expired"
+// @Failure 500 {string} string "Internal server error"
+// @Router /auth/refresh [post]
+func (h *Handler) Refresh(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var request refreshRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if request.RefreshToken == "" {
+		http.Error(w, ErrFieldsMissing.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	tokens, err := h.authService.Refresh(ctx, request.RefreshToken)
+	if errors.Is(err, auth.ErrInvalidRefreshToken) {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+
+		return
+	} else if errors.Is(err, auth.ErrPasswordExpired) {
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeTokens(w, tokens)
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/http/admin"
+)
+
+// Unlock lifts login lock of an admin
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Unlock an admin
+// @Description Lifts login lock of an admin locked after too many failed logins and forgets their failures
+// @Tags admins
+// @Produce json
+// @Param username path string true "Admin Username"
+// @Success 200 {string} string "Admin unlocked"
+// @Failure 400 {string} string "Username wasn't provided"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Admin doesn't exist"
+// @Failure 500 {string} string "Internal server error"
+// @Router /admins/{username}/unlock [post]
+func (h *Handler) Unlock(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	username, ok := mux.Vars(r)[admin.AdminUsernameParam]
+	if !ok {
+		http.Error(w, ErrNoUsername.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	// the unlock is attributed to the admin performing it
+	actor, ok := AdminFromContext(r.Context())
+	if !ok {
+		http.Error(w, ErrUnauthenticated.Error(), http.StatusUnauthorized)
+
+		return
+	}
+
+	err := h.authService.Unlock(ctx, actor.ID, username)
+	if errors.Is(err, auth.ErrAdminDoesntExist) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte("success"))
+}
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/web/http/admin"
+)
+
+func TestHandler_Unlock(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		username     string
+		noAdmin      bool
+		mockSetup    func(service *MockauthService)
+		expectedCode int
+	}{
+		{
+			name:     "Correct request",
+			username: "admin",
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Unlock(gomock.Any(), 2, "admin").Return(nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:     "No such admin",
+			username: "nobody",
+			mockSetup: func(authService *MockauthService) {
+				authService.EXPECT().Unlock(gomock.Any(), 2, "nobody").Return(auth.ErrAdminDoesntExist).Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "Unauthenticated request",
+			username:     "admin",
+			noAdmin:      true,
+			mockSetup:    func(*MockauthService) {},
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := NewMockauthService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodPost, "/admins/"+tt.username+"/unlock", nil)
+			req = mux.SetURLVars(req, map[string]string{admin.AdminUsernameParam: tt.username})
+			if !tt.noAdmin {
+				req = req.WithContext(ContextWithAdmin(req.Context(), models.Admin{ID: 2, Username: "boss"}))
+			}
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.Unlock(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+)
+
+// Handler is a struct for handling client related calls
+type Handler struct {
+	clientService clientService
+}
+
+// NewHandler creates an instance of client Handler
+func NewHandler(clientService clientService) *Handler {
+	return &Handler{
+		clientService: clientService,
+	}
+}
+
+const (
+	// PhoneParam is a query param for client phone
+	PhoneParam = "phone"
+)
+
+type clientService interface {
+	CreateClient(context.Context, models.Client) (int, error)
+	GetClientByPhone(context.Context, string) (models.Client, error)
+}
+
+var (
+	// ErrFieldsMissing happens when some fields are missing
+	ErrFieldsMissing = errors.New("missing fields")
+
+	// ErrNoPhone happens when phone wasn't provided
+	ErrNoPhone = errors.New("phone wasn't provided")
+)
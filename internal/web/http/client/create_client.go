@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+)
+
+type createClientRequest struct {
+	ID    int    `json:"id"`    // ID is an optional identifier, generated when omitted
+	Name  string `json:"name"`  // Name is the full name of the client
+	Phone string `json:"phone"` // Phone is the client's phone number
+	Email string `json:"email"` // Email is the client's email
+}
+
+type createClientResponse struct {
+	ID int `json:"id"`
+}
+
+// CreateClient registers client
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Create client
+// @Description Registers a new client, phone is normalized to E.164 and must be unique
+// @Tags clients
+// @Accept json
+// @Produce json
+// @Param client body createClientRequest true "Client details"
+// @Success 200 {object} createClientResponse "Client created successfully"
+// @Failure 400 {string} string "Invalid request, missing fields or wrong phone"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 409 {string} string "Phone or id is already used"
+// @Failure 500 {string} string "Internal server error"
+// @Router /clients [post]
+func (h *Handler) CreateClient(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	var createRequest = createClientRequest{}
+	err := json.NewDecoder(r.Body).Decode(&createRequest)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	if createRequest.Phone == "" {
+		http.Error(w, ErrFieldsMissing.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	newClient, err := models.NewClient(createRequest.ID, createRequest.Name, createRequest.Phone,
+		createRequest.Email)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	id, err := h.clientService.CreateClient(ctx, *newClient)
+	if errors.Is(err, client.ErrPhoneUsed) || errors.Is(err, client.ErrIDUsed) {
+		http.Error(w, err.Error(), http.StatusConflict)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(createClientResponse{ID: id})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
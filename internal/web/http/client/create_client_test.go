@@ -0,0 +1,97 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_CreateClient(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name         string
+		args         createClientRequest
+		mockSetup    func(service *MockclientService)
+		expectedCode int
+		expectedID   int
+	}{
+		{
+			name: "Missing phone",
+			args: createClientRequest{
+				Name: "Ivan Ivanov",
+			},
+			mockSetup:    func(_ *MockclientService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Wrong phone",
+			args: createClientRequest{
+				Name:  "Ivan Ivanov",
+				Phone: "call me maybe",
+			},
+			mockSetup:    func(_ *MockclientService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "Correct request",
+			args: createClientRequest{
+				Name:  "Ivan Ivanov",
+				Phone: "8 (999) 123-45-67",
+			},
+			mockSetup: func(clientService *MockclientService) {
+				clientService.EXPECT().CreateClient(gomock.Any(), gomock.Cond(func(c models.Client) bool {
+					return c.Phone == "+79991234567"
+				})).Return(42, nil).Times(1)
+			},
+			expectedCode: http.StatusOK,
+			expectedID:   42,
+		},
+		{
+			name: "Such phone exists",
+			args: createClientRequest{
+				Phone: "+79991234567",
+			},
+			mockSetup: func(clientService *MockclientService) {
+				clientService.EXPECT().CreateClient(gomock.Any(), gomock.Any()).Return(0, client.ErrPhoneUsed).Times(1)
+			},
+			expectedCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := NewMockclientService(ctrl)
+			tt.mockSetup(mockService)
+
+			reqBody, err := json.Marshal(tt.args)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodPost, "/clients", bytes.NewReader(reqBody))
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.CreateClient(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+
+			if tt.expectedCode == http.StatusOK {
+				var response createClientResponse
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+				assert.Equal(t, tt.expectedID, response.ID)
+			}
+		})
+	}
+}
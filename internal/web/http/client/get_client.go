@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+)
+
+// GetClientByPhone finds client by phone
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Get client by phone
+// @Description Finds a client by phone number in any common format
+// @Tags clients
+// @Produce json
+// @Param phone query string true "Phone number of the client"
+// @Success 200 {object} models.Client "Client"
+// @Failure 400 {string} string "Phone wasn't provided or is wrong"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Client not found"
+// @Failure 500 {string} string "Internal server error"
+// @Router /clients [get]
+func (h *Handler) GetClientByPhone(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	phone := r.URL.Query().Get(PhoneParam)
+	if phone == "" {
+		http.Error(w, ErrNoPhone.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	found, err := h.clientService.GetClientByPhone(ctx, phone)
+	switch {
+	case errors.Is(err, models.ErrWrongPhone):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	case errors.Is(err, client.ErrClientNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(found)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,90 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestHandler_GetClientByPhone(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		phone          string
+		mockSetup      func(service *MockclientService)
+		expectedCode   int
+		expectedClient models.Client
+	}{
+		{
+			name:         "Missing phone",
+			mockSetup:    func(_ *MockclientService) {},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Wrong phone",
+			phone: "12",
+			mockSetup: func(clientService *MockclientService) {
+				clientService.EXPECT().GetClientByPhone(gomock.Any(), "12").
+					Return(models.Client{}, models.ErrWrongPhone).Times(1)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Client not found",
+			phone: "+79991234567",
+			mockSetup: func(clientService *MockclientService) {
+				clientService.EXPECT().GetClientByPhone(gomock.Any(), "+79991234567").
+					Return(models.Client{}, client.ErrClientNotFound).Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:  "Correct request",
+			phone: "+79991234567",
+			mockSetup: func(clientService *MockclientService) {
+				clientService.EXPECT().GetClientByPhone(gomock.Any(), "+79991234567").
+					Return(models.Client{ID: 42, Name: "Ivan Ivanov", Phone: "+79991234567"}, nil).Times(1)
+			},
+			expectedCode:   http.StatusOK,
+			expectedClient: models.Client{ID: 42, Name: "Ivan Ivanov", Phone: "+79991234567"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockService := NewMockclientService(ctrl)
+			tt.mockSetup(mockService)
+
+			req := httptest.NewRequest(http.MethodGet, "/clients", nil)
+			q := req.URL.Query()
+			if tt.phone != "" {
+				q.Add(PhoneParam, tt.phone)
+			}
+			req.URL.RawQuery = q.Encode()
+			res := httptest.NewRecorder()
+			handler := NewHandler(mockService)
+
+			handler.GetClientByPhone(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedCode, res.Code)
+
+			if tt.expectedCode == http.StatusOK {
+				var response models.Client
+				require.NoError(t, json.NewDecoder(res.Body).Decode(&response))
+				assert.Equal(t, tt.expectedClient, response)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: client.go
+//
+// Generated by this command:
+//
+//	mockgen -typed -source=client.go -destination=mock_client_service_test.go -package=client
+//
+
+// Package client is a generated GoMock package.
+package client
+
+import (
+	context "context"
+	reflect "reflect"
+
+	models "gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockclientService is a mock of clientService interface.
+type MockclientService struct {
+	ctrl     *gomock.Controller
+	recorder *MockclientServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockclientServiceMockRecorder is the mock recorder for MockclientService.
+type MockclientServiceMockRecorder struct {
+	mock *MockclientService
+}
+
+// NewMockclientService creates a new mock instance.
+func NewMockclientService(ctrl *gomock.Controller) *MockclientService {
+	mock := &MockclientService{ctrl: ctrl}
+	mock.recorder = &MockclientServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockclientService) EXPECT() *MockclientServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateClient mocks base method.
+func (m *MockclientService) CreateClient(arg0 context.Context, arg1 models.Client) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClient", arg0, arg1)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClient indicates an expected call of CreateClient.
+func (mr *MockclientServiceMockRecorder) CreateClient(arg0, arg1 any) *MockclientServiceCreateClientCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClient", reflect.TypeOf((*MockclientService)(nil).CreateClient), arg0, arg1)
+	return &MockclientServiceCreateClientCall{Call: call}
+}
+
+// MockclientServiceCreateClientCall wrap *gomock.Call
+type MockclientServiceCreateClientCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientServiceCreateClientCall) Return(arg0 int, arg1 error) *MockclientServiceCreateClientCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientServiceCreateClientCall) Do(f func(context.Context, models.Client) (int, error)) *MockclientServiceCreateClientCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientServiceCreateClientCall) DoAndReturn(f func(context.Context, models.Client) (int, error)) *MockclientServiceCreateClientCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// GetClientByPhone mocks base method.
+func (m *MockclientService) GetClientByPhone(arg0 context.Context, arg1 string) (models.Client, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClientByPhone", arg0, arg1)
+	ret0, _ := ret[0].(models.Client)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClientByPhone indicates an expected call of GetClientByPhone.
+func (mr *MockclientServiceMockRecorder) GetClientByPhone(arg0, arg1 any) *MockclientServiceGetClientByPhoneCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClientByPhone", reflect.TypeOf((*MockclientService)(nil).GetClientByPhone), arg0, arg1)
+	return &MockclientServiceGetClientByPhoneCall{Call: call}
+}
+
+// MockclientServiceGetClientByPhoneCall wrap *gomock.Call
+type MockclientServiceGetClientByPhoneCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockclientServiceGetClientByPhoneCall) Return(arg0 models.Client, arg1 error) *MockclientServiceGetClientByPhoneCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockclientServiceGetClientByPhoneCall) Do(f func(context.Context, string) (models.Client, error)) *MockclientServiceGetClientByPhoneCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockclientServiceGetClientByPhoneCall) DoAndReturn(f func(context.Context, string) (models.Client, error)) *MockclientServiceGetClientByPhoneCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
@@ -0,0 +1,3 @@
+//go:generate mockgen -typed -source=client.go -destination=mock_client_service_test.go -package=client
+
+package client
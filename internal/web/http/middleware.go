@@ -14,19 +14,24 @@ import (
 
 	"github.com/gorilla/mux"
 
+	admin_Handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/admin"
+	auth_Handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/auth"
 	order_Handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/order"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
-	"gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	admin_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
 )
 
 var (
 	errUnauthorized    = errors.New("unauthorized")
 	errInvalidEncoding = errors.New("invalid encoding")
 	errInvalidFormat   = errors.New("invalid format")
-	errNoSuchUser      = errors.New("no such user")
-	errWrongPassword   = errors.New("wrong password")
+	errForbidden       = errors.New("forbidden")
+	errInvalidToken    = errors.New("invalid access token")
+	errInvalidAPIKey   = errors.New("invalid api key")
 )
 
 // FieldLogger logs fields of passed request body
@@ -50,7 +55,10 @@ func FieldLogger(handler http.Handler) http.Handler {
 
 // AuthMiddleware is a structure for auth middleware
 type AuthMiddleware struct {
-	adminService admin.Service
+	authService      auth.Service
+	adminService     admin_service.Service
+	apiKeyService    apikey.Service
+	basicAuthEnabled bool
 }
 
 func (a *AuthMiddleware) parseHeader(request *http.Request) (string, error) {
@@ -67,7 +75,69 @@ func (a *AuthMiddleware) parseHeader(request *http.Request) (string, error) {
 	return parts[1], nil
 }
 
-// BasicAuthChecker is a function that checks request for basic auth
+// Authenticate is a function that checks request for an API key in X-API-Key header or a bearer access token,
+// basic auth is accepted as a fallback if it's enabled
+func (a *AuthMiddleware) Authenticate(ctx context.Context, handler http.Handler) http.Handler {
+	basicAuthChecker := a.BasicAuthChecker(ctx, handler)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if key := r.Header.Get("X-API-Key"); key != "" {
+			admin, err := a.apiKeyService.Authenticate(ctx, key)
+			if errors.Is(err, apikey.ErrInvalidAPIKey) {
+				http.Error(w, errInvalidAPIKey.Error(), http.StatusUnauthorized)
+
+				return
+			} else if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+
+				return
+			}
+
+			handler.ServeHTTP(w, r.WithContext(auth_Handler.ContextWithAdmin(r.Context(), admin)))
+
+			return
+		}
+
+		accessToken, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok {
+			if a.basicAuthEnabled {
+				basicAuthChecker.ServeHTTP(w, r)
+			} else {
+				http.Error(w, errUnauthorized.Error(), http.StatusUnauthorized)
+			}
+
+			return
+		}
+
+		identity, err := a.authService.Authenticate(ctx, accessToken)
+		if errors.Is(err, auth.ErrInvalidToken) {
+			http.Error(w, errInvalidToken.Error(), http.StatusUnauthorized)
+
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		// admin is fetched again, so deleted and deactivated admins lose access before their tokens expire
+		admin, err := a.adminService.GetAdminByUsername(ctx, identity.Username)
+		if errors.Is(err, admin_service.ErrAdminDoesntExist) || (err == nil && !admin.IsActive()) {
+			http.Error(w, errInvalidToken.Error(), http.StatusUnauthorized)
+
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		handler.ServeHTTP(w, r.WithContext(auth_Handler.ContextWithAdmin(r.Context(), admin)))
+	})
+}
+
+// BasicAuthChecker is a function that checks request for basic auth,
+// repeated failures lock username and ip for a while
 func (a *AuthMiddleware) BasicAuthChecker(ctx context.Context, handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		credsStr, err := a.parseHeader(r)
@@ -93,15 +163,63 @@ func (a *AuthMiddleware) BasicAuthChecker(ctx context.Context, handler http.Hand
 
 		username, password := creds[0], creds[1]
 
-		admin, err := a.adminService.GetAdminByUsername(ctx, username)
-		if err != nil {
-			http.Error(w, errNoSuchUser.Error(), http.StatusUnauthorized)
+		admin, err := a.authService.VerifyCredentials(ctx, username, password, auth_Handler.ClientIP(r))
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			http.Error(w, err.Error(), http.StatusUnauthorized)
+
+			return
+		} else if errors.Is(err, auth.ErrPasswordExpired) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+
+			return
+		} else if errors.Is(err, auth.ErrLoginLocked) {
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+
+			return
+		} else if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+
+			return
+		}
+
+		handler.ServeHTTP(w, r.WithContext(auth_Handler.ContextWithAdmin(r.Context(), admin)))
+	})
+}
+
+// RequirePermission is a function that lets only admins with a given permission through,
+// it must be wrapped in BasicAuthChecker
+func RequirePermission(permission models.Permission, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		admin, ok := auth_Handler.AdminFromContext(r.Context())
+		if !ok {
+			http.Error(w, errUnauthorized.Error(), http.StatusUnauthorized)
+
+			return
+		}
+
+		if !admin.HasPermission(permission) {
+			http.Error(w, errForbidden.Error(), http.StatusForbidden)
+
+			return
+		}
+
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// RequireSelfOrPermission is a function that lets through admins managing their own account
+// or having a given permission, it must be wrapped in BasicAuthChecker
+func RequireSelfOrPermission(permission models.Permission, handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		admin, ok := auth_Handler.AdminFromContext(r.Context())
+		if !ok {
+			http.Error(w, errUnauthorized.Error(), http.StatusUnauthorized)
 
 			return
 		}
 
-		if !admin.CheckPassword(password) {
-			http.Error(w, errWrongPassword.Error(), http.StatusUnauthorized)
+		if admin.Username != mux.Vars(r)[admin_Handler.AdminUsernameParam] && !admin.HasPermission(permission) {
+			http.Error(w, errForbidden.Error(), http.StatusForbidden)
 
 			return
 		}
@@ -112,8 +230,7 @@ func (a *AuthMiddleware) BasicAuthChecker(ctx context.Context, handler http.Hand
 
 // AuditLoggerMiddleware is a structure for audit logger middleware
 type AuditLoggerMiddleware struct {
-	adminService       admin.Service
-	auditLoggerService auditlogger.Service
+	auditLoggerService *auditlogger.Service
 }
 
 type responseWriterWrapper struct {
@@ -138,13 +255,17 @@ type requestBody struct {
 }
 
 // AuditLogger is a function that logs all requests and responses
-func (a *AuditLoggerMiddleware) AuditLogger(ctx context.Context, handler http.Handler) http.Handler {
+func (a *AuditLoggerMiddleware) AuditLogger(handler http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		var request requestBody
 		body, err := io.ReadAll(r.Body)
-		if err != nil {
+		switch {
+		// ids in bodies of admins and clients aren't order ids, such requests aren't about any order
+		case !strings.HasPrefix(r.URL.Path, "/orders"):
+			request.ID = -1
+		case err != nil:
 			request.ID, _ = strconv.Atoi(mux.Vars(r)[order_Handler.OrderIDParam])
-		} else {
+		default:
 			err = json.Unmarshal(body, &request)
 			if err != nil || request.ID == 0 {
 				request.ID = -1
@@ -153,19 +274,19 @@ func (a *AuditLoggerMiddleware) AuditLogger(ctx context.Context, handler http.Ha
 
 		r.Body = io.NopCloser(bytes.NewReader(body))
 
-		username, _, _ := r.BasicAuth()
+		r = r.WithContext(auditlogger.ContextWithDiffRecorder(r.Context()))
 
 		rw := &responseWriterWrapper{ResponseWriter: w, statusCode: http.StatusOK}
 		handler.ServeHTTP(rw, r)
 
-		select {
-		case <-ctx.Done():
-			return
-		default:
-			someAdmin, _ := a.adminService.GetAdminByUsername(ctx, username)
-			responseText := strings.TrimSpace(rw.body.String())
-			currentLog := *models.NewLog(request.ID, someAdmin.ID, responseText, r.URL.Path, r.Method, rw.statusCode)
-			a.auditLoggerService.CreateLog(ctx, currentLog)
+		// admin is put into context by auth middleware, it's zero for unauthorized requests
+		someAdmin, _ := auth_Handler.AdminFromContext(r.Context())
+		responseText := strings.TrimSpace(rw.body.String())
+		currentLog := *models.NewLog(request.ID, someAdmin.ID, responseText, r.URL.Path, r.Method, rw.statusCode)
+		if someAdmin.APIKeyID != 0 {
+			currentLog.APIKeyID = &someAdmin.APIKeyID
 		}
+		currentLog.Diff = auditlogger.DiffFromContext(r.Context())
+		a.auditLoggerService.CreateLog(context.WithoutCancel(r.Context()), currentLog)
 	})
 }
@@ -3,15 +3,19 @@ package order
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	order_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 
 	"github.com/Rhymond/go-money"
 )
 
 // CreateOrder handles the creation of an order
+// @Security BearerAuth
 // @Security BasicAuth
 // @Summary Create a new order
 // @Description Creates a new order based on the provided order details and validates the fields
@@ -19,10 +23,12 @@ import (
 // @Accept  json
 // @Produce  json
 // @Param order body createOrderRequest true "Order details"
-// @Success 200 {string} string "Success"
+// @Success 200 {object} pickupCodeResponse "One-time pickup code for the client"
 // @Failure 400 {string} string "Invalid JSON format"
 // @Failure 400 {string} string "Missing required fields"
 // @Failure 400 {string} string "Invalid packaging"
+// @Failure 400 {string} string "Unknown currency"
+// @Failure 404 {string} string "Client not found"
 // @Router /orders [post]
 func (h *Handler) CreateOrder(ctx context.Context, w http.ResponseWriter, r *http.Request) {
 	var order = createOrderRequest{}
@@ -40,6 +46,12 @@ func (h *Handler) CreateOrder(ctx context.Context, w http.ResponseWriter, r *htt
 		return
 	}
 
+	if _, err = currency.Validate(order.Price.Currency().Code); err != nil {
+		http.Error(w, errUnknownCurrency.Error(), http.StatusBadRequest)
+
+		return
+	}
+
 	packagings := make([]models.Packaging, 0, 2)
 	packaging, err := getPackaging(models.GetPackagingName(models.PackagingType(order.Packaging)))
 	extraPackaging, errExtra := getPackaging(models.GetPackagingName(models.PackagingType(order.ExtraPackaging)))
@@ -52,7 +64,19 @@ func (h *Handler) CreateOrder(ctx context.Context, w http.ResponseWriter, r *htt
 	packagings = append(packagings, packaging)
 	packagings = append(packagings, extraPackaging)
 
-	err = h.OrderService.AcceptOrder(ctx, order.ID, order.UserID, order.Weight, order.Price, order.ExpiryDate, packagings)
+	pickupCode, err := h.OrderService.AcceptOrder(ctx, order.ID, order.UserID, order.Weight, order.Price,
+		order.ExpiryDate, packagings)
+	if errors.Is(err, order_service.ErrClientNotFound) {
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	} else if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(pickupCodeResponse{PickupCode: pickupCode})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 
@@ -61,7 +85,7 @@ func (h *Handler) CreateOrder(ctx context.Context, w http.ResponseWriter, r *htt
 
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write([]byte("success"))
+	_, _ = w.Write(data)
 }
 
 func getPackaging(packagingStr string) (models.Packaging, error) {
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 )
 
 func TestHandler_CreateOrder(t *testing.T) {
@@ -22,6 +24,7 @@ func TestHandler_CreateOrder(t *testing.T) {
 		args         createOrderRequest
 		mockSetup    func(orderService *MockorderService)
 		expectedCode int
+		expectedBody string
 	}{
 		{
 			name: "Invalid JSON",
@@ -61,6 +64,21 @@ func TestHandler_CreateOrder(t *testing.T) {
 			mockSetup:    func(_ *MockorderService) {},
 			expectedCode: http.StatusBadRequest,
 		},
+		{
+			name: "Unknown currency",
+			args: createOrderRequest{
+				ID:             123,
+				UserID:         2312,
+				Weight:         100,
+				Price:          *money.New(1000, "XYZ"),
+				Packaging:      2,
+				ExtraPackaging: 3,
+				Status:         0,
+				ExpiryDate:     time.Now().AddDate(1, 0, 0),
+			},
+			mockSetup:    func(_ *MockorderService) {},
+			expectedCode: http.StatusBadRequest,
+		},
 		{
 			name: "Not enough weight",
 			args: createOrderRequest{
@@ -76,10 +94,29 @@ func TestHandler_CreateOrder(t *testing.T) {
 			mockSetup: func(orderService *MockorderService) {
 				orderService.EXPECT().AcceptOrder(gomock.Any(), gomock.Eq(123), gomock.Eq(2312), gomock.Eq(1.0),
 					gomock.Eq(*money.New(1000, money.RUB)),
-					gomock.Any(), gomock.Any()).Return(errors.New("not enough weight")).Times(1)
+					gomock.Any(), gomock.Any()).Return("", errors.New("not enough weight")).Times(1)
 			},
 			expectedCode: http.StatusInternalServerError,
 		},
+		{
+			name: "Unknown client",
+			args: createOrderRequest{
+				ID:             124,
+				UserID:         9999,
+				Weight:         100,
+				Price:          *money.New(1000, money.RUB),
+				Packaging:      2,
+				ExtraPackaging: 3,
+				Status:         0,
+				ExpiryDate:     time.Now().AddDate(1, 0, 0),
+			},
+			mockSetup: func(orderService *MockorderService) {
+				orderService.EXPECT().AcceptOrder(gomock.Any(), gomock.Eq(124), gomock.Eq(9999), gomock.Eq(100.0),
+					gomock.Eq(*money.New(1000, money.RUB)),
+					gomock.Any(), gomock.Any()).Return("", order.ErrClientNotFound).Times(1)
+			},
+			expectedCode: http.StatusNotFound,
+		},
 		{
 			name: "Correct order",
 			args: createOrderRequest{
@@ -95,9 +132,10 @@ func TestHandler_CreateOrder(t *testing.T) {
 			mockSetup: func(orderService *MockorderService) {
 				orderService.EXPECT().AcceptOrder(gomock.Any(), gomock.Eq(123), gomock.Eq(2312), gomock.Eq(100.0),
 					gomock.Eq(*money.New(1000, money.RUB)),
-					gomock.Any(), gomock.Any()).Return(nil).Times(1)
+					gomock.Any(), gomock.Any()).Return("042137", nil).Times(1)
 			},
 			expectedCode: http.StatusOK,
+			expectedBody: `{"pickup_code":"042137"}`,
 		},
 	}
 
@@ -122,6 +160,10 @@ func TestHandler_CreateOrder(t *testing.T) {
 			handler.CreateOrder(t.Context(), res, req)
 
 			assert.Equal(t, tt.expectedCode, res.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, res.Body.String())
+			}
 		})
 	}
 }
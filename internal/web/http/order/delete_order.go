@@ -9,6 +9,7 @@ import (
 )
 
 // DeleteOrder handles the deletion of an order
+// @Security BearerAuth
 // @Security BasicAuth
 // @Summary Delete an order by its ID
 // @Description Deletes the specified order and returns success or error response
@@ -18,6 +19,8 @@ import (
 // @Param orderID path int true "Order ID"
 // @Success 200 {string} string "Success"
 // @Failure 400 {string} string "Invalid Order ID"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden, only supervisors and superadmins can delete orders"
 // @Failure 500 {string} string "Internal Server Error"
 // @Router /orders/{orderID} [delete]
 func (h *Handler) DeleteOrder(ctx context.Context, w http.ResponseWriter, r *http.Request) {
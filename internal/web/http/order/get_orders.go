@@ -9,6 +9,9 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Rhymond/go-money"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	myquery "gitlab.ozon.dev/alexplay1224/homework/internal/query"
 )
@@ -16,9 +19,11 @@ import (
 type getOrdersResponce struct {
 	Count  int            `json:"count"`
 	Orders []models.Order `json:"orders"`
+	Total  money.Money    `json:"total"`
 }
 
 // GetOrders retrieves a list of orders based on filter parameters
+// @Security BearerAuth
 // @Security BasicAuth
 // @Summary Get orders with filters
 // @Description Retrieves a paginated list of orders based on the provided filter parameters (e.g., count, page, etc.)
@@ -33,6 +38,8 @@ type getOrdersResponce struct {
 // @Param price query float64 false "Price of the order"
 // @Param price_from query float64 false "Minimum price of the order"
 // @Param price_to query float64 false "Maximum price of the order"
+// @Param currency query string false "ISO 4217 currency of the order price"
+// @Param phone query string false "Phone number of the client"
 // @Param status query int false "Status of the order"
 // @Param expiry_date_from query string false "Start date of the expiry range" format(date) "2025-03-10T00:00:00Z"
 // @Param expiry_date_to query string false "End date of the expiry range" format(date) "2025-03-10T00:00:00Z"
@@ -60,9 +67,17 @@ func (h *Handler) GetOrders(ctx context.Context, w http.ResponseWriter, r *http.
 		return
 	}
 
+	total, err := h.OrderService.TotalPrice(orders)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
 	response := getOrdersResponce{
 		Count:  len(orders),
 		Orders: orders,
+		Total:  *total,
 	}
 
 	data, err := json.Marshal(response)
@@ -115,6 +130,27 @@ func (h *Handler) validateWordParam(status string) (string, error) {
 	return "", errWrongStatusFormat
 }
 
+func (h *Handler) validateCurrencyParam(param string) (string, error) {
+	if param == "" {
+		return "", nil
+	}
+
+	code, err := currency.Validate(param)
+	if err != nil {
+		return "", errUnknownCurrency
+	}
+
+	return code, nil
+}
+
+func (h *Handler) validatePhoneParam(param string) (string, error) {
+	if param == "" {
+		return "", nil
+	}
+
+	return models.NormalizePhone(param)
+}
+
 func (h *Handler) validateDateParam(param string) (string, error) {
 	if param == "" {
 		return "", nil
@@ -143,6 +179,8 @@ func GetFilters() map[string]InputType {
 		PriceFromParam:       NumberType,
 		PriceToParam:         NumberType,
 		StatusParam:          NumberType,
+		CurrencyParam:        CurrencyType,
+		PhoneParam:           PhoneType,
 		ExpiryDateFromParam:  DateType,
 		ExpiryDateToParam:    DateType,
 		ArrivalDateFromParam: DateType,
@@ -161,6 +199,8 @@ func (h *Handler) getCondMap() map[string]myquery.CondType {
 		PriceFromParam:       myquery.GreaterEqualThan,
 		PriceToParam:         myquery.LessEqualThan,
 		StatusParam:          myquery.Equals,
+		CurrencyParam:        myquery.Equals,
+		PhoneParam:           myquery.Equals,
 		ExpiryDateFromParam:  myquery.GreaterEqualThan,
 		ExpiryDateToParam:    myquery.LessEqualThan,
 		ArrivalDateFromParam: myquery.GreaterEqualThan,
@@ -179,6 +219,8 @@ func (h *Handler) getColumnMap() map[string]string {
 		PriceFromParam:       PriceParam,
 		PriceToParam:         PriceParam,
 		StatusParam:          StatusParam,
+		CurrencyParam:        CurrencyParam,
+		PhoneParam:           PhoneParam,
 		ExpiryDateFromParam:  ExpiryDateParam,
 		ExpiryDateToParam:    ExpiryDateParam,
 		ArrivalDateFromParam: ArrivalDateParam,
@@ -234,6 +276,10 @@ func (h *Handler) validateParam(value string, inputType InputType) (string, erro
 		return h.validateWordParam(value)
 	case DateType:
 		return h.validateDateParam(value)
+	case CurrencyType:
+		return h.validateCurrencyParam(value)
+	case PhoneType:
+		return h.validatePhoneParam(value)
 	default:
 		return "", fmt.Errorf("unknown input type: %v", inputType)
 	}
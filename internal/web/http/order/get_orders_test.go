@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	myquery "gitlab.ozon.dev/alexplay1224/homework/internal/query"
 
 	"github.com/Rhymond/go-money"
 	"github.com/stretchr/testify/assert"
@@ -38,6 +39,7 @@ func TestHandler_GetOrders(t *testing.T) {
 					{ID: 1, UserID: 123, Weight: 10, Price: *money.New(1000, money.RUB)},
 				}
 				orderService.EXPECT().GetOrders(gomock.Any(), gomock.Any(), 10, 0).Return(orders, nil).Times(1)
+				orderService.EXPECT().TotalPrice(orders).Return(money.New(1000, money.RUB), nil).Times(1)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  1,
@@ -78,6 +80,7 @@ func TestHandler_GetOrders(t *testing.T) {
 					{ID: 2, UserID: 124, Weight: 20, Price: *money.New(2000, money.RUB)},
 				}
 				orderService.EXPECT().GetOrders(gomock.Any(), gomock.Any(), 5, 2).Return(orders, nil).Times(1)
+				orderService.EXPECT().TotalPrice(orders).Return(money.New(3000, money.RUB), nil).Times(1)
 			},
 			expectedStatus: http.StatusOK,
 			expectedCount:  2,
@@ -86,6 +89,80 @@ func TestHandler_GetOrders(t *testing.T) {
 				{ID: 2, UserID: 124, Weight: 20, Price: *money.New(2000, money.RUB)},
 			},
 		},
+		{
+			name: "Filter by currency",
+			queryParams: map[string]string{
+				"currency": "usd",
+			},
+			mockSetup: func(orderService *MockorderService) {
+				orders := []models.Order{
+					{ID: 3, UserID: 125, Weight: 30, Price: *money.New(500, money.USD)},
+				}
+				orderService.EXPECT().GetOrders(gomock.Any(), gomock.Any(), 0, 0).Return(orders, nil).Times(1)
+				orderService.EXPECT().TotalPrice(orders).Return(money.New(46250, money.RUB), nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			expectedOrders: []models.Order{
+				{ID: 3, UserID: 125, Weight: 30, Price: *money.New(500, money.USD)},
+			},
+		},
+		{
+			name: "Invalid filter (unknown currency)",
+			queryParams: map[string]string{
+				"currency": "XYZ",
+			},
+			mockSetup:      func(_ *MockorderService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCount:  0,
+			expectedOrders: nil,
+		},
+		{
+			name: "Filter by phone",
+			queryParams: map[string]string{
+				"phone": "8 (999) 123-45-67",
+			},
+			mockSetup: func(orderService *MockorderService) {
+				orders := []models.Order{
+					{ID: 5, UserID: 127, Weight: 10, Price: *money.New(1000, money.RUB)},
+				}
+				orderService.EXPECT().GetOrders(gomock.Any(), gomock.Cond(func(conds []myquery.Cond) bool {
+					return len(conds) == 1 && conds[0].Field == "phone" && conds[0].Value == "+79991234567"
+				}), 0, 0).Return(orders, nil).Times(1)
+				orderService.EXPECT().TotalPrice(orders).Return(money.New(1000, money.RUB), nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  1,
+			expectedOrders: []models.Order{
+				{ID: 5, UserID: 127, Weight: 10, Price: *money.New(1000, money.RUB)},
+			},
+		},
+		{
+			name: "Invalid filter (wrong phone)",
+			queryParams: map[string]string{
+				"phone": "12-34",
+			},
+			mockSetup:      func(_ *MockorderService) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedCount:  0,
+			expectedOrders: nil,
+		},
+		{
+			name: "Total price in mismatched currency",
+			queryParams: map[string]string{
+				"count": "5",
+			},
+			mockSetup: func(orderService *MockorderService) {
+				orders := []models.Order{
+					{ID: 4, UserID: 126, Weight: 10, Price: *money.New(100, money.JPY)},
+				}
+				orderService.EXPECT().GetOrders(gomock.Any(), gomock.Any(), 5, 0).Return(orders, nil).Times(1)
+				orderService.EXPECT().TotalPrice(orders).Return(nil, errors.New("no conversion rate")).Times(1)
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedCount:  0,
+			expectedOrders: nil,
+		},
 		{
 			name: "Error from order service",
 			queryParams: map[string]string{
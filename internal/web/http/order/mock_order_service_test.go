@@ -45,11 +45,12 @@ func (m *MockorderService) EXPECT() *MockorderServiceMockRecorder {
 }
 
 // AcceptOrder mocks base method.
-func (m *MockorderService) AcceptOrder(arg0 context.Context, arg1, arg2 int, arg3 float64, arg4 money.Money, arg5 time.Time, arg6 []models.Packaging) error {
+func (m *MockorderService) AcceptOrder(arg0 context.Context, arg1, arg2 int, arg3 float64, arg4 money.Money, arg5 time.Time, arg6 []models.Packaging) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AcceptOrder", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AcceptOrder indicates an expected call of AcceptOrder.
@@ -65,19 +66,19 @@ type MockorderServiceAcceptOrderCall struct {
 }
 
 // Return rewrite *gomock.Call.Return
-func (c *MockorderServiceAcceptOrderCall) Return(arg0 error) *MockorderServiceAcceptOrderCall {
-	c.Call = c.Call.Return(arg0)
+func (c *MockorderServiceAcceptOrderCall) Return(arg0 string, arg1 error) *MockorderServiceAcceptOrderCall {
+	c.Call = c.Call.Return(arg0, arg1)
 	return c
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceAcceptOrderCall) Do(f func(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) error) *MockorderServiceAcceptOrderCall {
+func (c *MockorderServiceAcceptOrderCall) Do(f func(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) (string, error)) *MockorderServiceAcceptOrderCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceAcceptOrderCall) DoAndReturn(f func(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) error) *MockorderServiceAcceptOrderCall {
+func (c *MockorderServiceAcceptOrderCall) DoAndReturn(f func(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) (string, error)) *MockorderServiceAcceptOrderCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -122,17 +123,17 @@ func (c *MockorderServiceGetOrdersCall) DoAndReturn(f func(context.Context, []qu
 }
 
 // ProcessOrder mocks base method.
-func (m *MockorderService) ProcessOrder(arg0 context.Context, arg1, arg2 int, arg3 string) error {
+func (m *MockorderService) ProcessOrder(arg0 context.Context, arg1, arg2 int, arg3, arg4 string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ProcessOrder", arg0, arg1, arg2, arg3)
+	ret := m.ctrl.Call(m, "ProcessOrder", arg0, arg1, arg2, arg3, arg4)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // ProcessOrder indicates an expected call of ProcessOrder.
-func (mr *MockorderServiceMockRecorder) ProcessOrder(arg0, arg1, arg2, arg3 any) *MockorderServiceProcessOrderCall {
+func (mr *MockorderServiceMockRecorder) ProcessOrder(arg0, arg1, arg2, arg3, arg4 any) *MockorderServiceProcessOrderCall {
 	mr.mock.ctrl.T.Helper()
-	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessOrder", reflect.TypeOf((*MockorderService)(nil).ProcessOrder), arg0, arg1, arg2, arg3)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessOrder", reflect.TypeOf((*MockorderService)(nil).ProcessOrder), arg0, arg1, arg2, arg3, arg4)
 	return &MockorderServiceProcessOrderCall{Call: call}
 }
 
@@ -148,13 +149,52 @@ func (c *MockorderServiceProcessOrderCall) Return(arg0 error) *MockorderServiceP
 }
 
 // Do rewrite *gomock.Call.Do
-func (c *MockorderServiceProcessOrderCall) Do(f func(context.Context, int, int, string) error) *MockorderServiceProcessOrderCall {
+func (c *MockorderServiceProcessOrderCall) Do(f func(context.Context, int, int, string, string) error) *MockorderServiceProcessOrderCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceProcessOrderCall) DoAndReturn(f func(context.Context, int, int, string, string) error) *MockorderServiceProcessOrderCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
+// RegenerateCode mocks base method.
+func (m *MockorderService) RegenerateCode(arg0 context.Context, arg1 int) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegenerateCode", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegenerateCode indicates an expected call of RegenerateCode.
+func (mr *MockorderServiceMockRecorder) RegenerateCode(arg0, arg1 any) *MockorderServiceRegenerateCodeCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegenerateCode", reflect.TypeOf((*MockorderService)(nil).RegenerateCode), arg0, arg1)
+	return &MockorderServiceRegenerateCodeCall{Call: call}
+}
+
+// MockorderServiceRegenerateCodeCall wrap *gomock.Call
+type MockorderServiceRegenerateCodeCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceRegenerateCodeCall) Return(arg0 string, arg1 error) *MockorderServiceRegenerateCodeCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceRegenerateCodeCall) Do(f func(context.Context, int) (string, error)) *MockorderServiceRegenerateCodeCall {
 	c.Call = c.Call.Do(f)
 	return c
 }
 
 // DoAndReturn rewrite *gomock.Call.DoAndReturn
-func (c *MockorderServiceProcessOrderCall) DoAndReturn(f func(context.Context, int, int, string) error) *MockorderServiceProcessOrderCall {
+func (c *MockorderServiceRegenerateCodeCall) DoAndReturn(f func(context.Context, int) (string, error)) *MockorderServiceRegenerateCodeCall {
 	c.Call = c.Call.DoAndReturn(f)
 	return c
 }
@@ -236,6 +276,45 @@ func (c *MockorderServiceReturnsCall) DoAndReturn(f func(context.Context) ([]mod
 	return c
 }
 
+// TotalPrice mocks base method.
+func (m *MockorderService) TotalPrice(arg0 []models.Order) (*money.Money, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TotalPrice", arg0)
+	ret0, _ := ret[0].(*money.Money)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TotalPrice indicates an expected call of TotalPrice.
+func (mr *MockorderServiceMockRecorder) TotalPrice(arg0 any) *MockorderServiceTotalPriceCall {
+	mr.mock.ctrl.T.Helper()
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TotalPrice", reflect.TypeOf((*MockorderService)(nil).TotalPrice), arg0)
+	return &MockorderServiceTotalPriceCall{Call: call}
+}
+
+// MockorderServiceTotalPriceCall wrap *gomock.Call
+type MockorderServiceTotalPriceCall struct {
+	*gomock.Call
+}
+
+// Return rewrite *gomock.Call.Return
+func (c *MockorderServiceTotalPriceCall) Return(arg0 *money.Money, arg1 error) *MockorderServiceTotalPriceCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrite *gomock.Call.Do
+func (c *MockorderServiceTotalPriceCall) Do(f func([]models.Order) (*money.Money, error)) *MockorderServiceTotalPriceCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrite *gomock.Call.DoAndReturn
+func (c *MockorderServiceTotalPriceCall) DoAndReturn(f func([]models.Order) (*money.Money, error)) *MockorderServiceTotalPriceCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
+}
+
 // UserOrders mocks base method.
 func (m *MockorderService) UserOrders(arg0 context.Context, arg1, arg2 int) ([]models.Order, error) {
 	m.ctrl.T.Helper()
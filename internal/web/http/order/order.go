@@ -69,6 +69,12 @@ const (
 	// PriceToParam is a param for price to
 	PriceToParam = "price_to"
 
+	// CurrencyParam is a param for currency
+	CurrencyParam = "currency"
+
+	// PhoneParam is a param for client phone
+	PhoneParam = "phone"
+
 	// CountParam is a param for count
 	CountParam = "count"
 
@@ -77,12 +83,14 @@ const (
 )
 
 type orderService interface {
-	AcceptOrder(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) error
+	AcceptOrder(context.Context, int, int, float64, money.Money, time.Time, []models.Packaging) (string, error)
 	ReturnOrder(context.Context, int) error
-	ProcessOrder(context.Context, int, int, string) error
+	ProcessOrder(context.Context, int, int, string, string) error
+	RegenerateCode(context.Context, int) (string, error)
 	UserOrders(context.Context, int, int) ([]models.Order, error)
 	Returns(context.Context) ([]models.Order, error)
 	GetOrders(context.Context, []myquery.Cond, int, int) ([]models.Order, error)
+	TotalPrice([]models.Order) (*money.Money, error)
 }
 
 var (
@@ -93,6 +101,7 @@ var (
 	errWrongStatusFormat = errors.New("wrong status format")
 	errFieldsMissing     = errors.New("missing fields")
 	errWrongJSONFormat   = errors.New("wrong json format")
+	errUnknownCurrency   = errors.New("unknown currency")
 )
 
 // InputType is a type for all inputs
@@ -105,6 +114,10 @@ const (
 	WordType
 	// DateType is for date inputs
 	DateType
+	// CurrencyType is for currency code inputs
+	CurrencyType
+	// PhoneType is for phone number inputs
+	PhoneType
 )
 
 const (
@@ -0,0 +1,67 @@
+package order
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+
+	order_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
+)
+
+type pickupCodeResponse struct {
+	PickupCode string `json:"pickup_code"`
+}
+
+// RegenerateCode issues new one-time pickup code for an order
+// @Security BearerAuth
+// @Security BasicAuth
+// @Summary Regenerate pickup code
+// @Description Issues new pickup code for a stored order, the previous code and failed attempts are discarded
+// @Tags orders
+// @Produce  json
+// @Param orderID path int true "Order ID"
+// @Success 200 {object} pickupCodeResponse "New pickup code"
+// @Failure 400 {string} string "Invalid Order ID"
+// @Failure 404 {string} string "Order not found"
+// @Failure 409 {string} string "Order is not stored at the pickup point"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /orders/{orderID}/code [post]
+func (h *Handler) RegenerateCode(ctx context.Context, w http.ResponseWriter, r *http.Request) {
+	orderID, err := strconv.Atoi(mux.Vars(r)[OrderIDParam])
+	if err != nil {
+		http.Error(w, errInvalidOrderID.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	pickupCode, err := h.OrderService.RegenerateCode(ctx, orderID)
+	switch {
+	case errors.Is(err, order_service.ErrOrderNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+
+		return
+	case errors.Is(err, order_service.ErrOrderNotEligible):
+		http.Error(w, err.Error(), http.StatusConflict)
+
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	data, err := json.Marshal(pickupCodeResponse{PickupCode: pickupCode})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
@@ -0,0 +1,83 @@
+package order
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
+)
+
+func TestHandler_RegenerateCode(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name           string
+		orderIDParam   string
+		mockSetup      func(orderService *MockorderService)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:         "Valid order ID",
+			orderIDParam: "123",
+			mockSetup: func(orderService *MockorderService) {
+				orderService.EXPECT().RegenerateCode(gomock.Any(), 123).Return("042137", nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"pickup_code":"042137"}`,
+		},
+		{
+			name:           "Invalid order ID format",
+			orderIDParam:   "invalid",
+			mockSetup:      func(_ *MockorderService) {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:         "Order not found",
+			orderIDParam: "123",
+			mockSetup: func(orderService *MockorderService) {
+				orderService.EXPECT().RegenerateCode(gomock.Any(), 123).Return("", order.ErrOrderNotFound).Times(1)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:         "Order is already given",
+			orderIDParam: "123",
+			mockSetup: func(orderService *MockorderService) {
+				orderService.EXPECT().RegenerateCode(gomock.Any(), 123).Return("", order.ErrOrderNotEligible).Times(1)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockOrderService := NewMockorderService(ctrl)
+			tt.mockSetup(mockOrderService)
+
+			req := httptest.NewRequest(http.MethodPost, "/orders/"+tt.orderIDParam+"/code", nil)
+			res := httptest.NewRecorder()
+			req = mux.SetURLVars(req, map[string]string{
+				OrderIDParam: tt.orderIDParam,
+			})
+
+			handler := NewHandler(mockOrderService)
+
+			handler.RegenerateCode(t.Context(), res, req)
+
+			assert.Equal(t, tt.expectedStatus, res.Code)
+
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, res.Body.String())
+			}
+		})
+	}
+}
@@ -3,7 +3,10 @@ package order
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
+
+	order_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 )
 
 // processOrderRequest represents the request body for the UpdateOrder endpoint
@@ -16,12 +19,14 @@ import (
 // @Failure 500 {string} string "Internal server error"
 // @Router /orders/update [post]
 type processOrderRequest struct {
-	OrderID int    `json:"id"`
-	UserID  int    `json:"user_id"`
-	Action  string `json:"action"`
+	OrderID    int    `json:"id"`
+	UserID     int    `json:"user_id"`
+	Action     string `json:"action"`
+	PickupCode string `json:"pickup_code"`
 }
 
 // UpdateOrder updates the orders based on the provided request data
+// @Security BearerAuth
 // @Security BasicAuth
 // @Summary Process orders
 // @Description Processes the given orders based on the action and order IDs provided
@@ -31,6 +36,8 @@ type processOrderRequest struct {
 // @Param request body processOrderRequest true "Process Orders Request"
 // @Success 200 {object} processOrderRequest
 // @Failure 400 {string} string "Invalid request"
+// @Failure 403 {string} string "Wrong or missing pickup code"
+// @Failure 429 {string} string "Too many failed pickup code attempts"
 // @Failure 500 {string} string "Internal server error"
 // @Router /orders/process [post]
 func (h *Handler) UpdateOrder(ctx context.Context, w http.ResponseWriter, r *http.Request) {
@@ -49,8 +56,18 @@ func (h *Handler) UpdateOrder(ctx context.Context, w http.ResponseWriter, r *htt
 		return
 	}
 
-	err = h.OrderService.ProcessOrder(ctx, processRequest.UserID, processRequest.OrderID, processRequest.Action)
-	if err != nil {
+	err = h.OrderService.ProcessOrder(ctx, processRequest.UserID, processRequest.OrderID, processRequest.Action,
+		processRequest.PickupCode)
+	switch {
+	case errors.Is(err, order_service.ErrWrongPickupCode), errors.Is(err, order_service.ErrNoPickupCode):
+		http.Error(w, err.Error(), http.StatusForbidden)
+
+		return
+	case errors.Is(err, order_service.ErrPickupCodeLocked):
+		http.Error(w, err.Error(), http.StatusTooManyRequests)
+
+		return
+	case err != nil:
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 
 		return
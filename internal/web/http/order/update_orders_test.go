@@ -9,6 +9,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 )
 
 func TestHandler_UpdateOrders(t *testing.T) {
@@ -28,12 +30,55 @@ func TestHandler_UpdateOrders(t *testing.T) {
                 "action": "return"
             }`,
 			mockSetup: func(mockOrderService *MockorderService) {
-				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "return").
+				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "return", "").
+					Return(nil).Times(1)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   `success`,
+		},
+		{
+			name: "Valid give with pickup code",
+			requestBody: `{
+                "user_id": 1,
+                "id": 123,
+                "action": "give",
+                "pickup_code": "042137"
+            }`,
+			mockSetup: func(mockOrderService *MockorderService) {
+				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "give", "042137").
 					Return(nil).Times(1)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody:   `success`,
 		},
+		{
+			name: "Wrong pickup code",
+			requestBody: `{
+                "user_id": 1,
+                "id": 123,
+                "action": "give",
+                "pickup_code": "000000"
+            }`,
+			mockSetup: func(mockOrderService *MockorderService) {
+				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "give", "000000").
+					Return(order.ErrWrongPickupCode).Times(1)
+			},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name: "Pickup code locked",
+			requestBody: `{
+                "user_id": 1,
+                "id": 123,
+                "action": "give",
+                "pickup_code": "000000"
+            }`,
+			mockSetup: func(mockOrderService *MockorderService) {
+				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "give", "000000").
+					Return(order.ErrPickupCodeLocked).Times(1)
+			},
+			expectedStatus: http.StatusTooManyRequests,
+		},
 		{
 			name:           "Invalid JSON",
 			requestBody:    `{"user_id": 1, "id": 123, "action": "return"`,
@@ -54,7 +99,7 @@ func TestHandler_UpdateOrders(t *testing.T) {
                 "action": "buy"
             }`,
 			mockSetup: func(mockOrderService *MockorderService) {
-				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "buy").
+				mockOrderService.EXPECT().ProcessOrder(gomock.Any(), 1, 123, "buy", "").
 					Return(errors.New("undefined action")).Times(1)
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -13,14 +14,24 @@ import (
 	"go.uber.org/zap"
 
 	admin_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/admin"
+	audit_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/audit"
+	auth_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/auth"
+	client_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/client"
 	order_handler "gitlab.ozon.dev/alexplay1224/homework/internal/web/http/order"
 
 	_ "gitlab.ozon.dev/alexplay1224/homework/docs" // docs needed for swagger
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/currency"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/password"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/query"
 	admin_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/admin"
+	apikey_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/apikey"
 	audit_logger_storage "gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+	auth_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/auth"
+	client_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/client"
+	logs_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/logs"
 	order_service "gitlab.ozon.dev/alexplay1224/homework/internal/service/order"
 )
 
@@ -42,6 +53,64 @@ type adminStorage interface {
 	DeleteAdmin(context.Context, string) error
 	ContainsUsername(context.Context, string) (bool, error)
 	ContainsID(context.Context, int) (bool, error)
+	GetPasswordHistory(context.Context, int, int) ([]string, error)
+	AddPasswordHistory(context.Context, int, string, time.Time) error
+	ListAdmins(context.Context, []query.Cond, int, int) ([]models.Admin, error)
+	SetAdminDeactivatedAt(context.Context, string, *time.Time) error
+}
+
+type clientStorage interface {
+	CreateClient(context.Context, models.Client) (int, error)
+	GetClientByPhone(context.Context, string) (models.Client, error)
+	GetClientByID(context.Context, pgx.Tx, int) (models.Client, error)
+	ContainsPhone(context.Context, string) (bool, error)
+	ContainsClientID(context.Context, pgx.Tx, int) (bool, error)
+}
+
+type pickupCodeStorage interface {
+	SetPickupCode(context.Context, pgx.Tx, models.PickupCode) error
+	GetPickupCode(context.Context, pgx.Tx, int) (models.PickupCode, error)
+	ContainsPickupCode(context.Context, pgx.Tx, int) (bool, error)
+	RegisterFailedAttempt(context.Context, pgx.Tx, int, int, time.Time) error
+	DeletePickupCode(context.Context, pgx.Tx, int) error
+}
+
+type notificationStorage interface {
+	CreateNotification(context.Context, pgx.Tx, models.Notification) error
+}
+
+type webhookStorage interface {
+	EnqueueEvent(context.Context, pgx.Tx, models.WebhookEvent, int, []byte) error
+}
+
+type orderEventStorage interface {
+	CreateOrderEvent(context.Context, pgx.Tx, models.OrderEvent) error
+}
+
+type loginAttemptStorage interface {
+	GetLoginAttempts(context.Context, string, string) ([]models.LoginAttempt, error)
+	RegisterFailedLogin(context.Context, string, string, time.Time, time.Time) (int, error)
+	LockLogin(context.Context, string, string, time.Time) error
+	ResetLoginAttempts(context.Context, string, string) error
+}
+
+type tokenStorage interface {
+	CreateRefreshToken(context.Context, pgx.Tx, models.RefreshToken) error
+	GetRefreshToken(context.Context, pgx.Tx, string) (models.RefreshToken, error)
+	ContainsRefreshToken(context.Context, pgx.Tx, string) (bool, error)
+	RevokeRefreshToken(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAdminRefreshTokens(context.Context, pgx.Tx, int, time.Time) error
+	RevokeAccessToken(context.Context, string, time.Time) error
+	IsAccessTokenRevoked(context.Context, string) (bool, error)
+}
+
+type apiKeyStorage interface {
+	CreateAPIKey(context.Context, models.APIKey) (int, error)
+	GetAPIKeys(context.Context) ([]models.APIKey, error)
+	GetAPIKey(context.Context, string) (models.APIKey, error)
+	ContainsAPIKey(context.Context, string) (bool, error)
+	RevokeAPIKey(context.Context, string, time.Time) error
+	TouchAPIKey(context.Context, int, time.Time) error
 }
 
 type txManager interface {
@@ -52,100 +121,233 @@ type txManager interface {
 
 type auditLoggerStorage interface {
 	GetAndMarkLogs(context.Context, int) ([]models.Log, error)
-	UpdateLog(context.Context, int, int, int) error
+	UpdateLog(context.Context, int, int, int, time.Time) error
 	CreateLog(context.Context, []models.Log) error
+	ListLogs(context.Context, []query.Cond, int, int) ([]models.Log, error)
+	GetLogsAfter(context.Context, int, int) ([]models.Log, error)
+	RequeueDeadLogs(context.Context, []int) (int, error)
 }
 
+// shutdownTimeout limits waiting for requests in flight and for audit logs to be written on shutdown
+const shutdownTimeout = 10 * time.Second
+
 // App is a structure for an app
 type App struct {
 	orderService       order_service.Service
 	adminService       admin_service.Service
-	auditLoggerService audit_logger_storage.Service
+	authService        auth_service.Service
+	clientService      client_service.Service
+	apiKeyService      apikey_service.Service
+	logService         logs_service.Service
+	auditLoggerService *audit_logger_storage.Service
 	Router             *mux.Router
+	basicAuthEnabled   bool
 }
 
-// NewApp creates an instance of an App
+// NewApp creates an instance of an App, audit logs are sent to Kafka by broker unless it's nil
 func NewApp(ctx context.Context, cfg config.Config, logger *zap.Logger, orders orderStorage, admins adminStorage,
-	logs auditLoggerStorage, txManager txManager, workerCount int, batchSize int, timeout time.Duration) (*App, error) {
-	kafkaLogger, err := audit_logger_storage.NewService(ctx, cfg, logs, workerCount, batchSize, timeout)
+	clients clientStorage, codes pickupCodeStorage, notifications notificationStorage, webhooks webhookStorage,
+	events orderEventStorage, tokens tokenStorage, attempts loginAttemptStorage, apiKeys apiKeyStorage,
+	logs auditLoggerStorage, broker kafka.Broker, txManager txManager, workerCount int, batchSize int,
+	timeout time.Duration) (*App, error) {
+	kafkaLogger, err := audit_logger_storage.NewService(ctx, cfg, logs, broker, workerCount, batchSize, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	converter, err := currency.NewConverter(cfg.BaseCurrency(), cfg.CurrencyRates())
 	if err != nil {
 		return nil, err
 	}
 
+	breached, err := password.LoadBreached(cfg.BreachedPasswordsFile())
+	if err != nil {
+		return nil, err
+	}
+	policy := password.NewPolicy(cfg.PasswordMinLength(), cfg.PasswordMaxAge(), cfg.PasswordHistory(), breached)
+
+	orderService := order_service.NewService(logger, orders, clients, codes, notifications, webhooks, events,
+		txManager, converter)
+	adminService := admin_service.NewService(logger, admins, policy)
+	authService := auth_service.NewService(logger, admins, tokens, attempts, logs, adminService, policy, txManager,
+		cfg.JWTSecret(), cfg.AccessTokenTTL(), cfg.RefreshTokenTTL())
+
 	return &App{
-		orderService:       *order_service.NewService(logger, orders, txManager),
-		adminService:       *admin_service.NewService(logger, admins),
-		auditLoggerService: *kafkaLogger,
+		orderService:       *orderService,
+		adminService:       *adminService,
+		authService:        *authService,
+		clientService:      *client_service.NewService(logger, clients),
+		apiKeyService:      *apikey_service.NewService(logger, apiKeys),
+		logService:         *logs_service.NewService(logger, logs),
+		auditLoggerService: kafkaLogger,
 		Router:             mux.NewRouter(),
+		basicAuthEnabled:   cfg.BasicAuthEnabled(),
 	}, nil
 }
 
 // SetupRoutes setups all the routing
 func (a *App) SetupRoutes(ctx context.Context) {
 	impl := server{
-		orders: *order_handler.NewHandler(&a.orderService),
-		admins: *admin_handler.NewHandler(&a.adminService),
+		orders:  *order_handler.NewHandler(&a.orderService),
+		admins:  *admin_handler.NewHandler(&a.adminService),
+		clients: *client_handler.NewHandler(&a.clientService),
+		auth:    *auth_handler.NewHandler(&a.authService),
+		logs:    *audit_handler.NewHandler(&a.logService),
 	}
 	logger := AuditLoggerMiddleware{
-		adminService:       a.adminService,
 		auditLoggerService: a.auditLoggerService,
 	}
 	a.Router.Use(FieldLogger)
 
 	authMiddleware := AuthMiddleware{
-		adminService: a.adminService,
+		authService:      a.authService,
+		adminService:     a.adminService,
+		apiKeyService:    a.apiKeyService,
+		basicAuthEnabled: a.basicAuthEnabled,
 	}
 
+	a.Router.HandleFunc("/auth/login", a.wrapHandler(ctx, impl.auth.Login)).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc("/auth/refresh", a.wrapHandler(ctx, impl.auth.Refresh)).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc("/auth/logout", a.wrapHandler(ctx, impl.auth.Logout)).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc("/auth/password", a.wrapHandler(ctx, impl.auth.ChangePassword)).
+		Methods(http.MethodPost)
+
 	a.Router.HandleFunc("/orders",
-		authMiddleware.BasicAuthChecker(ctx,
-			logger.AuditLogger(ctx,
-				a.wrapHandler(ctx, impl.orders.CreateOrder))).ServeHTTP).
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.AcceptOrdersPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.orders.CreateOrder)))).ServeHTTP).
 		Methods(http.MethodPost)
 
 	a.Router.HandleFunc("/orders",
-		authMiddleware.BasicAuthChecker(ctx,
-			a.wrapHandler(ctx, impl.orders.GetOrders)).ServeHTTP).
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ReadOrdersPermission,
+				a.wrapHandler(ctx, impl.orders.GetOrders))).ServeHTTP).
 		Methods(http.MethodGet)
 
 	a.Router.HandleFunc(fmt.Sprintf("/orders/{%s:[0-9]+}", order_handler.OrderIDParam),
-		authMiddleware.BasicAuthChecker(ctx,
-			logger.AuditLogger(ctx,
-				a.wrapHandler(ctx, impl.orders.DeleteOrder))).ServeHTTP).
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.DeleteOrdersPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.orders.DeleteOrder)))).ServeHTTP).
 		Methods(http.MethodDelete)
 
+	a.Router.HandleFunc(fmt.Sprintf("/orders/{%s:[0-9]+}/code", order_handler.OrderIDParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.WriteOrdersPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.orders.RegenerateCode)))).ServeHTTP).
+		Methods(http.MethodPost)
+
 	a.Router.HandleFunc("/orders/process",
-		authMiddleware.BasicAuthChecker(ctx,
-			logger.AuditLogger(ctx,
-				a.wrapHandler(ctx, impl.orders.UpdateOrder))).ServeHTTP).
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.WriteOrdersPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.orders.UpdateOrder)))).ServeHTTP).
 		Methods(http.MethodPost)
 
-	a.Router.HandleFunc("/admins", a.wrapHandler(ctx, impl.admins.CreateAdmin)).
+	a.Router.HandleFunc("/clients",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageClientsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.clients.CreateClient)))).ServeHTTP).
 		Methods(http.MethodPost)
 
-	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}",
-		admin_handler.AdminUsernameParam), a.wrapHandler(ctx, impl.admins.UpdateAdmin)).
+	a.Router.HandleFunc("/clients",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageClientsPermission,
+				a.wrapHandler(ctx, impl.clients.GetClientByPhone))).ServeHTTP).
+		Methods(http.MethodGet)
+
+	a.Router.HandleFunc("/admins",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.admins.CreateAdmin)))).ServeHTTP).
 		Methods(http.MethodPost)
 
-	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}",
-		admin_handler.AdminUsernameParam), a.wrapHandler(ctx, impl.admins.DeleteAdmin)).
+	a.Router.HandleFunc("/admins",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				a.wrapHandler(ctx, impl.admins.ListAdmins))).ServeHTTP).
+		Methods(http.MethodGet)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				a.wrapHandler(ctx, impl.admins.GetAdmin))).ServeHTTP).
+		Methods(http.MethodGet)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequireSelfOrPermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.admins.UpdateAdmin)))).ServeHTTP).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.admins.DeleteAdmin)))).ServeHTTP).
 		Methods(http.MethodDelete)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}/unlock", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.auth.Unlock)))).ServeHTTP).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}/deactivate", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.admins.DeactivateAdmin)))).ServeHTTP).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc(fmt.Sprintf("/admins/{%s:[a-zA-Z0-9]+}/reactivate", admin_handler.AdminUsernameParam),
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ManageAdminsPermission,
+				logger.AuditLogger(a.wrapHandler(ctx, impl.admins.ReactivateAdmin)))).ServeHTTP).
+		Methods(http.MethodPost)
+
+	a.Router.HandleFunc("/logs",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ReadLogsPermission,
+				a.wrapHandler(ctx, impl.logs.ListLogs))).ServeHTTP).
+		Methods(http.MethodGet)
+
+	a.Router.HandleFunc("/logs/verify",
+		authMiddleware.Authenticate(ctx,
+			RequirePermission(models.ReadLogsPermission,
+				a.wrapHandler(ctx, impl.logs.VerifyAuditChain))).ServeHTTP).
+		Methods(http.MethodGet)
 }
 
 func (a *App) wrapHandler(ctx context.Context, handler func(context.Context, http.ResponseWriter,
 	*http.Request)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		handler(ctx, w, r)
+		// handlers get app context, changes they make are recorded for audit log through request context
+		handler(audit_logger_storage.CopyDiffRecorder(ctx, r.Context()), w, r)
 	}
 }
 
 type server struct {
-	orders order_handler.Handler
-	admins admin_handler.Handler
+	orders  order_handler.Handler
+	admins  admin_handler.Handler
+	clients client_handler.Handler
+	auth    auth_handler.Handler
+	logs    audit_handler.Handler
 }
 
 // @securityDefinitions.basic BasicAuth
 
-// Run runs the app
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+
+// Run runs the app until ctx is done, then finishes requests in flight and drains audit logger
 // @title			PVZ API Documentation
 // @version		1.0
 // @description	This is a sample server for Swagger in Go.
@@ -156,9 +358,35 @@ func (a *App) Run(ctx context.Context) error {
 
 	// Путь для отображения Swagger UI
 	a.Router.PathPrefix("/swagger/").Handler(http_swagger.WrapHandler)
-	if err := http.ListenAndServe("localhost:9000", a.Router); err != nil {
-		log.Fatal(err)
+	server := &http.Server{
+		Addr:    "localhost:9000",
+		Handler: a.Router,
 	}
 
-	return nil
+	errCh := make(chan error, 1)
+	go func() {
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		// requests in flight are finished first, so their audit logs are queued before audit logger is drained
+		serverCtx, serverCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer serverCancel()
+		if err := server.Shutdown(serverCtx); err != nil {
+			log.Printf("Failed to shut down http server: %v", err)
+		}
+
+		auditCtx, auditCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer auditCancel()
+		if err := a.auditLoggerService.Shutdown(auditCtx); err != nil {
+			log.Printf("Failed to drain audit logger: %v", err)
+		}
+
+		return nil
+	case err := <-errCh:
+		return err
+	}
 }
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -27,15 +29,31 @@ type request struct {
 	body   []byte
 }
 
+// orderEvent matches order event of such type about an order with such id
+func orderEvent(orderID int, eventType models.OrderEventType) gomock.Matcher {
+	return gomock.Cond(func(event models.OrderEvent) bool {
+		return event.OrderID == orderID && event.Type == eventType
+	})
+}
+
 func TestApp_Run(t *testing.T) {
 	t.Parallel()
 
 	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	pickupCode, code, err := models.NewPickupCode(4)
+	require.NoError(t, err)
+	operator := models.Admin{ID: 0, Username: "user", Password: string(password), Role: models.OperatorRole,
+		PasswordChangedAt: time.Now()}
+	supervisor := models.Admin{ID: 0, Username: "user", Password: string(password), Role: models.SupervisorRole,
+		PasswordChangedAt: time.Now()}
+	superadmin := models.Admin{ID: 0, Username: "user", Password: string(password), Role: models.SuperadminRole,
+		PasswordChangedAt: time.Now()}
 	tests := []struct {
-		name         string
-		args         request
-		authorized   bool
-		mockSetup    func(MockorderStorage, MockadminStorage, MockauditLoggerStorage, MocktxManager)
+		name       string
+		args       request
+		authorized bool
+		mockSetup  func(MockorderStorage, MockadminStorage, MockclientStorage, MockpickupCodeStorage,
+			MocknotificationStorage, MockwebhookStorage, MockorderEventStorage, MockauditLoggerStorage, MocktxManager)
 		expectedCode int
 	}{
 		{
@@ -46,9 +64,10 @@ func TestApp_Run(t *testing.T) {
 			},
 			authorized: true,
 			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
-				_ MockauditLoggerStorage, _ MocktxManager) {
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
 				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
+					Return(operator, nil)
 				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
 				mockOrderStorage.EXPECT().GetOrders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return([]models.Order{}, nil)
@@ -63,7 +82,8 @@ func TestApp_Run(t *testing.T) {
 			},
 			authorized: true,
 			mockSetup: func(_ MockorderStorage, _ MockadminStorage,
-				_ MockauditLoggerStorage, _ MocktxManager) {
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
 			},
 			expectedCode: http.StatusNotFound,
 		},
@@ -75,7 +95,8 @@ func TestApp_Run(t *testing.T) {
 			},
 			authorized: false,
 			mockSetup: func(_ MockorderStorage, _ MockadminStorage,
-				_ MockauditLoggerStorage, _ MocktxManager) {
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
 			},
 			expectedCode: http.StatusUnauthorized,
 		},
@@ -89,12 +110,10 @@ func TestApp_Run(t *testing.T) {
 			},
 			authorized: true,
 			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
-				_ MockauditLoggerStorage, tx MocktxManager) {
-				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
+				clients MockclientStorage, codes MockpickupCodeStorage, outbox MocknotificationStorage, webhooks MockwebhookStorage,
+				events MockorderEventStorage, _ MockauditLoggerStorage, tx MocktxManager) {
 				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
-				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+					Return(operator, nil)
 				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
 				tx.EXPECT().RunRepeatableRead(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
@@ -102,6 +121,13 @@ func TestApp_Run(t *testing.T) {
 					}).Return(nil)
 				mockOrderStorage.EXPECT().AddOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 				mockOrderStorage.EXPECT().Contains(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+				clients.EXPECT().ContainsClientID(gomock.Any(), gomock.Any(), 52).Return(true, nil)
+				codes.EXPECT().SetPickupCode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				outbox.EXPECT().CreateNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), orderEvent(111111111111, models.OrderAccepted)).
+					Return(nil)
 			},
 			expectedCode: http.StatusOK,
 		},
@@ -114,7 +140,9 @@ func TestApp_Run(t *testing.T) {
 								"packaging":2,"extra_packaging":3,"expiry_date":"2025-03-10T00:00:00Z"}`),
 			},
 			authorized: true,
-			mockSetup: func(_ MockorderStorage, _ MockadminStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+			mockSetup: func(_ MockorderStorage, _ MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
 			},
 			expectedCode: http.StatusNotFound,
 		},
@@ -126,20 +154,26 @@ func TestApp_Run(t *testing.T) {
 			},
 			authorized: true,
 			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
-				_ MockauditLoggerStorage, mocktxManager MocktxManager) {
-				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, webhooks MockwebhookStorage,
+				events MockorderEventStorage, _ MockauditLoggerStorage, mocktxManager MocktxManager) {
 				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
-				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+					Return(supervisor, nil)
 				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
 				mocktxManager.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
 						return f(ctx, nil)
 					})
-				mockOrderStorage.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Order{}, nil)
+				mockOrderStorage.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Order{ID: 123}, nil)
 				mockOrderStorage.EXPECT().Contains(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
 				mockOrderStorage.EXPECT().RemoveOrder(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), models.OrderDeletedEvent, 123, gomock.Any()).
+					Return(nil)
+				gomock.InOrder(
+					events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), orderEvent(123, models.OrderReturnedToCourier)).
+						Return(nil),
+					events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), orderEvent(123, models.OrderDeleted)).
+						Return(nil),
+				)
 			},
 			expectedCode: http.StatusOK,
 		},
@@ -148,16 +182,14 @@ func TestApp_Run(t *testing.T) {
 			args: request{
 				method: http.MethodPost,
 				path:   "/orders/process",
-				body:   []byte(`{"id":4,"user_id":789,"action":"give"}`),
+				body:   []byte(`{"id":4,"user_id":789,"action":"give","pickup_code":"` + code + `"}`),
 			},
 			authorized: true,
 			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
-				_ MockauditLoggerStorage, tx MocktxManager) {
-				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
+				_ MockclientStorage, codes MockpickupCodeStorage, outbox MocknotificationStorage, webhooks MockwebhookStorage,
+				events MockorderEventStorage, _ MockauditLoggerStorage, tx MocktxManager) {
 				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "user", Password: string(password)}, nil)
-				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+					Return(operator, nil)
 				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
 				tx.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).Return(nil).
 					DoAndReturn(func(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
@@ -168,24 +200,174 @@ func TestApp_Run(t *testing.T) {
 				mockOrderStorage.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Order{
 					ID: 4, UserID: 789, Weight: 1233, Price: *money.New(22222, money.RUB),
 					Status: 1, ExpiryDate: time.Now().Add(time.Hour)}, nil)
+				codes.EXPECT().ContainsPickupCode(gomock.Any(), gomock.Any(), 4).Return(true, nil)
+				codes.EXPECT().GetPickupCode(gomock.Any(), gomock.Any(), 4).Return(*pickupCode, nil)
+				codes.EXPECT().DeletePickupCode(gomock.Any(), gomock.Any(), 4).Return(nil)
+				outbox.EXPECT().CreateNotification(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+				webhooks.EXPECT().EnqueueEvent(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil)
+				events.EXPECT().CreateOrderEvent(gomock.Any(), gomock.Any(), orderEvent(4, models.OrderGiven)).Return(nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "valid get clients",
+			args: request{
+				method: http.MethodGet,
+				path:   "/clients?phone=89991234567",
+			},
+			authorized: true,
+			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
+				clients MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
+					Return(operator, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+				clients.EXPECT().ContainsPhone(gomock.Any(), "+79991234567").Return(true, nil)
+				clients.EXPECT().GetClientByPhone(gomock.Any(), "+79991234567").
+					Return(models.Client{ID: 52, Phone: "+79991234567"}, nil)
+			},
+			expectedCode: http.StatusOK,
+		},
+		{
+			name: "wrong pickup code",
+			args: request{
+				method: http.MethodPost,
+				path:   "/orders/process",
+				body:   []byte(`{"id":4,"user_id":789,"action":"give","pickup_code":"not-a-code"}`),
+			},
+			authorized: true,
+			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, codes MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, tx MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
+					Return(operator, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+				tx.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
+						return f(ctx, nil)
+					})
+				mockOrderStorage.EXPECT().Contains(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+				mockOrderStorage.EXPECT().GetByID(gomock.Any(), gomock.Any(), gomock.Any()).Return(models.Order{
+					ID: 4, UserID: 789, Weight: 1233, Price: *money.New(22222, money.RUB),
+					Status: 1, ExpiryDate: time.Now().Add(time.Hour)}, nil)
+				codes.EXPECT().ContainsPickupCode(gomock.Any(), gomock.Any(), 4).Return(true, nil)
+				codes.EXPECT().GetPickupCode(gomock.Any(), gomock.Any(), 4).Return(*pickupCode, nil)
+				codes.EXPECT().RegisterFailedAttempt(gomock.Any(), nil, 4, gomock.Any(), gomock.Any()).Return(nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "valid regenerate pickup code",
+			args: request{
+				method: http.MethodPost,
+				path:   "/orders/4/code",
+			},
+			authorized: true,
+			mockSetup: func(mockOrderStorage MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, codes MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, tx MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
+					Return(operator, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
+				tx.EXPECT().RunSerializable(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
+						return f(ctx, nil)
+					})
+				mockOrderStorage.EXPECT().Contains(gomock.Any(), gomock.Any(), 4).Return(true, nil)
+				mockOrderStorage.EXPECT().GetByID(gomock.Any(), gomock.Any(), 4).Return(models.Order{
+					ID: 4, UserID: 789, Status: models.StoredOrder}, nil)
+				codes.EXPECT().SetPickupCode(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
 			},
 			expectedCode: http.StatusOK,
 		},
+		{
+			name: "operator delete orders",
+			args: request{
+				method: http.MethodDelete,
+				path:   "/orders/123",
+			},
+			authorized: true,
+			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
 		{
 			name: "valid post admins",
 			args: request{
 				method: http.MethodPost,
 				path:   "/admins",
-				body:   []byte(`{"id":52,"username":"sdasds","password":"give"}`),
+				body:   []byte(`{"id":52,"username":"sdasds","password":"correct horse","role":"supervisor"}`),
 			},
-			authorized: false,
-			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage, _ MockauditLoggerStorage, _ MocktxManager) {
-				mockAdminStorage.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).Return(nil)
+			authorized: true,
+			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(superadmin, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				mockAdminStorage.EXPECT().CreateAdmin(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, admin models.Admin) error {
+						require.Equal(t, models.SupervisorRole, admin.Role)
+
+						return nil
+					})
 				mockAdminStorage.EXPECT().ContainsID(gomock.Any(), gomock.Any()).Return(false, nil)
-				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(false, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "sdasds").Return(false, nil)
 			},
 			expectedCode: http.StatusOK,
 		},
+		{
+			name: "weak password post admins",
+			args: request{
+				method: http.MethodPost,
+				path:   "/admins",
+				body:   []byte(`{"id":52,"username":"sdasds","password":"short"}`),
+			},
+			authorized: true,
+			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(superadmin, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+				mockAdminStorage.EXPECT().ContainsID(gomock.Any(), gomock.Any()).Return(false, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "sdasds").Return(false, nil)
+			},
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "not authorised post admins",
+			args: request{
+				method: http.MethodPost,
+				path:   "/admins",
+				body:   []byte(`{"id":52,"username":"sdasds","password":"correct horse"}`),
+			},
+			authorized: false,
+			mockSetup: func(_ MockorderStorage, _ MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name: "operator post admins",
+			args: request{
+				method: http.MethodPost,
+				path:   "/admins",
+				body:   []byte(`{"id":52,"username":"sdasds","password":"correct horse"}`),
+			},
+			authorized: true,
+			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(operator, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+			},
+			expectedCode: http.StatusForbidden,
+		},
 		{
 			name: "valid delete admins",
 			args: request{
@@ -193,13 +375,16 @@ func TestApp_Run(t *testing.T) {
 				path:   "/admins/asdasd",
 				body:   []byte(`{"password":"password"}`),
 			},
-			authorized: false,
+			authorized: true,
 			mockSetup: func(_ MockorderStorage, mockAdminStorage MockadminStorage,
-				_ MockauditLoggerStorage, _ MocktxManager) {
+				_ MockclientStorage, _ MockpickupCodeStorage, _ MocknotificationStorage, _ MockwebhookStorage,
+				_ MockorderEventStorage, _ MockauditLoggerStorage, _ MocktxManager) {
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(superadmin, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
 				mockAdminStorage.EXPECT().DeleteAdmin(gomock.Any(), gomock.Any()).Return(nil)
-				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), gomock.Any()).Return(true, nil)
-				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), gomock.Any()).
-					Return(models.Admin{ID: 0, Username: "asdasd", Password: string(password)}, nil)
+				mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "asdasd").Return(true, nil)
+				mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "asdasd").
+					Return(models.Admin{ID: 1, Username: "asdasd", Password: string(password)}, nil)
 			},
 			expectedCode: http.StatusOK,
 		},
@@ -220,12 +405,25 @@ func TestApp_Run(t *testing.T) {
 
 			mockOrderStorage := NewMockorderStorage(ctrl)
 			mockAdminStorage := NewMockadminStorage(ctrl)
+			mockClientStorage := NewMockclientStorage(ctrl)
+			mockPickupCodeStorage := NewMockpickupCodeStorage(ctrl)
+			mockNotificationStorage := NewMocknotificationStorage(ctrl)
+			mockWebhookStorage := NewMockwebhookStorage(ctrl)
+			mockOrderEventStorage := NewMockorderEventStorage(ctrl)
 			mockLogStorage := NewMockauditLoggerStorage(ctrl)
-			app, _ := NewApp(context.Background(), config.Config{}, logger, mockOrderStorage, mockAdminStorage, mockLogStorage,
-				mockTxManager, 2, 5, 500*time.Millisecond)
+			// audit logs are flushed by background workers on timeout, so they may come at any moment
+			mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockLoginAttemptStorage := NewMockloginAttemptStorage(ctrl)
+			mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "user", gomock.Any()).
+				Return(nil, nil).AnyTimes()
+			app, _ := NewApp(context.Background(), config.Config{}, logger, mockOrderStorage, mockAdminStorage,
+				mockClientStorage, mockPickupCodeStorage, mockNotificationStorage, mockWebhookStorage,
+				mockOrderEventStorage, NewMocktokenStorage(ctrl), mockLoginAttemptStorage,
+				NewMockapiKeyStorage(ctrl), mockLogStorage, nil, mockTxManager, 2, 5, 500*time.Millisecond)
 			app.SetupRoutes(context.Background())
 
-			tt.mockSetup(*mockOrderStorage, *mockAdminStorage, *mockLogStorage, *mockTxManager)
+			tt.mockSetup(*mockOrderStorage, *mockAdminStorage, *mockClientStorage, *mockPickupCodeStorage,
+				*mockNotificationStorage, *mockWebhookStorage, *mockOrderEventStorage, *mockLogStorage, *mockTxManager)
 
 			var authHeader string
 			req, err := http.NewRequestWithContext(context.Background(), tt.args.method, tt.args.path,
@@ -246,3 +444,382 @@ func TestApp_Run(t *testing.T) {
 		})
 	}
 }
+
+func TestApp_TokenAuth(t *testing.T) {
+	t.Parallel()
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	ctrl := gomock.NewController(t)
+
+	mockOrderStorage := NewMockorderStorage(ctrl)
+	mockAdminStorage := NewMockadminStorage(ctrl)
+	mockTokenStorage := NewMocktokenStorage(ctrl)
+	mockLoginAttemptStorage := NewMockloginAttemptStorage(ctrl)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), mockOrderStorage, mockAdminStorage,
+		NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl), NewMocknotificationStorage(ctrl),
+		NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl), mockTokenStorage, mockLoginAttemptStorage,
+		NewMockapiKeyStorage(ctrl), mockLogStorage, nil, NewMocktxManager(ctrl), 2, 5, 500*time.Millisecond)
+	require.NoError(t, err)
+	app.SetupRoutes(context.Background())
+
+	// admin is looked up on login and on every request with access token that isn't revoked
+	admin := models.Admin{ID: 1, Username: "user", Password: string(password), Role: models.OperatorRole,
+		PasswordChangedAt: time.Now()}
+	mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "user", gomock.Any()).Return(nil, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil).Times(3)
+	mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(admin, nil).Times(3)
+	mockTokenStorage.EXPECT().CreateRefreshToken(gomock.Any(), gomock.Nil(), gomock.Any()).Return(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/auth/login",
+		bytes.NewReader([]byte(`{"username":"user","password":"password"}`)))
+	res := httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	var tokens models.TokenPair
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &tokens))
+
+	mockTokenStorage.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockOrderStorage.EXPECT().GetOrders(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]models.Order{}, nil)
+
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+
+	mockTokenStorage.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	req = httptest.NewRequest(http.MethodDelete, "/orders/123", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+
+	mockTokenStorage.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(true, nil)
+
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken+"x")
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+
+	// token of an admin deactivated after login isn't accepted anymore
+	deactivatedAt := time.Now()
+	deactivated := admin
+	deactivated.DeactivatedAt = &deactivatedAt
+	mockTokenStorage.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+	mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(deactivated, nil)
+
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+
+	// token of a deleted admin isn't accepted either
+	mockTokenStorage.EXPECT().IsAccessTokenRevoked(gomock.Any(), gomock.Any()).Return(false, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(false, nil)
+
+	req = httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("Authorization", "Bearer "+tokens.AccessToken)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func TestApp_LoginLockout(t *testing.T) {
+	t.Parallel()
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	ctrl := gomock.NewController(t)
+
+	mockAdminStorage := NewMockadminStorage(ctrl)
+	mockLoginAttemptStorage := NewMockloginAttemptStorage(ctrl)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), NewMockorderStorage(ctrl),
+		mockAdminStorage, NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl),
+		NewMocknotificationStorage(ctrl), NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl),
+		NewMocktokenStorage(ctrl), mockLoginAttemptStorage, NewMockapiKeyStorage(ctrl), mockLogStorage, nil,
+		NewMocktxManager(ctrl), 2, 5, 500*time.Millisecond)
+	require.NoError(t, err)
+	app.SetupRoutes(context.Background())
+
+	// locked username is rejected before its password is checked, even if it's correct
+	lockedUntil := time.Now().Add(time.Minute)
+	mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "user", "192.0.2.1").
+		Return([]models.LoginAttempt{{Kind: models.UsernameLoginAttempt, Key: "user", LockedUntil: &lockedUntil}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.SetBasicAuth("user", "password")
+	res := httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusTooManyRequests, res.Code)
+
+	superadmin := models.Admin{ID: 2, Username: "root", Password: string(password), Role: models.SuperadminRole,
+		PasswordChangedAt: time.Now()}
+	mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "root", "192.0.2.1").Return(nil, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "root").Return(true, nil)
+	mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "root").Return(superadmin, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil)
+	mockLoginAttemptStorage.EXPECT().ResetLoginAttempts(gomock.Any(), models.UsernameLoginAttempt, "user").
+		Return(nil)
+
+	req = httptest.NewRequest(http.MethodPost, "/admins/user/unlock", nil)
+	req.SetBasicAuth("root", "password")
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestApp_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	ctrl := gomock.NewController(t)
+
+	mockAdminStorage := NewMockadminStorage(ctrl)
+	mockLoginAttemptStorage := NewMockloginAttemptStorage(ctrl)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), NewMockorderStorage(ctrl),
+		mockAdminStorage, NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl),
+		NewMocknotificationStorage(ctrl), NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl),
+		NewMocktokenStorage(ctrl), mockLoginAttemptStorage, NewMockapiKeyStorage(ctrl), mockLogStorage, nil,
+		NewMocktxManager(ctrl), 2, 5, 500*time.Millisecond)
+	require.NoError(t, err)
+	app.SetupRoutes(context.Background())
+
+	changedAt := time.Now().Add(-100 * 24 * time.Hour)
+	expired := models.Admin{ID: 1, Username: "user", Password: string(password), Role: models.OperatorRole,
+		PasswordChangedAt: changedAt}
+	mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "user", gomock.Any()).Return(nil, nil).Times(3)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "user").Return(true, nil).Times(3)
+	mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "user").Return(expired, nil).Times(3)
+
+	// expired password works only for changing it
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.SetBasicAuth("user", "password")
+	res := httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+
+	// current password can't be reused
+	mockAdminStorage.EXPECT().GetPasswordHistory(gomock.Any(), 1, gomock.Any()).Return(nil, nil).Times(2)
+	req = httptest.NewRequest(http.MethodPost, "/auth/password",
+		bytes.NewReader([]byte(`{"username":"user","password":"password","new_password":"password"}`)))
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+
+	mockAdminStorage.EXPECT().AddPasswordHistory(gomock.Any(), 1, string(password), changedAt).Return(nil)
+	mockAdminStorage.EXPECT().UpdateAdmin(gomock.Any(), 1, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ int, admin models.Admin) error {
+			require.True(t, admin.CheckPassword("correct horse"))
+			require.WithinDuration(t, time.Now(), admin.PasswordChangedAt, time.Second)
+
+			return nil
+		})
+
+	req = httptest.NewRequest(http.MethodPost, "/auth/password",
+		bytes.NewReader([]byte(`{"username":"user","password":"password","new_password":"correct horse"}`)))
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusOK, res.Code)
+}
+
+func TestApp_APIKey(t *testing.T) {
+	t.Parallel()
+
+	key, apiKey, err := models.NewAPIKey("courier", []models.Permission{models.AcceptOrdersPermission}, 1)
+	require.NoError(t, err)
+	apiKey.ID = 5
+	apiKey.CreatorRole = models.SuperadminRole
+	deactivatedAt := time.Now()
+	deactivatedCreator := *apiKey
+	deactivatedCreator.CreatorDeactivatedAt = &deactivatedAt
+	ctrl := gomock.NewController(t)
+
+	mockAPIKeyStorage := NewMockapiKeyStorage(ctrl)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	logs := make(chan models.Log, 1)
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch []models.Log) error {
+			for _, log := range batch {
+				logs <- log
+			}
+
+			return nil
+		}).AnyTimes()
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), NewMockorderStorage(ctrl),
+		NewMockadminStorage(ctrl), NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl),
+		NewMocknotificationStorage(ctrl), NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl),
+		NewMocktokenStorage(ctrl), NewMockloginAttemptStorage(ctrl), mockAPIKeyStorage, mockLogStorage, nil,
+		NewMocktxManager(ctrl), 2, 1, 100*time.Millisecond)
+	require.NoError(t, err)
+	app.SetupRoutes(context.Background())
+
+	mockAPIKeyStorage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil).Times(3)
+	mockAPIKeyStorage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil).Times(3)
+	mockAPIKeyStorage.EXPECT().TouchAPIKey(gomock.Any(), 5, gomock.Any()).Return(nil).Times(2)
+
+	// key can be used only within its scopes
+	req := httptest.NewRequest(http.MethodGet, "/orders", nil)
+	req.Header.Set("X-API-Key", key)
+	res := httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusForbidden, res.Code)
+
+	req = httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("X-API-Key", key)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+
+	// audit log is attributed to the key and its creator
+	select {
+	case log := <-logs:
+		require.Equal(t, 1, log.AdminID)
+		require.NotNil(t, log.APIKeyID)
+		require.Equal(t, 5, *log.APIKeyID)
+	case <-time.After(time.Second):
+		require.Fail(t, "audit log wasn't written")
+	}
+
+	req = httptest.NewRequest(http.MethodPost, "/orders", nil)
+	req.Header.Set("X-API-Key", "pvz_"+apiKey.Prefix+"_wrong")
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+
+	// key stops working once its creator is deactivated
+	mockAPIKeyStorage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+	mockAPIKeyStorage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(deactivatedCreator, nil)
+	req = httptest.NewRequest(http.MethodPost, "/orders", bytes.NewReader([]byte(`{}`)))
+	req.Header.Set("X-API-Key", key)
+	res = httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusUnauthorized, res.Code)
+}
+
+func TestApp_AdminAudit(t *testing.T) {
+	t.Parallel()
+
+	password, _ := bcrypt.GenerateFromPassword([]byte("password"), bcrypt.DefaultCost)
+	ctrl := gomock.NewController(t)
+
+	mockAdminStorage := NewMockadminStorage(ctrl)
+	mockLoginAttemptStorage := NewMockloginAttemptStorage(ctrl)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	logs := make(chan models.Log, 1)
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch []models.Log) error {
+			for _, log := range batch {
+				logs <- log
+			}
+
+			return nil
+		}).AnyTimes()
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), NewMockorderStorage(ctrl),
+		mockAdminStorage, NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl),
+		NewMocknotificationStorage(ctrl), NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl),
+		NewMocktokenStorage(ctrl), mockLoginAttemptStorage, NewMockapiKeyStorage(ctrl), mockLogStorage, nil,
+		NewMocktxManager(ctrl), 2, 1, 100*time.Millisecond)
+	require.NoError(t, err)
+	app.SetupRoutes(context.Background())
+
+	superadmin := models.Admin{ID: 2, Username: "root", Password: string(password), Role: models.SuperadminRole,
+		PasswordChangedAt: time.Now()}
+	mockLoginAttemptStorage.EXPECT().GetLoginAttempts(gomock.Any(), "root", "192.0.2.1").Return(nil, nil)
+	mockAdminStorage.EXPECT().ContainsUsername(gomock.Any(), "root").Return(true, nil)
+	mockAdminStorage.EXPECT().GetAdminByUsername(gomock.Any(), "root").Return(superadmin, nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/admins", bytes.NewReader([]byte(`{"id":7}`)))
+	req.SetBasicAuth("root", "password")
+	res := httptest.NewRecorder()
+	app.Router.ServeHTTP(res, req)
+	require.Equal(t, http.StatusBadRequest, res.Code)
+
+	// admin changes are audited like over grpc, id of the created admin isn't taken for an order id
+	select {
+	case log := <-logs:
+		require.Equal(t, 2, log.AdminID)
+		require.Equal(t, -1, log.OrderID)
+		require.Equal(t, "/admins", log.URL)
+		require.Equal(t, http.MethodPost, log.Method)
+		require.Equal(t, http.StatusBadRequest, log.Status)
+	case <-time.After(time.Second):
+		require.Fail(t, "audit log wasn't written")
+	}
+}
+
+func TestApp_Run_Shutdown(t *testing.T) {
+	t.Parallel()
+
+	key, apiKey, err := models.NewAPIKey("courier", []models.Permission{models.AcceptOrdersPermission}, 1)
+	require.NoError(t, err)
+	apiKey.ID = 5
+	apiKey.CreatorRole = models.SuperadminRole
+	ctrl := gomock.NewController(t)
+
+	mockAPIKeyStorage := NewMockapiKeyStorage(ctrl)
+	mockAPIKeyStorage.EXPECT().ContainsAPIKey(gomock.Any(), apiKey.Prefix).Return(true, nil)
+	mockAPIKeyStorage.EXPECT().GetAPIKey(gomock.Any(), apiKey.Prefix).Return(*apiKey, nil)
+	mockAPIKeyStorage.EXPECT().TouchAPIKey(gomock.Any(), 5, gomock.Any()).Return(nil)
+	mockLogStorage := NewMockauditLoggerStorage(ctrl)
+	var written atomic.Int32
+	mockLogStorage.EXPECT().CreateLog(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, batch []models.Log) error {
+			written.Add(int32(len(batch)))
+
+			return nil
+		}).AnyTimes()
+	// batches aren't full and don't time out, so logs are written only by shutdown
+	app, err := NewApp(context.Background(), config.Config{}, zap.NewNop(), NewMockorderStorage(ctrl),
+		NewMockadminStorage(ctrl), NewMockclientStorage(ctrl), NewMockpickupCodeStorage(ctrl),
+		NewMocknotificationStorage(ctrl), NewMockwebhookStorage(ctrl), NewMockorderEventStorage(ctrl),
+		NewMocktokenStorage(ctrl), NewMockloginAttemptStorage(ctrl), mockAPIKeyStorage, mockLogStorage, nil,
+		NewMocktxManager(ctrl), 1, 100, time.Hour)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- app.Run(ctx)
+	}()
+
+	var res *http.Response
+	require.Eventually(t, func() bool {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost:9000/orders",
+			bytes.NewReader([]byte(`{}`)))
+		require.NoError(t, err)
+		req.Header.Set("X-API-Key", key)
+		res, err = http.DefaultClient.Do(req)
+
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, res.Body.Close())
+	require.Equal(t, http.StatusBadRequest, res.StatusCode)
+	require.Zero(t, written.Load())
+
+	cancel()
+	select {
+	case err := <-runErr:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "app wasn't shut down")
+	}
+	require.Equal(t, int32(1), written.Load())
+}
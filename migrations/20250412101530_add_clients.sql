@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE clients
+(
+    id         SERIAL PRIMARY KEY,
+    name       VARCHAR(255) NOT NULL DEFAULT '',
+    phone      VARCHAR(16) UNIQUE,
+    email      VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO clients(id)
+SELECT DISTINCT user_id
+FROM orders
+ON CONFLICT (id) DO NOTHING;
+
+SELECT setval('clients_id_seq', (SELECT COALESCE(MAX(id), 0) + 1 FROM clients), false);
+
+CREATE INDEX idx_clients_phone_hash ON clients USING HASH (phone);
+
+ALTER TABLE orders
+    ADD CONSTRAINT fk_orders_user_id FOREIGN KEY (user_id) REFERENCES clients (id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP CONSTRAINT fk_orders_user_id;
+
+DROP INDEX IF EXISTS idx_clients_phone_hash;
+DROP TABLE clients;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE pickup_codes
+(
+    order_id        INT PRIMARY KEY REFERENCES orders (id) ON DELETE CASCADE,
+    code_hash       VARCHAR(255) NOT NULL,
+    failed_attempts INT          NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMP,
+    created_at      TIMESTAMP             DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE pickup_codes;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE notifications
+(
+    id              SERIAL PRIMARY KEY,
+    order_id        INT         NOT NULL,
+    client_id       INT         NOT NULL REFERENCES clients (id) ON DELETE CASCADE,
+    kind            VARCHAR(32) NOT NULL,
+    message         TEXT        NOT NULL,
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    job_status      INT       DEFAULT 1 REFERENCES job_statuses (id),
+    attempts_left   INT       DEFAULT 5,
+    next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT notifications_order_kind_unique UNIQUE (order_id, kind)
+);
+
+CREATE INDEX notifications_job_status_idx ON notifications (job_status, next_attempt_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE notifications;
+-- +goose StatementEnd
@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE webhook_subscriptions
+(
+    id          SERIAL PRIMARY KEY,
+    url         TEXT         NOT NULL,
+    secret      VARCHAR(255) NOT NULL,
+    event_types TEXT[]       NOT NULL,
+    created_at  TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE webhook_deliveries
+(
+    id              SERIAL PRIMARY KEY,
+    subscription_id INT         NOT NULL REFERENCES webhook_subscriptions (id) ON DELETE CASCADE,
+    event_type      VARCHAR(32) NOT NULL,
+    order_id        INT         NOT NULL,
+    payload         JSONB       NOT NULL,
+    response_status INT       DEFAULT 0,
+    last_error      TEXT      DEFAULT '',
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    job_status      INT       DEFAULT 1 REFERENCES job_statuses (id),
+    attempts_left   INT       DEFAULT 5,
+    next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT webhook_deliveries_event_unique UNIQUE (subscription_id, event_type, order_id)
+);
+
+CREATE INDEX webhook_deliveries_job_status_idx ON webhook_deliveries (job_status, next_attempt_at);
+CREATE INDEX webhook_deliveries_order_event_idx ON webhook_deliveries (order_id, event_type);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE webhook_deliveries;
+DROP TABLE webhook_subscriptions;
+-- +goose StatementEnd
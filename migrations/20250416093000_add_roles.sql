@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles
+(
+    id   INT PRIMARY KEY,
+    name VARCHAR(100) UNIQUE NOT NULL
+);
+
+INSERT INTO roles(id, name)
+VALUES
+    (1, 'operator'),
+    (2, 'supervisor'),
+    (3, 'superadmin');
+
+ALTER TABLE admins
+    ADD COLUMN role INT NOT NULL DEFAULT 1,
+    ADD CONSTRAINT fk_admins_role FOREIGN KEY (role) REFERENCES roles (id);
+
+-- admins created before roles could do everything, new ones are operators by default
+UPDATE admins SET role = 3;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE admins
+    DROP CONSTRAINT fk_admins_role,
+    DROP COLUMN role;
+
+DROP TABLE roles;
+-- +goose StatementEnd
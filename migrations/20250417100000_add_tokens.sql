@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE refresh_tokens
+(
+    id         SERIAL PRIMARY KEY,
+    admin_id   INT         NOT NULL REFERENCES admins (id) ON DELETE CASCADE,
+    token_hash TEXT UNIQUE NOT NULL,
+    expires_at TIMESTAMP   NOT NULL,
+    created_at TIMESTAMP   NOT NULL DEFAULT now(),
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX refresh_tokens_admin_id_idx ON refresh_tokens (admin_id);
+
+-- access tokens revoked before they expire, rows can be dropped after expires_at
+CREATE TABLE revoked_tokens
+(
+    jti        TEXT PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE revoked_tokens;
+DROP TABLE refresh_tokens;
+-- +goose StatementEnd
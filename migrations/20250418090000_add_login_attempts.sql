@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- failed login attempts are tracked both per username and per ip, rows exist for unknown usernames too,
+-- so lockouts don't reveal which admins exist
+CREATE TABLE login_attempts
+(
+    kind            TEXT      NOT NULL,
+    key             TEXT      NOT NULL,
+    failed_attempts INT       NOT NULL DEFAULT 0,
+    locked_until    TIMESTAMP,
+    updated_at      TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (kind, key)
+);
+
+-- lockouts of unknown usernames are audited too, such logs have no admin
+ALTER TABLE logs ALTER COLUMN admin_id DROP NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM logs WHERE admin_id IS NULL;
+ALTER TABLE logs ALTER COLUMN admin_id SET NOT NULL;
+
+DROP TABLE login_attempts;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+-- passwords of existing admins are considered changed at migration time, so they don't expire at once
+ALTER TABLE admins
+    ADD COLUMN password_changed_at TIMESTAMP NOT NULL DEFAULT now();
+
+CREATE TABLE password_history
+(
+    id         SERIAL PRIMARY KEY,
+    admin_id   INT       NOT NULL REFERENCES admins (id) ON DELETE CASCADE,
+    password   TEXT      NOT NULL,
+    changed_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX password_history_admin_id_idx ON password_history (admin_id, changed_at DESC);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE password_history;
+
+ALTER TABLE admins
+    DROP COLUMN password_changed_at;
+-- +goose StatementEnd
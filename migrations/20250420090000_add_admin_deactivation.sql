@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- deactivated admins keep their rows, deleted admins' audit logs survive without an admin
+ALTER TABLE admins
+    ADD COLUMN deactivated_at TIMESTAMP;
+
+ALTER TABLE logs
+    DROP CONSTRAINT fk_logs_admin_id,
+    ADD CONSTRAINT fk_logs_admin_id FOREIGN KEY (admin_id) REFERENCES admins (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE logs
+    DROP CONSTRAINT fk_logs_admin_id,
+    ADD CONSTRAINT fk_logs_admin_id FOREIGN KEY (admin_id) REFERENCES admins (id) ON DELETE CASCADE;
+
+ALTER TABLE admins
+    DROP COLUMN deactivated_at;
+-- +goose StatementEnd
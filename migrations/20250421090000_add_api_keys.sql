@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- keys are looked up by their public prefix, only a hash of the whole key is stored
+CREATE TABLE api_keys
+(
+    id           SERIAL PRIMARY KEY,
+    name         TEXT               NOT NULL,
+    prefix       VARCHAR(16) UNIQUE NOT NULL,
+    secret_hash  TEXT               NOT NULL,
+    scopes       TEXT[]             NOT NULL,
+    created_by   INT                NOT NULL REFERENCES admins (id) ON DELETE CASCADE,
+    created_at   TIMESTAMP          NOT NULL DEFAULT now(),
+    last_used_at TIMESTAMP,
+    revoked_at   TIMESTAMP
+);
+
+-- requests made with a key are logged on behalf of its creator along with the key
+ALTER TABLE logs
+    ADD COLUMN api_key_id INT REFERENCES api_keys (id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE logs
+    DROP COLUMN api_key_id;
+
+DROP TABLE api_keys;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- diff maps changed fields to their old and new values, it's NULL for calls that changed nothing
+ALTER TABLE logs
+    ADD COLUMN diff JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE logs
+    DROP COLUMN diff;
+-- +goose StatementEnd
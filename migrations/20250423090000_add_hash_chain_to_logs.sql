@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- every log stores hash of its content chained to hash of the previous log, logs written before have empty hashes
+ALTER TABLE logs
+    ADD COLUMN prev_hash TEXT NOT NULL DEFAULT '',
+    ADD COLUMN hash      TEXT NOT NULL DEFAULT '';
+
+-- hashed columns must outlive admins and api keys, otherwise deleting them would break the chain
+ALTER TABLE logs
+    DROP CONSTRAINT fk_logs_admin_id,
+    DROP CONSTRAINT logs_api_key_id_fkey;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE logs
+    ADD CONSTRAINT logs_api_key_id_fkey FOREIGN KEY (api_key_id) REFERENCES api_keys (id) ON DELETE SET NULL,
+    ADD CONSTRAINT fk_logs_admin_id FOREIGN KEY (admin_id) REFERENCES admins (id) ON DELETE SET NULL;
+
+ALTER TABLE logs
+    DROP COLUMN hash,
+    DROP COLUMN prev_hash;
+-- +goose StatementEnd
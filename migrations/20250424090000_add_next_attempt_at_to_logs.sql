@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE logs
+    ADD COLUMN next_attempt_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP;
+
+CREATE INDEX logs_job_status_idx ON logs (job_status, next_attempt_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX logs_job_status_idx;
+
+ALTER TABLE logs
+    DROP COLUMN next_attempt_at;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE order_events
+(
+    id              SERIAL PRIMARY KEY,
+    order_id        INT         NOT NULL,
+    event_type      VARCHAR(32) NOT NULL,
+    payload         JSONB       NOT NULL,
+    created_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    job_status      INT       DEFAULT 1 REFERENCES job_statuses (id),
+    attempts_left   INT       DEFAULT 5,
+    next_attempt_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX order_events_job_status_idx ON order_events (job_status, next_attempt_at);
+CREATE INDEX order_events_order_idx ON order_events (order_id, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE order_events;
+-- +goose StatementEnd
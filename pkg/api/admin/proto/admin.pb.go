@@ -9,6 +9,7 @@ package proto
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,18 +22,113 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Admin struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Role     string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// active or deactivated
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PasswordChangedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=password_changed_at,json=passwordChangedAt,proto3" json:"password_changed_at,omitempty"`
+	DeactivatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deactivated_at,json=deactivatedAt,proto3,oneof" json:"deactivated_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Admin) Reset() {
+	*x = Admin{}
+	mi := &file_api_admin_admin_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Admin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Admin) ProtoMessage() {}
+
+func (x *Admin) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Admin.ProtoReflect.Descriptor instead.
+func (*Admin) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Admin) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Admin) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *Admin) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Admin) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Admin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Admin) GetPasswordChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PasswordChangedAt
+	}
+	return nil
+}
+
+func (x *Admin) GetDeactivatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeactivatedAt
+	}
+	return nil
+}
+
 type CreateAdminRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// operator, supervisor or superadmin, operator by default
+	Role          string `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateAdminRequest) Reset() {
 	*x = CreateAdminRequest{}
-	mi := &file_api_admin_admin_proto_msgTypes[0]
+	mi := &file_api_admin_admin_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -44,7 +140,7 @@ func (x *CreateAdminRequest) String() string {
 func (*CreateAdminRequest) ProtoMessage() {}
 
 func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[0]
+	mi := &file_api_admin_admin_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,7 +153,7 @@ func (x *CreateAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAdminRequest.ProtoReflect.Descriptor instead.
 func (*CreateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{0}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CreateAdminRequest) GetId() int32 {
@@ -81,6 +177,13 @@ func (x *CreateAdminRequest) GetPassword() string {
 	return ""
 }
 
+func (x *CreateAdminRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 type CreateAdminResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
@@ -90,7 +193,7 @@ type CreateAdminResponse struct {
 
 func (x *CreateAdminResponse) Reset() {
 	*x = CreateAdminResponse{}
-	mi := &file_api_admin_admin_proto_msgTypes[1]
+	mi := &file_api_admin_admin_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -102,7 +205,7 @@ func (x *CreateAdminResponse) String() string {
 func (*CreateAdminResponse) ProtoMessage() {}
 
 func (x *CreateAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[1]
+	mi := &file_api_admin_admin_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -115,7 +218,7 @@ func (x *CreateAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAdminResponse.ProtoReflect.Descriptor instead.
 func (*CreateAdminResponse) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{1}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateAdminResponse) GetOutput() string {
@@ -136,7 +239,7 @@ type UpdateAdminRequest struct {
 
 func (x *UpdateAdminRequest) Reset() {
 	*x = UpdateAdminRequest{}
-	mi := &file_api_admin_admin_proto_msgTypes[2]
+	mi := &file_api_admin_admin_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -148,7 +251,7 @@ func (x *UpdateAdminRequest) String() string {
 func (*UpdateAdminRequest) ProtoMessage() {}
 
 func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[2]
+	mi := &file_api_admin_admin_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -161,7 +264,7 @@ func (x *UpdateAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAdminRequest) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{2}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *UpdateAdminRequest) GetUsername() string {
@@ -194,7 +297,7 @@ type UpdateAdminResponse struct {
 
 func (x *UpdateAdminResponse) Reset() {
 	*x = UpdateAdminResponse{}
-	mi := &file_api_admin_admin_proto_msgTypes[3]
+	mi := &file_api_admin_admin_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -206,7 +309,7 @@ func (x *UpdateAdminResponse) String() string {
 func (*UpdateAdminResponse) ProtoMessage() {}
 
 func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[3]
+	mi := &file_api_admin_admin_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -219,7 +322,7 @@ func (x *UpdateAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAdminResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAdminResponse) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{3}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *UpdateAdminResponse) GetOutput() string {
@@ -239,7 +342,7 @@ type DeleteAdminRequest struct {
 
 func (x *DeleteAdminRequest) Reset() {
 	*x = DeleteAdminRequest{}
-	mi := &file_api_admin_admin_proto_msgTypes[4]
+	mi := &file_api_admin_admin_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -251,7 +354,7 @@ func (x *DeleteAdminRequest) String() string {
 func (*DeleteAdminRequest) ProtoMessage() {}
 
 func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[4]
+	mi := &file_api_admin_admin_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -264,7 +367,7 @@ func (x *DeleteAdminRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAdminRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAdminRequest) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{4}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteAdminRequest) GetUsername() string {
@@ -290,7 +393,7 @@ type DeleteAdminResponse struct {
 
 func (x *DeleteAdminResponse) Reset() {
 	*x = DeleteAdminResponse{}
-	mi := &file_api_admin_admin_proto_msgTypes[5]
+	mi := &file_api_admin_admin_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -302,7 +405,7 @@ func (x *DeleteAdminResponse) String() string {
 func (*DeleteAdminResponse) ProtoMessage() {}
 
 func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_admin_admin_proto_msgTypes[5]
+	mi := &file_api_admin_admin_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -315,7 +418,7 @@ func (x *DeleteAdminResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAdminResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAdminResponse) Descriptor() ([]byte, []int) {
-	return file_api_admin_admin_proto_rawDescGZIP(), []int{5}
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *DeleteAdminResponse) GetOutput() string {
@@ -325,15 +428,492 @@ func (x *DeleteAdminResponse) GetOutput() string {
 	return ""
 }
 
+type UnlockAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAdminRequest) Reset() {
+	*x = UnlockAdminRequest{}
+	mi := &file_api_admin_admin_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAdminRequest) ProtoMessage() {}
+
+func (x *UnlockAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAdminRequest.ProtoReflect.Descriptor instead.
+func (*UnlockAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *UnlockAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type UnlockAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlockAdminResponse) Reset() {
+	*x = UnlockAdminResponse{}
+	mi := &file_api_admin_admin_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlockAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlockAdminResponse) ProtoMessage() {}
+
+func (x *UnlockAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlockAdminResponse.ProtoReflect.Descriptor instead.
+func (*UnlockAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UnlockAdminResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ListAdminsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// operator, supervisor or superadmin
+	Role *string `protobuf:"bytes,1,opt,name=role,proto3,oneof" json:"role,omitempty"`
+	// active or deactivated
+	Status        *string `protobuf:"bytes,2,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Count         *int32  `protobuf:"varint,3,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Page          *int32  `protobuf:"varint,4,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsRequest) Reset() {
+	*x = ListAdminsRequest{}
+	mi := &file_api_admin_admin_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsRequest) ProtoMessage() {}
+
+func (x *ListAdminsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsRequest.ProtoReflect.Descriptor instead.
+func (*ListAdminsRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListAdminsRequest) GetRole() string {
+	if x != nil && x.Role != nil {
+		return *x.Role
+	}
+	return ""
+}
+
+func (x *ListAdminsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *ListAdminsRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *ListAdminsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+type ListAdminsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admins        []*Admin               `protobuf:"bytes,1,rep,name=admins,proto3" json:"admins,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAdminsResponse) Reset() {
+	*x = ListAdminsResponse{}
+	mi := &file_api_admin_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAdminsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAdminsResponse) ProtoMessage() {}
+
+func (x *ListAdminsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAdminsResponse.ProtoReflect.Descriptor instead.
+func (*ListAdminsResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListAdminsResponse) GetAdmins() []*Admin {
+	if x != nil {
+		return x.Admins
+	}
+	return nil
+}
+
+type GetAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminRequest) Reset() {
+	*x = GetAdminRequest{}
+	mi := &file_api_admin_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminRequest) ProtoMessage() {}
+
+func (x *GetAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Admin         *Admin                 `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAdminResponse) Reset() {
+	*x = GetAdminResponse{}
+	mi := &file_api_admin_admin_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAdminResponse) ProtoMessage() {}
+
+func (x *GetAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAdminResponse.ProtoReflect.Descriptor instead.
+func (*GetAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetAdminResponse) GetAdmin() *Admin {
+	if x != nil {
+		return x.Admin
+	}
+	return nil
+}
+
+type DeactivateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAdminRequest) Reset() {
+	*x = DeactivateAdminRequest{}
+	mi := &file_api_admin_admin_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAdminRequest) ProtoMessage() {}
+
+func (x *DeactivateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAdminRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeactivateAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type DeactivateAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateAdminResponse) Reset() {
+	*x = DeactivateAdminResponse{}
+	mi := &file_api_admin_admin_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateAdminResponse) ProtoMessage() {}
+
+func (x *DeactivateAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateAdminResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DeactivateAdminResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
+type ReactivateAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAdminRequest) Reset() {
+	*x = ReactivateAdminRequest{}
+	mi := &file_api_admin_admin_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAdminRequest) ProtoMessage() {}
+
+func (x *ReactivateAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAdminRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateAdminRequest) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReactivateAdminRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ReactivateAdminResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateAdminResponse) Reset() {
+	*x = ReactivateAdminResponse{}
+	mi := &file_api_admin_admin_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateAdminResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateAdminResponse) ProtoMessage() {}
+
+func (x *ReactivateAdminResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_admin_admin_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateAdminResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateAdminResponse) Descriptor() ([]byte, []int) {
+	return file_api_admin_admin_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReactivateAdminResponse) GetOutput() string {
+	if x != nil {
+		return x.Output
+	}
+	return ""
+}
+
 var File_api_admin_admin_proto protoreflect.FileDescriptor
 
 const file_api_admin_admin_proto_rawDesc = "" +
 	"\n" +
-	"\x15api/admin/admin.proto\x12\vadmin.proto\"\\\n" +
+	"\x15api/admin/admin.proto\x12\vadmin.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc1\x02\n" +
+	"\x05Admin\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12J\n" +
+	"\x13password_changed_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11passwordChangedAt\x12F\n" +
+	"\x0edeactivated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampH\x00R\rdeactivatedAt\x88\x01\x01B\x11\n" +
+	"\x0f_deactivated_at\"p\n" +
 	"\x12CreateAdminRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"-\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\"-\n" +
 	"\x13CreateAdminResponse\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\tR\x06output\"o\n" +
 	"\x12UpdateAdminRequest\x12\x1a\n" +
@@ -346,11 +926,44 @@ const file_api_admin_admin_proto_rawDesc = "" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"-\n" +
 	"\x13DeleteAdminResponse\x12\x16\n" +
-	"\x06output\x18\x01 \x01(\tR\x06output2\x84\x02\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"0\n" +
+	"\x12UnlockAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"-\n" +
+	"\x13UnlockAdminResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"\xa4\x01\n" +
+	"\x11ListAdminsRequest\x12\x17\n" +
+	"\x04role\x18\x01 \x01(\tH\x00R\x04role\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x02 \x01(\tH\x01R\x06status\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\x03 \x01(\x05H\x02R\x05count\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\x04 \x01(\x05H\x03R\x04page\x88\x01\x01B\a\n" +
+	"\x05_roleB\t\n" +
+	"\a_statusB\b\n" +
+	"\x06_countB\a\n" +
+	"\x05_page\"@\n" +
+	"\x12ListAdminsResponse\x12*\n" +
+	"\x06admins\x18\x01 \x03(\v2\x12.admin.proto.AdminR\x06admins\"-\n" +
+	"\x0fGetAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"<\n" +
+	"\x10GetAdminResponse\x12(\n" +
+	"\x05admin\x18\x01 \x01(\v2\x12.admin.proto.AdminR\x05admin\"4\n" +
+	"\x16DeactivateAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"1\n" +
+	"\x17DeactivateAdminResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"4\n" +
+	"\x16ReactivateAdminRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"1\n" +
+	"\x17ReactivateAdminResponse\x12\x16\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output2\xaa\x05\n" +
 	"\fAdminService\x12P\n" +
 	"\vCreateAdmin\x12\x1f.admin.proto.CreateAdminRequest\x1a .admin.proto.CreateAdminResponse\x12P\n" +
 	"\vUpdateAdmin\x12\x1f.admin.proto.UpdateAdminRequest\x1a .admin.proto.UpdateAdminResponse\x12P\n" +
-	"\vDeleteAdmin\x12\x1f.admin.proto.DeleteAdminRequest\x1a .admin.proto.DeleteAdminResponseB\rZ\vadmin/protob\x06proto3"
+	"\vDeleteAdmin\x12\x1f.admin.proto.DeleteAdminRequest\x1a .admin.proto.DeleteAdminResponse\x12P\n" +
+	"\vUnlockAdmin\x12\x1f.admin.proto.UnlockAdminRequest\x1a .admin.proto.UnlockAdminResponse\x12M\n" +
+	"\n" +
+	"ListAdmins\x12\x1e.admin.proto.ListAdminsRequest\x1a\x1f.admin.proto.ListAdminsResponse\x12G\n" +
+	"\bGetAdmin\x12\x1c.admin.proto.GetAdminRequest\x1a\x1d.admin.proto.GetAdminResponse\x12\\\n" +
+	"\x0fDeactivateAdmin\x12#.admin.proto.DeactivateAdminRequest\x1a$.admin.proto.DeactivateAdminResponse\x12\\\n" +
+	"\x0fReactivateAdmin\x12#.admin.proto.ReactivateAdminRequest\x1a$.admin.proto.ReactivateAdminResponseB\rZ\vadmin/protob\x06proto3"
 
 var (
 	file_api_admin_admin_proto_rawDescOnce sync.Once
@@ -364,27 +977,54 @@ func file_api_admin_admin_proto_rawDescGZIP() []byte {
 	return file_api_admin_admin_proto_rawDescData
 }
 
-var file_api_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_api_admin_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_api_admin_admin_proto_goTypes = []any{
-	(*CreateAdminRequest)(nil),  // 0: admin.proto.CreateAdminRequest
-	(*CreateAdminResponse)(nil), // 1: admin.proto.CreateAdminResponse
-	(*UpdateAdminRequest)(nil),  // 2: admin.proto.UpdateAdminRequest
-	(*UpdateAdminResponse)(nil), // 3: admin.proto.UpdateAdminResponse
-	(*DeleteAdminRequest)(nil),  // 4: admin.proto.DeleteAdminRequest
-	(*DeleteAdminResponse)(nil), // 5: admin.proto.DeleteAdminResponse
+	(*Admin)(nil),                   // 0: admin.proto.Admin
+	(*CreateAdminRequest)(nil),      // 1: admin.proto.CreateAdminRequest
+	(*CreateAdminResponse)(nil),     // 2: admin.proto.CreateAdminResponse
+	(*UpdateAdminRequest)(nil),      // 3: admin.proto.UpdateAdminRequest
+	(*UpdateAdminResponse)(nil),     // 4: admin.proto.UpdateAdminResponse
+	(*DeleteAdminRequest)(nil),      // 5: admin.proto.DeleteAdminRequest
+	(*DeleteAdminResponse)(nil),     // 6: admin.proto.DeleteAdminResponse
+	(*UnlockAdminRequest)(nil),      // 7: admin.proto.UnlockAdminRequest
+	(*UnlockAdminResponse)(nil),     // 8: admin.proto.UnlockAdminResponse
+	(*ListAdminsRequest)(nil),       // 9: admin.proto.ListAdminsRequest
+	(*ListAdminsResponse)(nil),      // 10: admin.proto.ListAdminsResponse
+	(*GetAdminRequest)(nil),         // 11: admin.proto.GetAdminRequest
+	(*GetAdminResponse)(nil),        // 12: admin.proto.GetAdminResponse
+	(*DeactivateAdminRequest)(nil),  // 13: admin.proto.DeactivateAdminRequest
+	(*DeactivateAdminResponse)(nil), // 14: admin.proto.DeactivateAdminResponse
+	(*ReactivateAdminRequest)(nil),  // 15: admin.proto.ReactivateAdminRequest
+	(*ReactivateAdminResponse)(nil), // 16: admin.proto.ReactivateAdminResponse
+	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
 }
 var file_api_admin_admin_proto_depIdxs = []int32{
-	0, // 0: admin.proto.AdminService.CreateAdmin:input_type -> admin.proto.CreateAdminRequest
-	2, // 1: admin.proto.AdminService.UpdateAdmin:input_type -> admin.proto.UpdateAdminRequest
-	4, // 2: admin.proto.AdminService.DeleteAdmin:input_type -> admin.proto.DeleteAdminRequest
-	1, // 3: admin.proto.AdminService.CreateAdmin:output_type -> admin.proto.CreateAdminResponse
-	3, // 4: admin.proto.AdminService.UpdateAdmin:output_type -> admin.proto.UpdateAdminResponse
-	5, // 5: admin.proto.AdminService.DeleteAdmin:output_type -> admin.proto.DeleteAdminResponse
-	3, // [3:6] is the sub-list for method output_type
-	0, // [0:3] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	17, // 0: admin.proto.Admin.created_at:type_name -> google.protobuf.Timestamp
+	17, // 1: admin.proto.Admin.password_changed_at:type_name -> google.protobuf.Timestamp
+	17, // 2: admin.proto.Admin.deactivated_at:type_name -> google.protobuf.Timestamp
+	0,  // 3: admin.proto.ListAdminsResponse.admins:type_name -> admin.proto.Admin
+	0,  // 4: admin.proto.GetAdminResponse.admin:type_name -> admin.proto.Admin
+	1,  // 5: admin.proto.AdminService.CreateAdmin:input_type -> admin.proto.CreateAdminRequest
+	3,  // 6: admin.proto.AdminService.UpdateAdmin:input_type -> admin.proto.UpdateAdminRequest
+	5,  // 7: admin.proto.AdminService.DeleteAdmin:input_type -> admin.proto.DeleteAdminRequest
+	7,  // 8: admin.proto.AdminService.UnlockAdmin:input_type -> admin.proto.UnlockAdminRequest
+	9,  // 9: admin.proto.AdminService.ListAdmins:input_type -> admin.proto.ListAdminsRequest
+	11, // 10: admin.proto.AdminService.GetAdmin:input_type -> admin.proto.GetAdminRequest
+	13, // 11: admin.proto.AdminService.DeactivateAdmin:input_type -> admin.proto.DeactivateAdminRequest
+	15, // 12: admin.proto.AdminService.ReactivateAdmin:input_type -> admin.proto.ReactivateAdminRequest
+	2,  // 13: admin.proto.AdminService.CreateAdmin:output_type -> admin.proto.CreateAdminResponse
+	4,  // 14: admin.proto.AdminService.UpdateAdmin:output_type -> admin.proto.UpdateAdminResponse
+	6,  // 15: admin.proto.AdminService.DeleteAdmin:output_type -> admin.proto.DeleteAdminResponse
+	8,  // 16: admin.proto.AdminService.UnlockAdmin:output_type -> admin.proto.UnlockAdminResponse
+	10, // 17: admin.proto.AdminService.ListAdmins:output_type -> admin.proto.ListAdminsResponse
+	12, // 18: admin.proto.AdminService.GetAdmin:output_type -> admin.proto.GetAdminResponse
+	14, // 19: admin.proto.AdminService.DeactivateAdmin:output_type -> admin.proto.DeactivateAdminResponse
+	16, // 20: admin.proto.AdminService.ReactivateAdmin:output_type -> admin.proto.ReactivateAdminResponse
+	13, // [13:21] is the sub-list for method output_type
+	5,  // [5:13] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_api_admin_admin_proto_init() }
@@ -392,13 +1032,15 @@ func file_api_admin_admin_proto_init() {
 	if File_api_admin_admin_proto != nil {
 		return
 	}
+	file_api_admin_admin_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_admin_admin_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_admin_admin_proto_rawDesc), len(file_api_admin_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,9 +19,14 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_CreateAdmin_FullMethodName = "/admin.proto.AdminService/CreateAdmin"
-	AdminService_UpdateAdmin_FullMethodName = "/admin.proto.AdminService/UpdateAdmin"
-	AdminService_DeleteAdmin_FullMethodName = "/admin.proto.AdminService/DeleteAdmin"
+	AdminService_CreateAdmin_FullMethodName     = "/admin.proto.AdminService/CreateAdmin"
+	AdminService_UpdateAdmin_FullMethodName     = "/admin.proto.AdminService/UpdateAdmin"
+	AdminService_DeleteAdmin_FullMethodName     = "/admin.proto.AdminService/DeleteAdmin"
+	AdminService_UnlockAdmin_FullMethodName     = "/admin.proto.AdminService/UnlockAdmin"
+	AdminService_ListAdmins_FullMethodName      = "/admin.proto.AdminService/ListAdmins"
+	AdminService_GetAdmin_FullMethodName        = "/admin.proto.AdminService/GetAdmin"
+	AdminService_DeactivateAdmin_FullMethodName = "/admin.proto.AdminService/DeactivateAdmin"
+	AdminService_ReactivateAdmin_FullMethodName = "/admin.proto.AdminService/ReactivateAdmin"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -31,6 +36,13 @@ type AdminServiceClient interface {
 	CreateAdmin(ctx context.Context, in *CreateAdminRequest, opts ...grpc.CallOption) (*CreateAdminResponse, error)
 	UpdateAdmin(ctx context.Context, in *UpdateAdminRequest, opts ...grpc.CallOption) (*UpdateAdminResponse, error)
 	DeleteAdmin(ctx context.Context, in *DeleteAdminRequest, opts ...grpc.CallOption) (*DeleteAdminResponse, error)
+	// UnlockAdmin lifts login lock of an admin locked after too many failed logins
+	UnlockAdmin(ctx context.Context, in *UnlockAdminRequest, opts ...grpc.CallOption) (*UnlockAdminResponse, error)
+	ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error)
+	GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error)
+	// DeactivateAdmin forbids admin to log in, unlike DeleteAdmin admin and their audit logs are kept
+	DeactivateAdmin(ctx context.Context, in *DeactivateAdminRequest, opts ...grpc.CallOption) (*DeactivateAdminResponse, error)
+	ReactivateAdmin(ctx context.Context, in *ReactivateAdminRequest, opts ...grpc.CallOption) (*ReactivateAdminResponse, error)
 }
 
 type adminServiceClient struct {
@@ -71,6 +83,56 @@ func (c *adminServiceClient) DeleteAdmin(ctx context.Context, in *DeleteAdminReq
 	return out, nil
 }
 
+func (c *adminServiceClient) UnlockAdmin(ctx context.Context, in *UnlockAdminRequest, opts ...grpc.CallOption) (*UnlockAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnlockAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListAdmins(ctx context.Context, in *ListAdminsRequest, opts ...grpc.CallOption) (*ListAdminsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAdminsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListAdmins_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetAdmin(ctx context.Context, in *GetAdminRequest, opts ...grpc.CallOption) (*GetAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeactivateAdmin(ctx context.Context, in *DeactivateAdminRequest, opts ...grpc.CallOption) (*DeactivateAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeactivateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReactivateAdmin(ctx context.Context, in *ReactivateAdminRequest, opts ...grpc.CallOption) (*ReactivateAdminResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateAdminResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReactivateAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -78,6 +140,13 @@ type AdminServiceServer interface {
 	CreateAdmin(context.Context, *CreateAdminRequest) (*CreateAdminResponse, error)
 	UpdateAdmin(context.Context, *UpdateAdminRequest) (*UpdateAdminResponse, error)
 	DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error)
+	// UnlockAdmin lifts login lock of an admin locked after too many failed logins
+	UnlockAdmin(context.Context, *UnlockAdminRequest) (*UnlockAdminResponse, error)
+	ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error)
+	GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error)
+	// DeactivateAdmin forbids admin to log in, unlike DeleteAdmin admin and their audit logs are kept
+	DeactivateAdmin(context.Context, *DeactivateAdminRequest) (*DeactivateAdminResponse, error)
+	ReactivateAdmin(context.Context, *ReactivateAdminRequest) (*ReactivateAdminResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -97,6 +166,21 @@ func (UnimplementedAdminServiceServer) UpdateAdmin(context.Context, *UpdateAdmin
 func (UnimplementedAdminServiceServer) DeleteAdmin(context.Context, *DeleteAdminRequest) (*DeleteAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAdmin not implemented")
 }
+func (UnimplementedAdminServiceServer) UnlockAdmin(context.Context, *UnlockAdminRequest) (*UnlockAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockAdmin not implemented")
+}
+func (UnimplementedAdminServiceServer) ListAdmins(context.Context, *ListAdminsRequest) (*ListAdminsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAdmins not implemented")
+}
+func (UnimplementedAdminServiceServer) GetAdmin(context.Context, *GetAdminRequest) (*GetAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAdmin not implemented")
+}
+func (UnimplementedAdminServiceServer) DeactivateAdmin(context.Context, *DeactivateAdminRequest) (*DeactivateAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAdmin not implemented")
+}
+func (UnimplementedAdminServiceServer) ReactivateAdmin(context.Context, *ReactivateAdminRequest) (*ReactivateAdminResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateAdmin not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -172,6 +256,96 @@ func _AdminService_DeleteAdmin_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_UnlockAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnlockAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnlockAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnlockAdmin(ctx, req.(*UnlockAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListAdmins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAdminsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListAdmins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListAdmins_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListAdmins(ctx, req.(*ListAdminsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetAdmin(ctx, req.(*GetAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeactivateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeactivateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeactivateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeactivateAdmin(ctx, req.(*DeactivateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReactivateAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReactivateAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReactivateAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReactivateAdmin(ctx, req.(*ReactivateAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +365,26 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAdmin",
 			Handler:    _AdminService_DeleteAdmin_Handler,
 		},
+		{
+			MethodName: "UnlockAdmin",
+			Handler:    _AdminService_UnlockAdmin_Handler,
+		},
+		{
+			MethodName: "ListAdmins",
+			Handler:    _AdminService_ListAdmins_Handler,
+		},
+		{
+			MethodName: "GetAdmin",
+			Handler:    _AdminService_GetAdmin_Handler,
+		},
+		{
+			MethodName: "DeactivateAdmin",
+			Handler:    _AdminService_DeactivateAdmin_Handler,
+		},
+		{
+			MethodName: "ReactivateAdmin",
+			Handler:    _AdminService_ReactivateAdmin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/admin/admin.proto",
@@ -0,0 +1,732 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: api/audit/audit.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Log struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// order_id is -1 for calls not about orders
+	OrderId int32 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AdminId int32 `protobuf:"varint,3,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	// api_key_id is set if call was made with an API key
+	ApiKeyId *int32                 `protobuf:"varint,4,opt,name=api_key_id,json=apiKeyId,proto3,oneof" json:"api_key_id,omitempty"`
+	Message  string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	Date     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date,proto3" json:"date,omitempty"`
+	Url      string                 `protobuf:"bytes,7,opt,name=url,proto3" json:"url,omitempty"`
+	// http method or GRPC
+	Method       string                 `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
+	Status       int32                  `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	JobStatus    int32                  `protobuf:"varint,10,opt,name=job_status,json=jobStatus,proto3" json:"job_status,omitempty"`
+	AttemptsLeft int32                  `protobuf:"varint,11,opt,name=attempts_left,json=attemptsLeft,proto3" json:"attempts_left,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// diff maps changed fields to their old and new values
+	Diff     map[string]*Change `protobuf:"bytes,13,rep,name=diff,proto3" json:"diff,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PrevHash string             `protobuf:"bytes,14,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash     string             `protobuf:"bytes,15,opt,name=hash,proto3" json:"hash,omitempty"`
+	// next_attempt_at is a time failed job is sent again at
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Log) Reset() {
+	*x = Log{}
+	mi := &file_api_audit_audit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Log) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Log) ProtoMessage() {}
+
+func (x *Log) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Log.ProtoReflect.Descriptor instead.
+func (*Log) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Log) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Log) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *Log) GetAdminId() int32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *Log) GetApiKeyId() int32 {
+	if x != nil && x.ApiKeyId != nil {
+		return *x.ApiKeyId
+	}
+	return 0
+}
+
+func (x *Log) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Log) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *Log) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Log) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Log) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *Log) GetJobStatus() int32 {
+	if x != nil {
+		return x.JobStatus
+	}
+	return 0
+}
+
+func (x *Log) GetAttemptsLeft() int32 {
+	if x != nil {
+		return x.AttemptsLeft
+	}
+	return 0
+}
+
+func (x *Log) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Log) GetDiff() map[string]*Change {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *Log) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *Log) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Log) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+// Change holds JSON encoded values of a field, old is null for created and new is null for deleted entities
+type Change struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Old           string                 `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           string                 `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	mi := &file_api_audit_audit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Change) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *Change) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+type ListLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AdminId       *int32                 `protobuf:"varint,1,opt,name=admin_id,json=adminId,proto3,oneof" json:"admin_id,omitempty"`
+	OrderId       *int32                 `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3,oneof" json:"order_id,omitempty"`
+	Method        *string                `protobuf:"bytes,3,opt,name=method,proto3,oneof" json:"method,omitempty"`
+	Status        *int32                 `protobuf:"varint,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	DateFrom      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3" json:"date_from,omitempty"`
+	DateTo        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3" json:"date_to,omitempty"`
+	JobStatus     *int32                 `protobuf:"varint,7,opt,name=job_status,json=jobStatus,proto3,oneof" json:"job_status,omitempty"`
+	Count         *int32                 `protobuf:"varint,8,opt,name=count,proto3,oneof" json:"count,omitempty"`
+	Page          *int32                 `protobuf:"varint,9,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogsRequest) Reset() {
+	*x = ListLogsRequest{}
+	mi := &file_api_audit_audit_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsRequest) ProtoMessage() {}
+
+func (x *ListLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListLogsRequest) GetAdminId() int32 {
+	if x != nil && x.AdminId != nil {
+		return *x.AdminId
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetOrderId() int32 {
+	if x != nil && x.OrderId != nil {
+		return *x.OrderId
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetMethod() string {
+	if x != nil && x.Method != nil {
+		return *x.Method
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetStatus() int32 {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetDateFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateFrom
+	}
+	return nil
+}
+
+func (x *ListLogsRequest) GetDateTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTo
+	}
+	return nil
+}
+
+func (x *ListLogsRequest) GetJobStatus() int32 {
+	if x != nil && x.JobStatus != nil {
+		return *x.JobStatus
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetCount() int32 {
+	if x != nil && x.Count != nil {
+		return *x.Count
+	}
+	return 0
+}
+
+func (x *ListLogsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+type ListLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Logs          []*Log                 `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogsResponse) Reset() {
+	*x = ListLogsResponse{}
+	mi := &file_api_audit_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogsResponse) ProtoMessage() {}
+
+func (x *ListLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListLogsResponse) GetLogs() []*Log {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type VerifyAuditChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainRequest) Reset() {
+	*x = VerifyAuditChainRequest{}
+	mi := &file_api_audit_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainRequest) ProtoMessage() {}
+
+func (x *VerifyAuditChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainRequest.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainRequest) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{4}
+}
+
+type VerifyAuditChainResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Checked int32                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Valid   bool                   `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	// broken_log_id and reason are set if chain is broken
+	BrokenLogId   int32  `protobuf:"varint,3,opt,name=broken_log_id,json=brokenLogId,proto3" json:"broken_log_id,omitempty"`
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyAuditChainResponse) Reset() {
+	*x = VerifyAuditChainResponse{}
+	mi := &file_api_audit_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyAuditChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyAuditChainResponse) ProtoMessage() {}
+
+func (x *VerifyAuditChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyAuditChainResponse.ProtoReflect.Descriptor instead.
+func (*VerifyAuditChainResponse) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyAuditChainResponse) GetChecked() int32 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyAuditChainResponse) GetBrokenLogId() int32 {
+	if x != nil {
+		return x.BrokenLogId
+	}
+	return 0
+}
+
+func (x *VerifyAuditChainResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RequeueDeadJobsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// all dead jobs are requeued if ids are empty
+	Ids           []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadJobsRequest) Reset() {
+	*x = RequeueDeadJobsRequest{}
+	mi := &file_api_audit_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadJobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadJobsRequest) ProtoMessage() {}
+
+func (x *RequeueDeadJobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadJobsRequest.ProtoReflect.Descriptor instead.
+func (*RequeueDeadJobsRequest) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RequeueDeadJobsRequest) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type RequeueDeadJobsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requeued      int32                  `protobuf:"varint,1,opt,name=requeued,proto3" json:"requeued,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequeueDeadJobsResponse) Reset() {
+	*x = RequeueDeadJobsResponse{}
+	mi := &file_api_audit_audit_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequeueDeadJobsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequeueDeadJobsResponse) ProtoMessage() {}
+
+func (x *RequeueDeadJobsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_audit_audit_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequeueDeadJobsResponse.ProtoReflect.Descriptor instead.
+func (*RequeueDeadJobsResponse) Descriptor() ([]byte, []int) {
+	return file_api_audit_audit_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RequeueDeadJobsResponse) GetRequeued() int32 {
+	if x != nil {
+		return x.Requeued
+	}
+	return 0
+}
+
+var File_api_audit_audit_proto protoreflect.FileDescriptor
+
+const file_api_audit_audit_proto_rawDesc = "" +
+	"\n" +
+	"\x15api/audit/audit.proto\x12\vaudit.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xfb\x04\n" +
+	"\x03Log\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x05R\aorderId\x12\x19\n" +
+	"\badmin_id\x18\x03 \x01(\x05R\aadminId\x12!\n" +
+	"\n" +
+	"api_key_id\x18\x04 \x01(\x05H\x00R\bapiKeyId\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12.\n" +
+	"\x04date\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x10\n" +
+	"\x03url\x18\a \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\b \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\t \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"job_status\x18\n" +
+	" \x01(\x05R\tjobStatus\x12#\n" +
+	"\rattempts_left\x18\v \x01(\x05R\fattemptsLeft\x129\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12.\n" +
+	"\x04diff\x18\r \x03(\v2\x1a.audit.proto.Log.DiffEntryR\x04diff\x12\x1b\n" +
+	"\tprev_hash\x18\x0e \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\x0f \x01(\tR\x04hash\x12B\n" +
+	"\x0fnext_attempt_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x1aL\n" +
+	"\tDiffEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.audit.proto.ChangeR\x05value:\x028\x01B\r\n" +
+	"\v_api_key_id\",\n" +
+	"\x06Change\x12\x10\n" +
+	"\x03old\x18\x01 \x01(\tR\x03old\x12\x10\n" +
+	"\x03new\x18\x02 \x01(\tR\x03new\"\xa3\x03\n" +
+	"\x0fListLogsRequest\x12\x1e\n" +
+	"\badmin_id\x18\x01 \x01(\x05H\x00R\aadminId\x88\x01\x01\x12\x1e\n" +
+	"\border_id\x18\x02 \x01(\x05H\x01R\aorderId\x88\x01\x01\x12\x1b\n" +
+	"\x06method\x18\x03 \x01(\tH\x02R\x06method\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\x05H\x03R\x06status\x88\x01\x01\x127\n" +
+	"\tdate_from\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bdateFrom\x123\n" +
+	"\adate_to\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06dateTo\x12\"\n" +
+	"\n" +
+	"job_status\x18\a \x01(\x05H\x04R\tjobStatus\x88\x01\x01\x12\x19\n" +
+	"\x05count\x18\b \x01(\x05H\x05R\x05count\x88\x01\x01\x12\x17\n" +
+	"\x04page\x18\t \x01(\x05H\x06R\x04page\x88\x01\x01B\v\n" +
+	"\t_admin_idB\v\n" +
+	"\t_order_idB\t\n" +
+	"\a_methodB\t\n" +
+	"\a_statusB\r\n" +
+	"\v_job_statusB\b\n" +
+	"\x06_countB\a\n" +
+	"\x05_page\"8\n" +
+	"\x10ListLogsResponse\x12$\n" +
+	"\x04logs\x18\x01 \x03(\v2\x10.audit.proto.LogR\x04logs\"\x19\n" +
+	"\x17VerifyAuditChainRequest\"\x86\x01\n" +
+	"\x18VerifyAuditChainResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x05R\achecked\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\bR\x05valid\x12\"\n" +
+	"\rbroken_log_id\x18\x03 \x01(\x05R\vbrokenLogId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"*\n" +
+	"\x16RequeueDeadJobsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x05R\x03ids\"5\n" +
+	"\x17RequeueDeadJobsResponse\x12\x1a\n" +
+	"\brequeued\x18\x01 \x01(\x05R\brequeued2\x96\x02\n" +
+	"\fAuditService\x12G\n" +
+	"\bListLogs\x12\x1c.audit.proto.ListLogsRequest\x1a\x1d.audit.proto.ListLogsResponse\x12_\n" +
+	"\x10VerifyAuditChain\x12$.audit.proto.VerifyAuditChainRequest\x1a%.audit.proto.VerifyAuditChainResponse\x12\\\n" +
+	"\x0fRequeueDeadJobs\x12#.audit.proto.RequeueDeadJobsRequest\x1a$.audit.proto.RequeueDeadJobsResponseB\rZ\vaudit/protob\x06proto3"
+
+var (
+	file_api_audit_audit_proto_rawDescOnce sync.Once
+	file_api_audit_audit_proto_rawDescData []byte
+)
+
+func file_api_audit_audit_proto_rawDescGZIP() []byte {
+	file_api_audit_audit_proto_rawDescOnce.Do(func() {
+		file_api_audit_audit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_audit_audit_proto_rawDesc), len(file_api_audit_audit_proto_rawDesc)))
+	})
+	return file_api_audit_audit_proto_rawDescData
+}
+
+var file_api_audit_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_audit_audit_proto_goTypes = []any{
+	(*Log)(nil),                      // 0: audit.proto.Log
+	(*Change)(nil),                   // 1: audit.proto.Change
+	(*ListLogsRequest)(nil),          // 2: audit.proto.ListLogsRequest
+	(*ListLogsResponse)(nil),         // 3: audit.proto.ListLogsResponse
+	(*VerifyAuditChainRequest)(nil),  // 4: audit.proto.VerifyAuditChainRequest
+	(*VerifyAuditChainResponse)(nil), // 5: audit.proto.VerifyAuditChainResponse
+	(*RequeueDeadJobsRequest)(nil),   // 6: audit.proto.RequeueDeadJobsRequest
+	(*RequeueDeadJobsResponse)(nil),  // 7: audit.proto.RequeueDeadJobsResponse
+	nil,                              // 8: audit.proto.Log.DiffEntry
+	(*timestamppb.Timestamp)(nil),    // 9: google.protobuf.Timestamp
+}
+var file_api_audit_audit_proto_depIdxs = []int32{
+	9,  // 0: audit.proto.Log.date:type_name -> google.protobuf.Timestamp
+	9,  // 1: audit.proto.Log.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 2: audit.proto.Log.diff:type_name -> audit.proto.Log.DiffEntry
+	9,  // 3: audit.proto.Log.next_attempt_at:type_name -> google.protobuf.Timestamp
+	9,  // 4: audit.proto.ListLogsRequest.date_from:type_name -> google.protobuf.Timestamp
+	9,  // 5: audit.proto.ListLogsRequest.date_to:type_name -> google.protobuf.Timestamp
+	0,  // 6: audit.proto.ListLogsResponse.logs:type_name -> audit.proto.Log
+	1,  // 7: audit.proto.Log.DiffEntry.value:type_name -> audit.proto.Change
+	2,  // 8: audit.proto.AuditService.ListLogs:input_type -> audit.proto.ListLogsRequest
+	4,  // 9: audit.proto.AuditService.VerifyAuditChain:input_type -> audit.proto.VerifyAuditChainRequest
+	6,  // 10: audit.proto.AuditService.RequeueDeadJobs:input_type -> audit.proto.RequeueDeadJobsRequest
+	3,  // 11: audit.proto.AuditService.ListLogs:output_type -> audit.proto.ListLogsResponse
+	5,  // 12: audit.proto.AuditService.VerifyAuditChain:output_type -> audit.proto.VerifyAuditChainResponse
+	7,  // 13: audit.proto.AuditService.RequeueDeadJobs:output_type -> audit.proto.RequeueDeadJobsResponse
+	11, // [11:14] is the sub-list for method output_type
+	8,  // [8:11] is the sub-list for method input_type
+	8,  // [8:8] is the sub-list for extension type_name
+	8,  // [8:8] is the sub-list for extension extendee
+	0,  // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_api_audit_audit_proto_init() }
+func file_api_audit_audit_proto_init() {
+	if File_api_audit_audit_proto != nil {
+		return
+	}
+	file_api_audit_audit_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_audit_audit_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_audit_audit_proto_rawDesc), len(file_api_audit_audit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_audit_audit_proto_goTypes,
+		DependencyIndexes: file_api_audit_audit_proto_depIdxs,
+		MessageInfos:      file_api_audit_audit_proto_msgTypes,
+	}.Build()
+	File_api_audit_audit_proto = out.File
+	file_api_audit_audit_proto_goTypes = nil
+	file_api_audit_audit_proto_depIdxs = nil
+}
@@ -0,0 +1,348 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: api/auditevent/auditevent.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuditEvent is a value of audit log messages in Kafka, schema_version is increased on incompatible changes.
+// Fields are only added, numbers of removed fields are reserved and never reused,
+// so consumers of older versions skip fields they don't know
+type AuditEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SchemaVersion int32                  `protobuf:"varint,1,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	Id            int32                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// order_id is -1 for calls not about orders
+	OrderId int32 `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	AdminId int32 `protobuf:"varint,4,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	// api_key_id is set if call was made with an API key
+	ApiKeyId *int32                 `protobuf:"varint,5,opt,name=api_key_id,json=apiKeyId,proto3,oneof" json:"api_key_id,omitempty"`
+	Message  string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	Date     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`
+	Url      string                 `protobuf:"bytes,8,opt,name=url,proto3" json:"url,omitempty"`
+	// http method or GRPC
+	Method        string                 `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
+	Status        int32                  `protobuf:"varint,10,opt,name=status,proto3" json:"status,omitempty"`
+	JobStatus     int32                  `protobuf:"varint,11,opt,name=job_status,json=jobStatus,proto3" json:"job_status,omitempty"`
+	AttemptsLeft  int32                  `protobuf:"varint,12,opt,name=attempts_left,json=attemptsLeft,proto3" json:"attempts_left,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	NextAttemptAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=next_attempt_at,json=nextAttemptAt,proto3" json:"next_attempt_at,omitempty"`
+	// diff maps changed fields to their old and new values
+	Diff          map[string]*Change `protobuf:"bytes,15,rep,name=diff,proto3" json:"diff,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	PrevHash      string             `protobuf:"bytes,16,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	Hash          string             `protobuf:"bytes,17,opt,name=hash,proto3" json:"hash,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditEvent) Reset() {
+	*x = AuditEvent{}
+	mi := &file_api_auditevent_auditevent_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditEvent) ProtoMessage() {}
+
+func (x *AuditEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auditevent_auditevent_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditEvent.ProtoReflect.Descriptor instead.
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return file_api_auditevent_auditevent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AuditEvent) GetSchemaVersion() int32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetOrderId() int32 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAdminId() int32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetApiKeyId() int32 {
+	if x != nil && x.ApiKeyId != nil {
+		return *x.ApiKeyId
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Date
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetJobStatus() int32 {
+	if x != nil {
+		return x.JobStatus
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetAttemptsLeft() int32 {
+	if x != nil {
+		return x.AttemptsLeft
+	}
+	return 0
+}
+
+func (x *AuditEvent) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetNextAttemptAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetDiff() map[string]*Change {
+	if x != nil {
+		return x.Diff
+	}
+	return nil
+}
+
+func (x *AuditEvent) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *AuditEvent) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+// Change holds JSON encoded values of a field, old is null for created and new is null for deleted entities
+type Change struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Old           string                 `protobuf:"bytes,1,opt,name=old,proto3" json:"old,omitempty"`
+	New           string                 `protobuf:"bytes,2,opt,name=new,proto3" json:"new,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Change) Reset() {
+	*x = Change{}
+	mi := &file_api_auditevent_auditevent_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Change) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Change) ProtoMessage() {}
+
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_api_auditevent_auditevent_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Change.ProtoReflect.Descriptor instead.
+func (*Change) Descriptor() ([]byte, []int) {
+	return file_api_auditevent_auditevent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Change) GetOld() string {
+	if x != nil {
+		return x.Old
+	}
+	return ""
+}
+
+func (x *Change) GetNew() string {
+	if x != nil {
+		return x.New
+	}
+	return ""
+}
+
+var File_api_auditevent_auditevent_proto protoreflect.FileDescriptor
+
+const file_api_auditevent_auditevent_proto_rawDesc = "" +
+	"\n" +
+	"\x1fapi/auditevent/auditevent.proto\x12\x10auditevent.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x05\n" +
+	"\n" +
+	"AuditEvent\x12%\n" +
+	"\x0eschema_version\x18\x01 \x01(\x05R\rschemaVersion\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x05R\x02id\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x05R\aorderId\x12\x19\n" +
+	"\badmin_id\x18\x04 \x01(\x05R\aadminId\x12!\n" +
+	"\n" +
+	"api_key_id\x18\x05 \x01(\x05H\x00R\bapiKeyId\x88\x01\x01\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12.\n" +
+	"\x04date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x04date\x12\x10\n" +
+	"\x03url\x18\b \x01(\tR\x03url\x12\x16\n" +
+	"\x06method\x18\t \x01(\tR\x06method\x12\x16\n" +
+	"\x06status\x18\n" +
+	" \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"job_status\x18\v \x01(\x05R\tjobStatus\x12#\n" +
+	"\rattempts_left\x18\f \x01(\x05R\fattemptsLeft\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12B\n" +
+	"\x0fnext_attempt_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\rnextAttemptAt\x12:\n" +
+	"\x04diff\x18\x0f \x03(\v2&.auditevent.proto.AuditEvent.DiffEntryR\x04diff\x12\x1b\n" +
+	"\tprev_hash\x18\x10 \x01(\tR\bprevHash\x12\x12\n" +
+	"\x04hash\x18\x11 \x01(\tR\x04hash\x1aQ\n" +
+	"\tDiffEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12.\n" +
+	"\x05value\x18\x02 \x01(\v2\x18.auditevent.proto.ChangeR\x05value:\x028\x01B\r\n" +
+	"\v_api_key_id\",\n" +
+	"\x06Change\x12\x10\n" +
+	"\x03old\x18\x01 \x01(\tR\x03old\x12\x10\n" +
+	"\x03new\x18\x02 \x01(\tR\x03newB\x12Z\x10auditevent/protob\x06proto3"
+
+var (
+	file_api_auditevent_auditevent_proto_rawDescOnce sync.Once
+	file_api_auditevent_auditevent_proto_rawDescData []byte
+)
+
+func file_api_auditevent_auditevent_proto_rawDescGZIP() []byte {
+	file_api_auditevent_auditevent_proto_rawDescOnce.Do(func() {
+		file_api_auditevent_auditevent_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_auditevent_auditevent_proto_rawDesc), len(file_api_auditevent_auditevent_proto_rawDesc)))
+	})
+	return file_api_auditevent_auditevent_proto_rawDescData
+}
+
+var file_api_auditevent_auditevent_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_api_auditevent_auditevent_proto_goTypes = []any{
+	(*AuditEvent)(nil),            // 0: auditevent.proto.AuditEvent
+	(*Change)(nil),                // 1: auditevent.proto.Change
+	nil,                           // 2: auditevent.proto.AuditEvent.DiffEntry
+	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+}
+var file_api_auditevent_auditevent_proto_depIdxs = []int32{
+	3, // 0: auditevent.proto.AuditEvent.date:type_name -> google.protobuf.Timestamp
+	3, // 1: auditevent.proto.AuditEvent.updated_at:type_name -> google.protobuf.Timestamp
+	3, // 2: auditevent.proto.AuditEvent.next_attempt_at:type_name -> google.protobuf.Timestamp
+	2, // 3: auditevent.proto.AuditEvent.diff:type_name -> auditevent.proto.AuditEvent.DiffEntry
+	1, // 4: auditevent.proto.AuditEvent.DiffEntry.value:type_name -> auditevent.proto.Change
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_api_auditevent_auditevent_proto_init() }
+func file_api_auditevent_auditevent_proto_init() {
+	if File_api_auditevent_auditevent_proto != nil {
+		return
+	}
+	file_api_auditevent_auditevent_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_auditevent_auditevent_proto_rawDesc), len(file_api_auditevent_auditevent_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_auditevent_auditevent_proto_goTypes,
+		DependencyIndexes: file_api_auditevent_auditevent_proto_depIdxs,
+		MessageInfos:      file_api_auditevent_auditevent_proto_msgTypes,
+	}.Build()
+	File_api_auditevent_auditevent_proto = out.File
+	file_api_auditevent_auditevent_proto_goTypes = nil
+	file_api_auditevent_auditevent_proto_depIdxs = nil
+}
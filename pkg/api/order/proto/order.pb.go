@@ -34,6 +34,7 @@ type Order struct {
 	ArrivalDate    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=arrival_date,json=arrivalDate,proto3" json:"arrival_date,omitempty"`
 	ExpiryDate     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
 	LastChange     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=last_change,json=lastChange,proto3" json:"last_change,omitempty"`
+	Currency       string                 `protobuf:"bytes,11,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -138,6 +139,13 @@ func (x *Order) GetLastChange() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Order) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateOrderRequest struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -147,6 +155,7 @@ type CreateOrderRequest struct {
 	ExpiryDate     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expiry_date,json=expiryDate,proto3" json:"expiry_date,omitempty"`
 	Packaging      int32                  `protobuf:"varint,6,opt,name=packaging,proto3" json:"packaging,omitempty"`
 	ExtraPackaging int32                  `protobuf:"varint,7,opt,name=extra_packaging,json=extraPackaging,proto3" json:"extra_packaging,omitempty"`
+	Currency       string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -230,9 +239,17 @@ func (x *CreateOrderRequest) GetExtraPackaging() int32 {
 	return 0
 }
 
+func (x *CreateOrderRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CreateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,2,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -274,11 +291,19 @@ func (x *CreateOrderResponse) GetOutput() string {
 	return ""
 }
 
+func (x *CreateOrderResponse) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
 type UpdateOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	UserId        int32                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	PickupCode    string                 `protobuf:"bytes,4,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -334,6 +359,13 @@ func (x *UpdateOrderRequest) GetAction() string {
 	return ""
 }
 
+func (x *UpdateOrderRequest) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
 type UpdateOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Output        string                 `protobuf:"bytes,1,opt,name=output,proto3" json:"output,omitempty"`
@@ -485,6 +517,8 @@ type GetOrdersRequest struct {
 	ExpiryDateFrom  *timestamppb.Timestamp `protobuf:"bytes,15,opt,name=expiry_date_from,json=expiryDateFrom,proto3,oneof" json:"expiry_date_from,omitempty"`
 	Count           *int32                 `protobuf:"varint,16,opt,name=count,proto3,oneof" json:"count,omitempty"`
 	Page            *int32                 `protobuf:"varint,17,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	Currency        *string                `protobuf:"bytes,18,opt,name=currency,proto3,oneof" json:"currency,omitempty"`
+	Phone           *string                `protobuf:"bytes,19,opt,name=phone,proto3,oneof" json:"phone,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -638,9 +672,25 @@ func (x *GetOrdersRequest) GetPage() int32 {
 	return 0
 }
 
+func (x *GetOrdersRequest) GetCurrency() string {
+	if x != nil && x.Currency != nil {
+		return *x.Currency
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetPhone() string {
+	if x != nil && x.Phone != nil {
+		return *x.Phone
+	}
+	return ""
+}
+
 type GetOrdersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Orders        []*Order               `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int64                  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	TotalCurrency string                 `protobuf:"bytes,4,opt,name=total_currency,json=totalCurrency,proto3" json:"total_currency,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -682,11 +732,113 @@ func (x *GetOrdersResponse) GetOrders() []*Order {
 	return nil
 }
 
+func (x *GetOrdersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetOrdersResponse) GetTotalCurrency() string {
+	if x != nil {
+		return x.TotalCurrency
+	}
+	return ""
+}
+
+type RegenerateCodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateCodeRequest) Reset() {
+	*x = RegenerateCodeRequest{}
+	mi := &file_api_order_order_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateCodeRequest) ProtoMessage() {}
+
+func (x *RegenerateCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_order_order_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateCodeRequest.ProtoReflect.Descriptor instead.
+func (*RegenerateCodeRequest) Descriptor() ([]byte, []int) {
+	return file_api_order_order_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RegenerateCodeRequest) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RegenerateCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PickupCode    string                 `protobuf:"bytes,1,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegenerateCodeResponse) Reset() {
+	*x = RegenerateCodeResponse{}
+	mi := &file_api_order_order_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegenerateCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegenerateCodeResponse) ProtoMessage() {}
+
+func (x *RegenerateCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_order_order_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegenerateCodeResponse.ProtoReflect.Descriptor instead.
+func (*RegenerateCodeResponse) Descriptor() ([]byte, []int) {
+	return file_api_order_order_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RegenerateCodeResponse) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
 var File_api_order_order_proto protoreflect.FileDescriptor
 
 const file_api_order_order_proto_rawDesc = "" +
 	"\n" +
-	"\x15api/order/order.proto\x12\vorder.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf6\x02\n" +
+	"\x15api/order/order.proto\x12\vorder.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x03\n" +
 	"\x05order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x16\n" +
@@ -700,7 +852,8 @@ const file_api_order_order_proto_rawDesc = "" +
 	"expiryDate\x12;\n" +
 	"\vlast_change\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"lastChange\"\xef\x01\n" +
+	"lastChange\x12\x1a\n" +
+	"\bcurrency\x18\v \x01(\tR\bcurrency\"\x8b\x02\n" +
 	"\x12CreateOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x16\n" +
@@ -709,19 +862,24 @@ const file_api_order_order_proto_rawDesc = "" +
 	"\vexpiry_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
 	"expiryDate\x12\x1c\n" +
 	"\tpackaging\x18\x06 \x01(\x05R\tpackaging\x12'\n" +
-	"\x0fextra_packaging\x18\a \x01(\x05R\x0eextraPackaging\"-\n" +
+	"\x0fextra_packaging\x18\a \x01(\x05R\x0eextraPackaging\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\"N\n" +
 	"\x13CreateOrderResponse\x12\x16\n" +
-	"\x06output\x18\x01 \x01(\tR\x06output\"U\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\x12\x1f\n" +
+	"\vpickup_code\x18\x02 \x01(\tR\n" +
+	"pickupCode\"v\n" +
 	"\x12UpdateOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x05R\x06userId\x12\x16\n" +
-	"\x06action\x18\x03 \x01(\tR\x06action\"-\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x1f\n" +
+	"\vpickup_code\x18\x04 \x01(\tR\n" +
+	"pickupCode\"-\n" +
 	"\x13UpdateOrderResponse\x12\x16\n" +
 	"\x06output\x18\x01 \x01(\tR\x06output\"$\n" +
 	"\x12DeleteOrderRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x05R\x02id\"-\n" +
 	"\x13DeleteOrderResponse\x12\x16\n" +
-	"\x06output\x18\x01 \x01(\tR\x06output\"\xfb\a\n" +
+	"\x06output\x18\x01 \x01(\tR\x06output\"\xce\b\n" +
 	"\x10GetOrdersRequest\x12\x13\n" +
 	"\x02id\x18\x01 \x01(\x05H\x00R\x02id\x88\x01\x01\x12\x1c\n" +
 	"\auser_id\x18\x02 \x01(\x05H\x01R\x06userId\x88\x01\x01\x12\x1b\n" +
@@ -744,7 +902,9 @@ const file_api_order_order_proto_rawDesc = "" +
 	"\x0eexpiry_date_to\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampH\rR\fexpiryDateTo\x88\x01\x01\x12I\n" +
 	"\x10expiry_date_from\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampH\x0eR\x0eexpiryDateFrom\x88\x01\x01\x12\x19\n" +
 	"\x05count\x18\x10 \x01(\x05H\x0fR\x05count\x88\x01\x01\x12\x17\n" +
-	"\x04page\x18\x11 \x01(\x05H\x10R\x04page\x88\x01\x01B\x05\n" +
+	"\x04page\x18\x11 \x01(\x05H\x10R\x04page\x88\x01\x01\x12\x1f\n" +
+	"\bcurrency\x18\x12 \x01(\tH\x11R\bcurrency\x88\x01\x01\x12\x19\n" +
+	"\x05phone\x18\x13 \x01(\tH\x12R\x05phone\x88\x01\x01B\x05\n" +
 	"\x03_idB\n" +
 	"\n" +
 	"\b_user_idB\t\n" +
@@ -763,14 +923,24 @@ const file_api_order_order_proto_rawDesc = "" +
 	"\x0f_expiry_date_toB\x13\n" +
 	"\x11_expiry_date_fromB\b\n" +
 	"\x06_countB\a\n" +
-	"\x05_page\"?\n" +
+	"\x05_pageB\v\n" +
+	"\t_currencyB\b\n" +
+	"\x06_phone\"|\n" +
 	"\x11GetOrdersResponse\x12*\n" +
-	"\x06orders\x18\x02 \x03(\v2\x12.order.proto.orderR\x06orders2\xd0\x02\n" +
+	"\x06orders\x18\x02 \x03(\v2\x12.order.proto.orderR\x06orders\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\x03R\x05total\x12%\n" +
+	"\x0etotal_currency\x18\x04 \x01(\tR\rtotalCurrency\"'\n" +
+	"\x15RegenerateCodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x05R\x02id\"9\n" +
+	"\x16RegenerateCodeResponse\x12\x1f\n" +
+	"\vpickup_code\x18\x01 \x01(\tR\n" +
+	"pickupCode2\xab\x03\n" +
 	"\fOrderService\x12P\n" +
 	"\vCreateOrder\x12\x1f.order.proto.CreateOrderRequest\x1a .order.proto.CreateOrderResponse\x12P\n" +
 	"\vUpdateOrder\x12\x1f.order.proto.UpdateOrderRequest\x1a .order.proto.UpdateOrderResponse\x12P\n" +
 	"\vDeleteOrder\x12\x1f.order.proto.DeleteOrderRequest\x1a .order.proto.DeleteOrderResponse\x12J\n" +
-	"\tGetOrders\x12\x1d.order.proto.GetOrdersRequest\x1a\x1e.order.proto.GetOrdersResponseB\rZ\vorder/protob\x06proto3"
+	"\tGetOrders\x12\x1d.order.proto.GetOrdersRequest\x1a\x1e.order.proto.GetOrdersResponse\x12Y\n" +
+	"\x0eRegenerateCode\x12\".order.proto.RegenerateCodeRequest\x1a#.order.proto.RegenerateCodeResponseB\rZ\vorder/protob\x06proto3"
 
 var (
 	file_api_order_order_proto_rawDescOnce sync.Once
@@ -784,41 +954,45 @@ func file_api_order_order_proto_rawDescGZIP() []byte {
 	return file_api_order_order_proto_rawDescData
 }
 
-var file_api_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_order_order_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_api_order_order_proto_goTypes = []any{
-	(*Order)(nil),                 // 0: order.proto.order
-	(*CreateOrderRequest)(nil),    // 1: order.proto.CreateOrderRequest
-	(*CreateOrderResponse)(nil),   // 2: order.proto.CreateOrderResponse
-	(*UpdateOrderRequest)(nil),    // 3: order.proto.UpdateOrderRequest
-	(*UpdateOrderResponse)(nil),   // 4: order.proto.UpdateOrderResponse
-	(*DeleteOrderRequest)(nil),    // 5: order.proto.DeleteOrderRequest
-	(*DeleteOrderResponse)(nil),   // 6: order.proto.DeleteOrderResponse
-	(*GetOrdersRequest)(nil),      // 7: order.proto.GetOrdersRequest
-	(*GetOrdersResponse)(nil),     // 8: order.proto.GetOrdersResponse
-	(*timestamppb.Timestamp)(nil), // 9: google.protobuf.Timestamp
+	(*Order)(nil),                  // 0: order.proto.order
+	(*CreateOrderRequest)(nil),     // 1: order.proto.CreateOrderRequest
+	(*CreateOrderResponse)(nil),    // 2: order.proto.CreateOrderResponse
+	(*UpdateOrderRequest)(nil),     // 3: order.proto.UpdateOrderRequest
+	(*UpdateOrderResponse)(nil),    // 4: order.proto.UpdateOrderResponse
+	(*DeleteOrderRequest)(nil),     // 5: order.proto.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),    // 6: order.proto.DeleteOrderResponse
+	(*GetOrdersRequest)(nil),       // 7: order.proto.GetOrdersRequest
+	(*GetOrdersResponse)(nil),      // 8: order.proto.GetOrdersResponse
+	(*RegenerateCodeRequest)(nil),  // 9: order.proto.RegenerateCodeRequest
+	(*RegenerateCodeResponse)(nil), // 10: order.proto.RegenerateCodeResponse
+	(*timestamppb.Timestamp)(nil),  // 11: google.protobuf.Timestamp
 }
 var file_api_order_order_proto_depIdxs = []int32{
-	9,  // 0: order.proto.order.arrival_date:type_name -> google.protobuf.Timestamp
-	9,  // 1: order.proto.order.expiry_date:type_name -> google.protobuf.Timestamp
-	9,  // 2: order.proto.order.last_change:type_name -> google.protobuf.Timestamp
-	9,  // 3: order.proto.CreateOrderRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	9,  // 4: order.proto.GetOrdersRequest.arrival_date:type_name -> google.protobuf.Timestamp
-	9,  // 5: order.proto.GetOrdersRequest.arrival_date_to:type_name -> google.protobuf.Timestamp
-	9,  // 6: order.proto.GetOrdersRequest.arrival_date_from:type_name -> google.protobuf.Timestamp
-	9,  // 7: order.proto.GetOrdersRequest.expiry_date:type_name -> google.protobuf.Timestamp
-	9,  // 8: order.proto.GetOrdersRequest.expiry_date_to:type_name -> google.protobuf.Timestamp
-	9,  // 9: order.proto.GetOrdersRequest.expiry_date_from:type_name -> google.protobuf.Timestamp
+	11, // 0: order.proto.order.arrival_date:type_name -> google.protobuf.Timestamp
+	11, // 1: order.proto.order.expiry_date:type_name -> google.protobuf.Timestamp
+	11, // 2: order.proto.order.last_change:type_name -> google.protobuf.Timestamp
+	11, // 3: order.proto.CreateOrderRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	11, // 4: order.proto.GetOrdersRequest.arrival_date:type_name -> google.protobuf.Timestamp
+	11, // 5: order.proto.GetOrdersRequest.arrival_date_to:type_name -> google.protobuf.Timestamp
+	11, // 6: order.proto.GetOrdersRequest.arrival_date_from:type_name -> google.protobuf.Timestamp
+	11, // 7: order.proto.GetOrdersRequest.expiry_date:type_name -> google.protobuf.Timestamp
+	11, // 8: order.proto.GetOrdersRequest.expiry_date_to:type_name -> google.protobuf.Timestamp
+	11, // 9: order.proto.GetOrdersRequest.expiry_date_from:type_name -> google.protobuf.Timestamp
 	0,  // 10: order.proto.GetOrdersResponse.orders:type_name -> order.proto.order
 	1,  // 11: order.proto.OrderService.CreateOrder:input_type -> order.proto.CreateOrderRequest
 	3,  // 12: order.proto.OrderService.UpdateOrder:input_type -> order.proto.UpdateOrderRequest
 	5,  // 13: order.proto.OrderService.DeleteOrder:input_type -> order.proto.DeleteOrderRequest
 	7,  // 14: order.proto.OrderService.GetOrders:input_type -> order.proto.GetOrdersRequest
-	2,  // 15: order.proto.OrderService.CreateOrder:output_type -> order.proto.CreateOrderResponse
-	4,  // 16: order.proto.OrderService.UpdateOrder:output_type -> order.proto.UpdateOrderResponse
-	6,  // 17: order.proto.OrderService.DeleteOrder:output_type -> order.proto.DeleteOrderResponse
-	8,  // 18: order.proto.OrderService.GetOrders:output_type -> order.proto.GetOrdersResponse
-	15, // [15:19] is the sub-list for method output_type
-	11, // [11:15] is the sub-list for method input_type
+	9,  // 15: order.proto.OrderService.RegenerateCode:input_type -> order.proto.RegenerateCodeRequest
+	2,  // 16: order.proto.OrderService.CreateOrder:output_type -> order.proto.CreateOrderResponse
+	4,  // 17: order.proto.OrderService.UpdateOrder:output_type -> order.proto.UpdateOrderResponse
+	6,  // 18: order.proto.OrderService.DeleteOrder:output_type -> order.proto.DeleteOrderResponse
+	8,  // 19: order.proto.OrderService.GetOrders:output_type -> order.proto.GetOrdersResponse
+	10, // 20: order.proto.OrderService.RegenerateCode:output_type -> order.proto.RegenerateCodeResponse
+	16, // [16:21] is the sub-list for method output_type
+	11, // [11:16] is the sub-list for method input_type
 	11, // [11:11] is the sub-list for extension type_name
 	11, // [11:11] is the sub-list for extension extendee
 	0,  // [0:11] is the sub-list for field type_name
@@ -836,7 +1010,7 @@ func file_api_order_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_order_order_proto_rawDesc), len(file_api_order_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,10 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName = "/order.proto.OrderService/CreateOrder"
-	OrderService_UpdateOrder_FullMethodName = "/order.proto.OrderService/UpdateOrder"
-	OrderService_DeleteOrder_FullMethodName = "/order.proto.OrderService/DeleteOrder"
-	OrderService_GetOrders_FullMethodName   = "/order.proto.OrderService/GetOrders"
+	OrderService_CreateOrder_FullMethodName    = "/order.proto.OrderService/CreateOrder"
+	OrderService_UpdateOrder_FullMethodName    = "/order.proto.OrderService/UpdateOrder"
+	OrderService_DeleteOrder_FullMethodName    = "/order.proto.OrderService/DeleteOrder"
+	OrderService_GetOrders_FullMethodName      = "/order.proto.OrderService/GetOrders"
+	OrderService_RegenerateCode_FullMethodName = "/order.proto.OrderService/RegenerateCode"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -33,6 +34,7 @@ type OrderServiceClient interface {
 	UpdateOrder(ctx context.Context, in *UpdateOrderRequest, opts ...grpc.CallOption) (*UpdateOrderResponse, error)
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
+	RegenerateCode(ctx context.Context, in *RegenerateCodeRequest, opts ...grpc.CallOption) (*RegenerateCodeResponse, error)
 }
 
 type orderServiceClient struct {
@@ -83,6 +85,16 @@ func (c *orderServiceClient) GetOrders(ctx context.Context, in *GetOrdersRequest
 	return out, nil
 }
 
+func (c *orderServiceClient) RegenerateCode(ctx context.Context, in *RegenerateCodeRequest, opts ...grpc.CallOption) (*RegenerateCodeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegenerateCodeResponse)
+	err := c.cc.Invoke(ctx, OrderService_RegenerateCode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -91,6 +103,7 @@ type OrderServiceServer interface {
 	UpdateOrder(context.Context, *UpdateOrderRequest) (*UpdateOrderResponse, error)
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	RegenerateCode(context.Context, *RegenerateCodeRequest) (*RegenerateCodeResponse, error)
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -113,6 +126,9 @@ func (UnimplementedOrderServiceServer) DeleteOrder(context.Context, *DeleteOrder
 func (UnimplementedOrderServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
 }
+func (UnimplementedOrderServiceServer) RegenerateCode(context.Context, *RegenerateCodeRequest) (*RegenerateCodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegenerateCode not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -206,6 +222,24 @@ func _OrderService_GetOrders_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_RegenerateCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegenerateCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RegenerateCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RegenerateCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RegenerateCode(ctx, req.(*RegenerateCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +263,10 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrders",
 			Handler:    _OrderService_GetOrders_Handler,
 		},
+		{
+			MethodName: "RegenerateCode",
+			Handler:    _OrderService_RegenerateCode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/order/order.proto",
@@ -22,6 +22,11 @@ var (
 		Help:    "Request duration in seconds",
 		Buckets: []float64{0.1, 0.5, 1, 2, 5},
 	})
+	responseTimeSummary = prometheus.NewSummary(prometheus.SummaryOpts{
+		Name:       "response_time_seconds",
+		Help:       "Summary of response times in seconds",
+		Objectives: map[float64]float64{0.5: 0.05, 0.9: 0.01, 0.99: 0.001},
+	})
 	ordersReturned = prometheus.NewCounter(prometheus.CounterOpts{
 		Name: "returns_rate_total",
 		Help: "Rate of product returns",
@@ -50,6 +55,22 @@ var (
 		},
 		[]string{"method"},
 	)
+	auditQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "audit_queue_depth",
+		Help: "Number of audit logs waiting in a sink queue",
+	}, []string{"sink"})
+	auditDropped = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_dropped_total",
+		Help: "Total number of audit logs dropped by a sink queue, labeled by reason",
+	}, []string{"sink", "reason"})
+	auditSpilled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_spilled_total",
+		Help: "Total number of audit logs written straight to db since a sink queue was full",
+	}, []string{"sink"})
+	auditFailedBatches = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "audit_failed_batches_total",
+		Help: "Total number of audit log batches a sink failed to write",
+	}, []string{"sink"})
 )
 
 // SetRequestCounter updates request count metric
@@ -67,6 +88,11 @@ func RequestDuration(duration float64) {
 	requestDuration.Observe(duration)
 }
 
+// SetResponseTimeSummary updates response time summary metric
+func SetResponseTimeSummary(duration float64) {
+	responseTimeSummary.Observe(duration)
+}
+
 // SetOrdersReturned updates returned orders metric
 func SetOrdersReturned() {
 	ordersReturned.Inc()
@@ -97,17 +123,42 @@ func SetGrpcRequestByMethodCount(method string) {
 	grpcRequestByMethod.WithLabelValues(method).Inc()
 }
 
+// SetAuditQueueDepth updates audit sink queue depth metric
+func SetAuditQueueDepth(sink string, depth int) {
+	auditQueueDepth.WithLabelValues(sink).Set(float64(depth))
+}
+
+// SetAuditDropped updates dropped audit logs metric
+func SetAuditDropped(sink string, reason string) {
+	auditDropped.WithLabelValues(sink, reason).Inc()
+}
+
+// SetAuditSpilled updates spilled audit logs metric
+func SetAuditSpilled(sink string) {
+	auditSpilled.WithLabelValues(sink).Inc()
+}
+
+// SetAuditFailedBatch updates failed audit log batches metric
+func SetAuditFailedBatch(sink string) {
+	auditFailedBatches.WithLabelValues(sink).Inc()
+}
+
 func init() {
 	prometheus.MustRegister(
 		requestCounter,
 		errorCounter,
 		requestDuration,
+		responseTimeSummary,
 		ordersReturned,
 		orderTotalPrice,
 		ordersCreated,
 		packagingUsage,
 		grpcRequestByStatusCount,
 		grpcRequestByMethod,
+		auditQueueDepth,
+		auditDropped,
+		auditSpilled,
+		auditFailedBatches,
 	)
 }
 
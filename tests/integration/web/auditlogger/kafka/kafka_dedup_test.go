@@ -0,0 +1,161 @@
+//go:build integration
+
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/stretchr/testify/require"
+
+	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres/repository"
+	"gitlab.ozon.dev/alexplay1224/homework/tests/integration"
+)
+
+// waitForLogs waits until logs have expected job status and returns them
+func waitForLogs(t *testing.T, logsRepo *repository.LogsRepo, jobStatus int) []models.Log {
+	t.Helper()
+
+	var logs []models.Log
+	require.Eventually(t, func() bool {
+		var err error
+		logs, err = logsRepo.GetLogs(t.Context())
+		require.NoError(t, err)
+
+		for _, log := range logs {
+			if log.JobStatus != jobStatus {
+				return false
+			}
+		}
+
+		return len(logs) != 0
+	}, 15*time.Second, 200*time.Millisecond)
+
+	return logs
+}
+
+// waitForCommit waits until consumer group commits offsets past lastOffsets of logs topic partitions,
+// consumer commits only logs updater has processed, so messages up to them are applied by then
+func waitForCommit(t *testing.T, cfg config.Config, lastOffsets map[int32]int64) {
+	t.Helper()
+
+	admin, err := sarama.NewClusterAdmin([]string{cfg.KafkaHost() + ":" + cfg.KafkaPort()}, sarama.NewConfig())
+	require.NoError(t, err)
+	defer admin.Close()
+
+	partitions := make([]int32, 0, len(lastOffsets))
+	for partition := range lastOffsets {
+		partitions = append(partitions, partition)
+	}
+
+	require.Eventually(t, func() bool {
+		offsets, err := admin.ListConsumerGroupOffsets(cfg.KafkaConsumerGroup(),
+			map[string][]int32{cfg.KafkaLogsTopic(): partitions})
+		require.NoError(t, err)
+
+		for partition, lastOffset := range lastOffsets {
+			// committed offset is the one of the next message to consume
+			block := offsets.GetBlock(cfg.KafkaLogsTopic(), partition)
+			if block == nil || block.Offset <= lastOffset {
+				return false
+			}
+		}
+
+		return true
+	}, 15*time.Second, 200*time.Millisecond)
+}
+
+// TestKafka_Dedup checks that logs delivered more than once change job status only once,
+// it isn't parallel, since containers use fixed ports
+func TestKafka_Dedup(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	rootDir, err := config.GetRootDir()
+	require.NoError(t, err)
+	err = config.InitEnv(rootDir + "/.env.test")
+	require.NoError(t, err)
+
+	cfg := config.NewConfig()
+
+	connStr, pgContainer, err := integration.InitPostgresContainer(t.Context(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, pgContainer.Terminate(context.Background()))
+	})
+	db, err := postgres.NewDB(t.Context(), connStr)
+	require.NoError(t, err)
+
+	kafkaContainer, err := integration.InitKafkaContainer(t.Context(), cfg)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, kafkaContainer.Terminate(context.Background()))
+	})
+
+	logsRepo := repository.NewLogsRepo(db, cfg.AuditMaxAttempts())
+	broker, err := kafka.NewBroker(cfg)
+	require.NoError(t, err)
+	_, err = auditlogger.NewService(ctx, cfg, logsRepo, broker, 1, 1, 1*time.Second)
+	require.NoError(t, err)
+
+	err = logsRepo.CreateJob(ctx, []models.Log{
+		{AdminID: 1, JobStatus: models.CreatedStatus, AttemptsLeft: 3},
+		{AdminID: 1, JobStatus: models.CreatedStatus, AttemptsLeft: 3},
+		{AdminID: 1, JobStatus: models.CreatedStatus, AttemptsLeft: 3},
+	})
+	require.NoError(t, err)
+
+	sent := waitForLogs(t, logsRepo, models.DoneStatus)
+
+	kafkaConfig := sarama.NewConfig()
+	kafkaConfig.Producer.Return.Successes = true
+	producer, err := sarama.NewSyncProducer([]string{cfg.KafkaHost() + ":" + cfg.KafkaPort()}, kafkaConfig)
+	require.NoError(t, err)
+	defer producer.Close()
+
+	lastOffsets := make(map[int32]int64)
+	for _, log := range sent {
+		// redelivered log has a stale body, but the same dedup key
+		stale := log
+		stale.ID = 0
+		stale.AttemptsLeft = 1
+		value, err := json.Marshal(stale)
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			partition, offset, err := producer.SendMessage(&sarama.ProducerMessage{
+				Topic: cfg.KafkaLogsTopic(),
+				Key:   sarama.StringEncoder(strconv.Itoa(log.ID)),
+				Value: sarama.ByteEncoder(value),
+				Headers: []sarama.RecordHeader{
+					{Key: []byte("dedup-key"), Value: []byte("audit-log-" + strconv.Itoa(log.ID))},
+				},
+			})
+			require.NoError(t, err)
+			lastOffsets[partition] = max(lastOffsets[partition], offset)
+		}
+
+		// failure of a resend that comes after the log is done
+		require.NoError(t, logsRepo.UpdateLog(ctx, log.ID, models.FailedStatus, log.AttemptsLeft-1,
+			time.Now()))
+	}
+
+	waitForCommit(t, cfg, lastOffsets)
+
+	logs, err := logsRepo.GetLogs(ctx)
+	require.NoError(t, err)
+	require.Len(t, logs, len(sent))
+	for _, log := range logs {
+		require.Equal(t, models.DoneStatus, log.JobStatus)
+		require.Equal(t, 3, log.AttemptsLeft)
+	}
+}
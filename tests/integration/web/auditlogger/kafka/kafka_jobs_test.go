@@ -12,6 +12,7 @@ import (
 	"gitlab.ozon.dev/alexplay1224/homework/internal/config"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/models"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger"
+	"gitlab.ozon.dev/alexplay1224/homework/internal/service/auditlogger/kafka"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres"
 	"gitlab.ozon.dev/alexplay1224/homework/internal/storage/postgres/repository"
 	"gitlab.ozon.dev/alexplay1224/homework/tests/integration"
@@ -277,8 +278,10 @@ func TestKafka_Jobs(t *testing.T) {
 	_, err = integration.InitKafkaContainer(t.Context(), cfg)
 	require.NoError(t, err)
 
-	logsRepo := repository.NewLogsRepo(db)
-	_, err = auditlogger.NewService(ctx, cfg, logsRepo, 1, 1, 1*time.Second)
+	logsRepo := repository.NewLogsRepo(db, cfg.AuditMaxAttempts())
+	broker, err := kafka.NewBroker(cfg)
+	require.NoError(t, err)
+	_, err = auditlogger.NewService(ctx, cfg, logsRepo, broker, 1, 1, 1*time.Second)
 	require.NoError(t, err)
 
 	currentLogs := make([]models.Log, 0, 18)
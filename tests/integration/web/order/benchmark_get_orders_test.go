@@ -86,10 +86,21 @@ func BenchmarkOrderHandler_GetOrders_Cache(b *testing.B) {
 	adminsRepo := repository.NewAdminsRepo(logger, db)
 	adminsFacade := facade.NewAdminFacade(adminsRepo, 10000)
 
-	logsRepo := repository.NewLogsRepo(db)
+	clientsRepo := repository.NewClientsRepo(logger, db)
+	pickupCodesRepo := repository.NewPickupCodesRepo(logger, db)
+	notificationsRepo := repository.NewNotificationsRepo(logger, db)
+	webhooksRepo := repository.NewWebhooksRepo(logger, db)
+	orderEventsRepo := repository.NewOrderEventsRepo(logger, db, 5)
+	tokensRepo := repository.NewTokensRepo(logger, db)
+	loginAttemptsRepo := repository.NewLoginAttemptsRepo(logger, db)
 
-	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersFacade, adminsFacade, logsRepo, txManager,
-		2, 5, 500*time.Millisecond)
+	apiKeysRepo := repository.NewAPIKeysRepo(logger, db)
+
+	logsRepo := repository.NewLogsRepo(db, 3)
+
+	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersFacade, adminsFacade, clientsRepo, pickupCodesRepo,
+		notificationsRepo, webhooksRepo, orderEventsRepo, tokensRepo, loginAttemptsRepo, apiKeysRepo, logsRepo,
+		nil, txManager, 2, 5, 500*time.Millisecond)
 	app.SetupRoutes(ctx)
 
 	server := httptest.NewServer(app.Router)
@@ -133,10 +144,21 @@ func BenchmarkOrderHandler_GetOrders_NoAdminCache(b *testing.B) {
 
 	adminsRepo := repository.NewAdminsRepo(logger, db)
 
-	logsRepo := repository.NewLogsRepo(db)
+	clientsRepo := repository.NewClientsRepo(logger, db)
+	pickupCodesRepo := repository.NewPickupCodesRepo(logger, db)
+	notificationsRepo := repository.NewNotificationsRepo(logger, db)
+	webhooksRepo := repository.NewWebhooksRepo(logger, db)
+	orderEventsRepo := repository.NewOrderEventsRepo(logger, db, 5)
+	tokensRepo := repository.NewTokensRepo(logger, db)
+	loginAttemptsRepo := repository.NewLoginAttemptsRepo(logger, db)
+
+	apiKeysRepo := repository.NewAPIKeysRepo(logger, db)
+
+	logsRepo := repository.NewLogsRepo(db, 3)
 
-	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersFacade, adminsRepo, logsRepo, txManager,
-		2, 5, 500*time.Millisecond)
+	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersFacade, adminsRepo, clientsRepo, pickupCodesRepo,
+		notificationsRepo, webhooksRepo, orderEventsRepo, tokensRepo, loginAttemptsRepo, apiKeysRepo, logsRepo,
+		nil, txManager, 2, 5, 500*time.Millisecond)
 	app.SetupRoutes(ctx)
 
 	server := httptest.NewServer(app.Router)
@@ -180,10 +202,21 @@ func BenchmarkOrderHandler_GetOrders_NoOrderCache(b *testing.B) {
 
 	adminsFacade := facade.NewAdminFacade(adminsRepo, 10000)
 
-	logsRepo := repository.NewLogsRepo(db)
+	clientsRepo := repository.NewClientsRepo(logger, db)
+	pickupCodesRepo := repository.NewPickupCodesRepo(logger, db)
+	notificationsRepo := repository.NewNotificationsRepo(logger, db)
+	webhooksRepo := repository.NewWebhooksRepo(logger, db)
+	orderEventsRepo := repository.NewOrderEventsRepo(logger, db, 5)
+	tokensRepo := repository.NewTokensRepo(logger, db)
+	loginAttemptsRepo := repository.NewLoginAttemptsRepo(logger, db)
 
-	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersRepo, adminsFacade, logsRepo, txManager,
-		2, 5, 500*time.Millisecond)
+	apiKeysRepo := repository.NewAPIKeysRepo(logger, db)
+
+	logsRepo := repository.NewLogsRepo(db, 3)
+
+	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersRepo, adminsFacade, clientsRepo, pickupCodesRepo,
+		notificationsRepo, webhooksRepo, orderEventsRepo, tokensRepo, loginAttemptsRepo, apiKeysRepo, logsRepo,
+		nil, txManager, 2, 5, 500*time.Millisecond)
 	app.SetupRoutes(ctx)
 
 	server := httptest.NewServer(app.Router)
@@ -226,10 +259,21 @@ func BenchmarkOrderHandler_GetOrders_NoCache(b *testing.B) {
 
 	adminsRepo := repository.NewAdminsRepo(logger, db)
 
-	logsRepo := repository.NewLogsRepo(db)
+	clientsRepo := repository.NewClientsRepo(logger, db)
+	pickupCodesRepo := repository.NewPickupCodesRepo(logger, db)
+	notificationsRepo := repository.NewNotificationsRepo(logger, db)
+	webhooksRepo := repository.NewWebhooksRepo(logger, db)
+	orderEventsRepo := repository.NewOrderEventsRepo(logger, db, 5)
+	tokensRepo := repository.NewTokensRepo(logger, db)
+	loginAttemptsRepo := repository.NewLoginAttemptsRepo(logger, db)
+
+	apiKeysRepo := repository.NewAPIKeysRepo(logger, db)
+
+	logsRepo := repository.NewLogsRepo(db, 3)
 
-	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersRepo, adminsRepo, logsRepo, txManager,
-		2, 5, 500*time.Millisecond)
+	app, _ := web.NewApp(ctx, config.Config{}, logger, ordersRepo, adminsRepo, clientsRepo, pickupCodesRepo,
+		notificationsRepo, webhooksRepo, orderEventsRepo, tokensRepo, loginAttemptsRepo, apiKeysRepo, logsRepo,
+		nil, txManager, 2, 5, 500*time.Millisecond)
 	app.SetupRoutes(ctx)
 
 	server := httptest.NewServer(app.Router)
@@ -107,7 +107,7 @@ func TestOrderHandlerRps_CreateOrder(t *testing.T) {
 	ordersFacade := facade.NewOrderFacade(ctx, ordersRepo, 10000)
 	adminsRepo := repository.NewAdminsRepo(db)
 	adminsFacade := facade.NewAdminFacade(adminsRepo, 10000)
-	logsRepo := repository.NewLogsRepo(db)
+	logsRepo := repository.NewLogsRepo(db, cfg.AuditMaxAttempts())
 
 	app, _ := web.NewApp(ctx, config.Config{}, ordersFacade, adminsFacade, logsRepo, txManager, 2, 5, 500*time.Millisecond)
 	app.SetupRoutes(ctx)